@@ -52,6 +52,11 @@ type HeadFetcher interface {
 	ChainHeads() ([][32]byte, []types.Slot)
 }
 
+// ForkChoiceTreeFetcher retrieves the fork choice tree of the chain, to inspect the forks known to the node.
+type ForkChoiceTreeFetcher interface {
+	ForkChoiceTree(ctx context.Context, filter *ForkChoiceTreeFilter) (*ForkChoiceTree, error)
+}
+
 // ForkFetcher retrieves the current fork information of the Ethereum beacon chain.
 type ForkFetcher interface {
 	CurrentFork() *pb.Fork
@@ -0,0 +1,279 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/emicklei/dot"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// ForkChoiceTreeFilter selects the nodes of the fork choice tree to return.
+type ForkChoiceTreeFilter struct {
+	StartSlot        types.Slot // lowest slot of the nodes.
+	EndSlot          types.Slot // highest slot of the nodes, no upper bound if zero.
+	MinWeight        uint64     // minimum weight of the nodes in Gwei.
+	NonCanonicalOnly bool       // only return the nodes which are not part of the canonical chain.
+}
+
+// ForkChoiceTree is a snapshot of the fork choice tree of the node.
+type ForkChoiceTree struct {
+	JustifiedEpoch types.Epoch
+	FinalizedEpoch types.Epoch
+	HeadRoot       [32]byte
+	Nodes          []*ForkChoiceTreeNode
+}
+
+// ForkChoiceTreeNode is a block of the fork choice tree. The roots of a parent, best child or best descendant
+// which does not exist are zero.
+type ForkChoiceTreeNode struct {
+	Slot               types.Slot
+	Root               [32]byte
+	ParentRoot         [32]byte
+	JustifiedEpoch     types.Epoch
+	FinalizedEpoch     types.Epoch
+	Weight             uint64 // weight in Gwei as of the last head computation.
+	WeightDelta        int64  // change of weight in Gwei which the next head computation applies.
+	BestChildRoot      [32]byte
+	BestDescendantRoot [32]byte
+	Graffiti           [32]byte
+	Viable             bool // whether the justified and finalized epochs of the node make it viable for head.
+	Canonical          bool // whether the node is an ancestor of the head, or the head.
+}
+
+// ForkChoiceTree returns the nodes of the fork choice tree selected by the filter, in the order they were
+// inserted into fork choice, which is an ascending order of slots on each branch.
+func (s *Service) ForkChoiceTree(ctx context.Context, filter *ForkChoiceTreeFilter) (*ForkChoiceTree, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.ForkChoiceTree")
+	defer span.End()
+
+	if filter == nil {
+		filter = &ForkChoiceTreeFilter{}
+	}
+	if filter.EndSlot != 0 && filter.EndSlot < filter.StartSlot {
+		return nil, errors.Errorf("end slot %d is lower than start slot %d", filter.EndSlot, filter.StartSlot)
+	}
+
+	s.headLock.RLock()
+	headRoot := s.headRoot()
+	s.headLock.RUnlock()
+
+	store := s.cfg.ForkChoiceStore.Store()
+	nodes, err := s.cfg.ForkChoiceStore.TreeNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork choice nodes")
+	}
+	tree := &ForkChoiceTree{
+		JustifiedEpoch: store.JustifiedEpoch(),
+		FinalizedEpoch: store.FinalizedEpoch(),
+		HeadRoot:       headRoot,
+		Nodes:          make([]*ForkChoiceTreeNode, 0),
+	}
+
+	// The canonical nodes recorded by fork choice are not removed on reorgs, so they are walked from the head.
+	canonical := make(map[uint64]bool)
+	for i := range nodes {
+		if nodes[i].Root() != headRoot {
+			continue
+		}
+		for j := uint64(i); j < uint64(len(nodes)); j = nodes[j].Parent() {
+			canonical[j] = true
+		}
+		break
+	}
+
+	rootOf := func(i uint64) [32]byte {
+		if i >= uint64(len(nodes)) {
+			return [32]byte{}
+		}
+		return nodes[i].Root()
+	}
+	for i, n := range nodes {
+		if n.Slot() < filter.StartSlot || (filter.EndSlot != 0 && n.Slot() > filter.EndSlot) {
+			continue
+		}
+		if n.Weight() < filter.MinWeight || (filter.NonCanonicalOnly && canonical[uint64(i)]) {
+			continue
+		}
+		tree.Nodes = append(tree.Nodes, &ForkChoiceTreeNode{
+			Slot:               n.Slot(),
+			Root:               n.Root(),
+			ParentRoot:         rootOf(n.Parent()),
+			JustifiedEpoch:     n.JustifiedEpoch(),
+			FinalizedEpoch:     n.FinalizedEpoch(),
+			Weight:             n.Weight(),
+			WeightDelta:        int64(n.WeightDelta),
+			BestChildRoot:      rootOf(n.BestChild()),
+			BestDescendantRoot: rootOf(n.BestDescendant()),
+			Graffiti:           n.Graffiti(),
+			Viable:             n.Viable,
+			Canonical:          canonical[uint64(i)],
+		})
+	}
+	return tree, nil
+}
+
+// DOT renders the tree as a graph in the DOT language, with an edge from each node to its parent when both
+// are part of the tree. The head is green, the canonical chain is blue and the nodes which are not viable for
+// head are dashed.
+func (t *ForkChoiceTree) DOT() string {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make(map[[32]byte]dot.Node, len(t.Nodes))
+	for _, n := range t.Nodes {
+		dotN := graph.Node(fmt.Sprintf("%#x", n.Root)).Box().Attr("label", strings.Join(n.labels(), "\n"))
+		if n.Root == t.HeadRoot {
+			dotN = dotN.Attr("color", "green")
+		} else if n.Canonical {
+			dotN = dotN.Attr("color", "blue")
+		}
+		if !n.Viable {
+			dotN = dotN.Attr("style", "dashed")
+		}
+		dotNodes[n.Root] = dotN
+	}
+	for _, n := range t.Nodes {
+		if parent, ok := dotNodes[n.ParentRoot]; ok && n.ParentRoot != params.BeaconConfig().ZeroHash {
+			graph.Edge(dotNodes[n.Root], parent)
+		}
+	}
+	return graph.String()
+}
+
+// Dimensions of the nodes of the SVG rendering of the tree, in pixels.
+const (
+	svgNodeWidth  = 150
+	svgNodeHeight = 76
+	svgSlotWidth  = 180
+	svgLaneHeight = 100
+	svgMargin     = 20
+	svgLineHeight = 14
+)
+
+// SVG renders the tree as a standalone SVG image, which needs no scripts nor external assets. Nodes are laid
+// out by slot from left to right, the canonical chain is on the first row and each other branch is on a row
+// of its own. The head is green, the canonical chain is blue and the nodes which are not viable for head are
+// dashed.
+func (t *ForkChoiceTree) SVG() string {
+	if len(t.Nodes) == 0 {
+		return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d"></svg>`, 2*svgMargin, 2*svgMargin)
+	}
+
+	// Parents are inserted into fork choice before their children, so a branch continues on the row of its
+	// parent unless another child of the parent already did. The slots of a row are then increasing.
+	index := make(map[[32]byte]int, len(t.Nodes))
+	for i, n := range t.Nodes {
+		index[n.Root] = i
+	}
+	lanes := make([]int, len(t.Nodes))
+	continued := make(map[int]bool)
+	nextLane := 0
+	for _, n := range t.Nodes {
+		if n.Canonical {
+			nextLane = 1
+			if p, ok := index[n.ParentRoot]; ok {
+				continued[p] = true
+			}
+		}
+	}
+	for i, n := range t.Nodes {
+		if n.Canonical {
+			continue
+		}
+		if p, ok := index[n.ParentRoot]; ok && !continued[p] && !t.Nodes[p].Canonical {
+			continued[p] = true
+			lanes[i] = lanes[p]
+			continue
+		}
+		lanes[i] = nextLane
+		nextLane++
+	}
+
+	minSlot, maxSlot := t.Nodes[0].Slot, t.Nodes[0].Slot
+	for _, n := range t.Nodes {
+		if n.Slot < minSlot {
+			minSlot = n.Slot
+		}
+		if n.Slot > maxSlot {
+			maxSlot = n.Slot
+		}
+	}
+	x := func(i int) int {
+		return svgMargin + int(t.Nodes[i].Slot-minSlot)*svgSlotWidth
+	}
+	y := func(i int) int {
+		return svgMargin + lanes[i]*svgLaneHeight
+	}
+
+	var b bytes.Buffer
+	width := 2*svgMargin + int(maxSlot-minSlot)*svgSlotWidth + svgNodeWidth
+	height := 2*svgMargin + (nextLane-1)*svgLaneHeight + svgNodeHeight
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="monospace" font-size="11">`, width, height)
+	b.WriteString("\n")
+
+	// Edges are drawn first to be below the nodes.
+	for i, n := range t.Nodes {
+		p, ok := index[n.ParentRoot]
+		if !ok || n.ParentRoot == params.BeaconConfig().ZeroHash {
+			continue
+		}
+		fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="grey"/>`,
+			x(i), y(i)+svgNodeHeight/2, x(p)+svgNodeWidth, y(p)+svgNodeHeight/2)
+		b.WriteString("\n")
+	}
+
+	for i, n := range t.Nodes {
+		color := "black"
+		if n.Root == t.HeadRoot {
+			color = "green"
+		} else if n.Canonical {
+			color = "blue"
+		}
+		dash := ""
+		if !n.Viable {
+			dash = ` stroke-dasharray="4"`
+		}
+		fmt.Fprintf(&b, `<g><title>%#x</title>`, n.Root)
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" fill="white" stroke="%s"%s/>`,
+			x(i), y(i), svgNodeWidth, svgNodeHeight, color, dash)
+		for j, label := range n.labels() {
+			fmt.Fprintf(&b, `<text x="%d" y="%d">%s</text>`, x(i)+6, y(i)+svgLineHeight*(j+1)+2, html.EscapeString(label))
+		}
+		b.WriteString("</g>\n")
+	}
+	b.WriteString("</svg>")
+	return b.String()
+}
+
+// labels of the node in the renderings of the tree, with weights in ETH.
+func (n *ForkChoiceTreeNode) labels() []string {
+	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
+	return []string{
+		fmt.Sprintf("slot: %d", n.Slot),
+		fmt.Sprintf("root: %#x", n.Root[:8]),
+		fmt.Sprintf("weight: %.2f", float64(n.Weight)/gweiPerEth),
+		fmt.Sprintf("delta: %+.2f", float64(n.WeightDelta)/gweiPerEth),
+		fmt.Sprintf("graffiti: %s", printableGraffiti(n.Graffiti)),
+	}
+}
+
+// printableGraffiti returns the graffiti as text, where the bytes which are not printable ASCII are dots.
+func printableGraffiti(graffiti [32]byte) string {
+	b := bytes.TrimRight(graffiti[:], "\x00")
+	text := make([]byte, len(b))
+	for i, c := range b {
+		if c < ' ' || c > '~' || c == '"' || c == '\\' {
+			c = '.'
+		}
+		text[i] = c
+	}
+	return string(text)
+}
@@ -0,0 +1,149 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// setupForkChoiceTree returns a service with the fork choice tree below, where head is b, a and b have a
+// weight of 10, and the votes for d are pending until the next head computation.
+//
+//	g <- a <- b
+//	 \
+//	  c <- d
+func setupForkChoiceTree(t *testing.T) *Service {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	cfg := &Config{
+		BeaconDB:        beaconDB,
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{'g'}),
+		StateGen:        stategen.New(beaconDB),
+	}
+	s, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+	f := s.cfg.ForkChoiceStore
+	require.NoError(t, f.ProcessBlock(ctx, 0, [32]byte{'g'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, [32]byte{'a'}, [32]byte{'g'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, [32]byte{'c'}, [32]byte{'g'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 3, [32]byte{'d'}, [32]byte{'c'}, [32]byte{'<', 'x', '>'}, 0, 0))
+	balances := []uint64{10, 10, 10}
+	f.ProcessAttestation(ctx, []uint64{0}, [32]byte{'b'}, 1)
+	head, err := f.Head(ctx, 0, [32]byte{'g'}, balances, 0)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{'b'}, head)
+	f.ProcessAttestation(ctx, []uint64{1, 2}, [32]byte{'d'}, 1)
+
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	s.setHead(head, interfaces.WrappedPhase0SignedBeaconBlock(testutil.NewBeaconBlock()), headState)
+	return s
+}
+
+func TestService_ForkChoiceTree(t *testing.T) {
+	ctx := context.Background()
+	s := setupForkChoiceTree(t)
+
+	tree, err := s.ForkChoiceTree(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'b'}, tree.HeadRoot)
+	require.Equal(t, 5, len(tree.Nodes))
+	nodes := make(map[[32]byte]*ForkChoiceTreeNode)
+	for _, n := range tree.Nodes {
+		nodes[n.Root] = n
+	}
+	assert.DeepEqual(t, &ForkChoiceTreeNode{
+		Slot:               0,
+		Root:               [32]byte{'g'},
+		Weight:             10,
+		WeightDelta:        20,
+		BestChildRoot:      [32]byte{'a'},
+		BestDescendantRoot: [32]byte{'b'},
+		Viable:             true,
+		Canonical:          true,
+	}, nodes[[32]byte{'g'}])
+	assert.DeepEqual(t, &ForkChoiceTreeNode{
+		Slot:        3,
+		Root:        [32]byte{'d'},
+		ParentRoot:  [32]byte{'c'},
+		WeightDelta: 20,
+		Graffiti:    [32]byte{'<', 'x', '>'},
+		Viable:      true,
+	}, nodes[[32]byte{'d'}])
+	assert.Equal(t, true, nodes[[32]byte{'a'}].Canonical)
+	assert.Equal(t, true, nodes[[32]byte{'b'}].Canonical)
+	assert.Equal(t, false, nodes[[32]byte{'c'}].Canonical)
+}
+
+func TestService_ForkChoiceTree_Filters(t *testing.T) {
+	ctx := context.Background()
+	s := setupForkChoiceTree(t)
+
+	tests := []struct {
+		name   string
+		filter *ForkChoiceTreeFilter
+		roots  [][32]byte
+	}{
+		{
+			name:   "slot range",
+			filter: &ForkChoiceTreeFilter{StartSlot: 2, EndSlot: 2},
+			roots:  [][32]byte{{'b'}, {'c'}},
+		},
+		{
+			name:   "start slot only",
+			filter: &ForkChoiceTreeFilter{StartSlot: 2},
+			roots:  [][32]byte{{'b'}, {'c'}, {'d'}},
+		},
+		{
+			name:   "minimum weight",
+			filter: &ForkChoiceTreeFilter{MinWeight: 10},
+			roots:  [][32]byte{{'g'}, {'a'}, {'b'}},
+		},
+		{
+			name:   "non canonical only",
+			filter: &ForkChoiceTreeFilter{NonCanonicalOnly: true},
+			roots:  [][32]byte{{'c'}, {'d'}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := s.ForkChoiceTree(ctx, tt.filter)
+			require.NoError(t, err)
+			roots := make([][32]byte, len(tree.Nodes))
+			for i, n := range tree.Nodes {
+				roots[i] = n.Root
+			}
+			assert.DeepEqual(t, tt.roots, roots)
+		})
+	}
+
+	_, err := s.ForkChoiceTree(ctx, &ForkChoiceTreeFilter{StartSlot: 3, EndSlot: 2})
+	assert.ErrorContains(t, "end slot 2 is lower than start slot 3", err)
+}
+
+func TestForkChoiceTree_Render(t *testing.T) {
+	s := setupForkChoiceTree(t)
+	tree, err := s.ForkChoiceTree(context.Background(), nil)
+	require.NoError(t, err)
+
+	graph := tree.DOT()
+	assert.Equal(t, 4, strings.Count(graph, "->"), "Unexpected number of edges")
+	assert.Equal(t, true, strings.Contains(graph, fmt.Sprintf("root: %#x", []byte{'d', 0, 0, 0, 0, 0, 0, 0})))
+
+	svg := tree.SVG()
+	assert.Equal(t, true, strings.HasPrefix(svg, "<svg"))
+	assert.Equal(t, 5, strings.Count(svg, "<rect"), "Unexpected number of nodes")
+	assert.Equal(t, 4, strings.Count(svg, "<line"), "Unexpected number of edges")
+	assert.Equal(t, true, strings.Contains(svg, "graffiti: &lt;x&gt;"), "Graffiti is not escaped")
+	assert.Equal(t, false, strings.Contains(svg, "<script"))
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	f "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/journal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
@@ -64,7 +66,7 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 		if err != nil {
 			return err
 		}
-		s.cfg.ForkChoiceStore = protoarray.New(j.Epoch, f.Epoch, bytesutil.ToBytes32(f.Root))
+		s.cfg.ForkChoiceStore = s.newForkChoiceStore(j.Epoch, f.Epoch, bytesutil.ToBytes32(f.Root))
 		if err := s.insertBlockToForkChoiceStore(ctx, jb.Block(), headStartRoot, f, j); err != nil {
 			return err
 		}
@@ -79,6 +81,14 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 	return s.saveHead(ctx, headRoot)
 }
 
+// This initializes a new fork choice store, which records its inputs to the fork choice journal if enabled.
+func (s *Service) newForkChoiceStore(justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) f.ForkChoicer {
+	if s.cfg.ForkChoiceJournal != nil {
+		return journal.New(s.cfg.ForkChoiceJournal, justifiedEpoch, finalizedEpoch, finalizedRoot)
+	}
+	return protoarray.New(justifiedEpoch, finalizedEpoch, finalizedRoot)
+}
+
 // This saves head info to the local service cache, it also saves the
 // new head root to the DB.
 func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
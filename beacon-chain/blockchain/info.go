@@ -1,38 +1,27 @@
 package blockchain
 
 import (
-	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strconv"
 
-	"github.com/emicklei/dot"
-	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/pkg/errors"
 )
 
 const template = `<html>
 <head>
-    <script src="//cdnjs.cloudflare.com/ajax/libs/viz.js/2.1.2/viz.js"></script>
-    <script src="//cdnjs.cloudflare.com/ajax/libs/viz.js/2.1.2/full.render.js"></script>
-<body>
-    <script type="application/javascript">
-        var graph = ` + "`%s`;" + `
-        var viz = new Viz();
-        viz.renderSVGElement(graph) // reading the graph.
-            .then(function(element) {
-                document.body.appendChild(element); // appends to document.
-            })
-            .catch(error => {
-                // Create a new Viz instance (@see Caveats page for more info)
-                viz = new Viz();
-                // Possibly display the error
-                console.error(error);
-            });
-    </script>
+    <title>Fork choice tree</title>
 </head>
+<body>
+    <p>head: %#x, justified epoch: %d, finalized epoch: %d, nodes: %d</p>
+    <p>Weights are in ETH. The head is green, the canonical chain is blue and the nodes which are not viable for head are dashed.
+    Filter with the start_slot, end_slot, min_weight (Gwei) and non_canonical_only query parameters, and get the graph in DOT with format=dot.</p>
+    %s
 </body>
 </html>`
 
-// TreeHandler is a handler to serve /tree page in metrics.
+// TreeHandler is a handler to serve /tree page in metrics. The page renders the fork choice tree without any
+// external asset, the tree can also be served in the DOT language with the format=dot query parameter.
 func (s *Service) TreeHandler(w http.ResponseWriter, r *http.Request) {
 	headState, err := s.HeadState(r.Context())
 	if err != nil {
@@ -41,59 +30,64 @@ func (s *Service) TreeHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	if headState == nil || headState.IsNil() {
 		if _, err := w.Write([]byte("Unavailable during initial syncing")); err != nil {
-			log.WithError(err).Error("Failed to render p2p info page")
+			log.WithError(err).Error("Failed to render fork choice tree page")
 		}
+		return
 	}
 
-	nodes := s.cfg.ForkChoiceStore.Nodes()
-
-	graph := dot.NewGraph(dot.Directed)
-	graph.Attr("rankdir", "RL")
-	graph.Attr("labeljust", "l")
-
-	dotNodes := make([]*dot.Node, len(nodes))
-	avgBalance := uint64(averageBalance(headState.Balances()))
+	filter, err := treeFilterFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tree, err := s.ForkChoiceTree(r.Context(), filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	for i := len(nodes) - 1; i >= 0; i-- {
-		// Construct label for each node.
-		slot := fmt.Sprintf("%d", nodes[i].Slot())
-		weight := fmt.Sprintf("%d", nodes[i].Weight()/1e9) // Convert unit Gwei to unit ETH.
-		votes := fmt.Sprintf("%d", nodes[i].Weight()/1e9/avgBalance)
-		index := fmt.Sprintf("%d", i)
-		g := nodes[i].Graffiti()
-		graffiti := hex.EncodeToString(g[:8])
-		label := "slot: " + slot + "\n votes: " + votes + "\n weight: " + weight + "\n graffiti: " + graffiti
-		var dotN dot.Node
-		if nodes[i].Parent() != ^uint64(0) {
-			dotN = graph.Node(index).Box().Attr("label", label)
+	switch format := r.URL.Query().Get("format"); format {
+	case "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(tree.DOT())); err != nil {
+			log.WithError(err).Error("Failed to render fork choice tree page")
 		}
-
-		if nodes[i].Slot() == s.HeadSlot() &&
-			nodes[i].BestDescendant() == ^uint64(0) &&
-			nodes[i].Parent() != ^uint64(0) {
-			dotN = dotN.Attr("color", "green")
+	case "", "html":
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusOK)
+		if _, err := fmt.Fprintf(w, template, tree.HeadRoot, tree.JustifiedEpoch, tree.FinalizedEpoch, len(tree.Nodes), tree.SVG()); err != nil {
+			log.WithError(err).Error("Failed to render fork choice tree page")
 		}
-
-		dotNodes[i] = &dotN
+	default:
+		http.Error(w, fmt.Sprintf("unknown format %q", format), http.StatusBadRequest)
 	}
+}
 
-	for i := len(nodes) - 1; i >= 0; i-- {
-		if nodes[i].Parent() != ^uint64(0) && nodes[i].Parent() < uint64(len(dotNodes)) {
-			graph.Edge(*dotNodes[i], *dotNodes[nodes[i].Parent()])
+// treeFilterFromQuery reads the filter of the fork choice tree from the query parameters of the request.
+func treeFilterFromQuery(r *http.Request) (*ForkChoiceTreeFilter, error) {
+	query := r.URL.Query()
+	filter := &ForkChoiceTreeFilter{}
+	for name, value := range map[string]*uint64{
+		"start_slot": (*uint64)(&filter.StartSlot),
+		"end_slot":   (*uint64)(&filter.EndSlot),
+		"min_weight": &filter.MinWeight,
+	} {
+		if query.Get(name) == "" {
+			continue
 		}
+		v, err := strconv.ParseUint(query.Get(name), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", name)
+		}
+		*value = v
 	}
-
-	w.WriteHeader(http.StatusOK)
-	w.Header().Set("Content-Type", "text/html")
-	if _, err := fmt.Fprintf(w, template, graph.String()); err != nil {
-		log.WithError(err).Error("Failed to render p2p info page")
-	}
-}
-
-func averageBalance(balances []uint64) float64 {
-	total := uint64(0)
-	for i := 0; i < len(balances); i++ {
-		total += balances[i]
+	if query.Get("non_canonical_only") != "" {
+		v, err := strconv.ParseBool(query.Get("non_canonical_only"))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid non_canonical_only")
+		}
+		filter.NonCanonicalOnly = v
 	}
-	return float64(total) / float64(len(balances)) / float64(params.BeaconConfig().GweiPerEth)
+	return filter, nil
 }
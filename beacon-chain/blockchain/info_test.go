@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -47,3 +48,36 @@ func TestService_TreeHandler(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
+
+func TestService_TreeHandler_Formats(t *testing.T) {
+	s := setupForkChoiceTree(t)
+	handler := http.HandlerFunc(s.TreeHandler)
+
+	tests := []struct {
+		query       string
+		code        int
+		contentType string
+		contains    string
+	}{
+		{query: "", code: http.StatusOK, contentType: "text/html", contains: "<svg"},
+		{query: "?non_canonical_only=true&min_weight=1", code: http.StatusOK, contentType: "text/html", contains: "nodes: 0"},
+		{query: "?format=dot&start_slot=2", code: http.StatusOK, contentType: "text/vnd.graphviz", contains: "digraph"},
+		{query: "?format=json", code: http.StatusBadRequest, contains: "unknown format"},
+		{query: "?end_slot=x", code: http.StatusBadRequest, contains: "invalid end_slot"},
+		{query: "?start_slot=3&end_slot=2", code: http.StatusBadRequest, contains: "lower than start slot"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			req, err := http.NewRequest("GET", "/tree"+tt.query, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tt.code, rr.Code)
+			if tt.contentType != "" {
+				assert.Equal(t, tt.contentType, rr.Header().Get("Content-Type"))
+			}
+			assert.Equal(t, true, strings.Contains(rr.Body.String(), tt.contains), "Body does not contain %q", tt.contains)
+			assert.Equal(t, false, strings.Contains(rr.Body.String(), "cdnjs"), "Page uses external assets")
+		})
+	}
+}
@@ -663,6 +663,29 @@ func TestAncestor_CanUseForkchoice(t *testing.T) {
 	}
 }
 
+func TestInsertSlashingsToForkChoiceStore(t *testing.T) {
+	ctx := context.Background()
+	cfg := &Config{ForkChoiceStore: protoarray.New(0, 0, [32]byte{})}
+	service, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+
+	root := [32]byte{'a'}
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, root, [32]byte{}, [32]byte{}, 0, 0))
+	service.cfg.ForkChoiceStore.ProcessAttestation(ctx, []uint64{0, 1, 2, 3}, root, 0)
+	balances := []uint64{1, 2, 4, 8}
+	_, err = service.cfg.ForkChoiceStore.Head(ctx, 0, root, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), service.cfg.ForkChoiceStore.Node(root).Weight())
+
+	// Only the validators attesting to both attestations are equivocating.
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{0, 1, 2}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2, 3}},
+	}
+	service.insertSlashingsToForkChoiceStore(ctx, []*ethpb.AttesterSlashing{slashing})
+	assert.Equal(t, uint64(9), service.cfg.ForkChoiceStore.Node(root).Weight())
+}
+
 func TestAncestor_CanUseDB(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/interfaces"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
@@ -65,6 +66,13 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.SignedBeaco
 		return err
 	}
 
+	// Record the proposer and the attesters of the block as live.
+	if s.cfg.LivenessCache != nil {
+		if err := s.markLiveValidators(ctx, blockCopy.Block(), blockRoot); err != nil {
+			log.WithError(err).Warn("Could not mark validators of block as live")
+		}
+	}
+
 	// Have we been finalizing? Should we start saving hot states to db?
 	if err := s.checkSaveHotStateDB(ctx); err != nil {
 		return err
@@ -174,3 +182,33 @@ func (s *Service) checkSaveHotStateDB(ctx context.Context) error {
 
 	return s.cfg.StateGen.DisableSaveHotStateToDB(ctx)
 }
+
+// markLiveValidators marks the proposer of the block as live in the epoch of the block, and the
+// attesters of the attestations included in the block as live in the target epoch of the attestations.
+func (s *Service) markLiveValidators(ctx context.Context, b interfaces.BeaconBlock, blockRoot [32]byte) error {
+	s.cfg.LivenessCache.MarkLive(helpers.SlotToEpoch(b.Slot()), b.ProposerIndex())
+	atts := b.Body().Attestations()
+	if len(atts) == 0 {
+		return nil
+	}
+	postState, err := s.cfg.StateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get post state of block")
+	}
+	for _, a := range atts {
+		committee, err := helpers.BeaconCommitteeFromState(postState, a.Data.Slot, a.Data.CommitteeIndex)
+		if err != nil {
+			return errors.Wrap(err, "could not get attestation committee")
+		}
+		indices, err := attestationutil.AttestingIndices(a.AggregationBits, committee)
+		if err != nil {
+			return errors.Wrap(err, "could not get attesting indices")
+		}
+		live := make([]types.ValidatorIndex, len(indices))
+		for i, idx := range indices {
+			live[i] = types.ValidatorIndex(idx)
+		}
+		s.cfg.LivenessCache.MarkLive(a.Data.Target.Epoch, live...)
+	}
+	return nil
+}
@@ -8,12 +8,15 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	blockchainTesting "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/interfaces"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -35,6 +38,7 @@ func TestService_ReceiveBlock(t *testing.T) {
 	bc := params.BeaconConfig()
 	bc.ShardCommitteePeriod = 0 // Required for voluntary exits test in reasonable time.
 	params.OverrideBeaconConfig(bc)
+	blkWithAtts := genFullBlock(t, &testutil.BlockGenConfig{NumAttestations: 1}, 1 /*slot*/)
 
 	type args struct {
 		block *ethpb.SignedBeaconBlock
@@ -104,6 +108,24 @@ func TestService_ReceiveBlock(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "marks proposer and attesters as live",
+			args: args{
+				block: blkWithAtts,
+			},
+			check: func(t *testing.T, s *Service) {
+				assert.Equal(t, true, s.cfg.LivenessCache.IsLive(0, blkWithAtts.Block.ProposerIndex))
+				att := blkWithAtts.Block.Body.Attestations[0]
+				committee, err := helpers.BeaconCommitteeFromState(genesis, att.Data.Slot, att.Data.CommitteeIndex)
+				require.NoError(t, err)
+				indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+				require.NoError(t, err)
+				require.NotEqual(t, 0, len(indices))
+				for _, idx := range indices {
+					assert.Equal(t, true, s.cfg.LivenessCache.IsLive(att.Data.Target.Epoch, types.ValidatorIndex(idx)))
+				}
+			},
+		},
 		{
 			name: "notifies block processed on state feed",
 			args: args{
@@ -134,6 +156,7 @@ func TestService_ReceiveBlock(t *testing.T) {
 				ExitPool:      voluntaryexits.NewPool(),
 				StateNotifier: &blockchainTesting.MockStateNotifier{RecordEvents: true},
 				StateGen:      stategen.New(beaconDB),
+				LivenessCache: cache.NewLivenessCache(),
 			}
 			s, err := NewService(ctx, cfg)
 			require.NoError(t, err)
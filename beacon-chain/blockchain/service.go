@@ -20,7 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	f "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/journal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -81,9 +81,11 @@ type Config struct {
 	MaxRoutines             int
 	StateNotifier           statefeed.Notifier
 	ForkChoiceStore         f.ForkChoicer
+	ForkChoiceJournal       *journal.Writer
 	AttService              *attestations.Service
 	StateGen                *stategen.State
 	WeakSubjectivityCheckpt *ethpb.Checkpoint
+	LivenessCache           *cache.LivenessCache
 }
 
 // NewService instantiates a new block service instance that will
@@ -174,6 +176,9 @@ func (s *Service) Start() {
 		s.finalizedCheckpt = copyutil.CopyCheckpoint(finalizedCheckpoint)
 		s.prevFinalizedCheckpt = copyutil.CopyCheckpoint(finalizedCheckpoint)
 		s.resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint)
+		if err := s.insertFinalizedBlockToForkChoice(s.ctx, finalizedCheckpoint, justifiedCheckpoint); err != nil {
+			log.Fatalf("Could not insert finalized block to fork choice store: %v", err)
+		}
 
 		ss, err := helpers.StartSlot(s.finalizedCheckpt.Epoch)
 		if err != nil {
@@ -459,10 +464,32 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 // This is called when a client starts from non-genesis slot. This passes last justified and finalized
 // information to fork choice service to initializes fork choice store.
 func (s *Service) resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) {
-	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
+	store := s.newForkChoiceStore(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
 	s.cfg.ForkChoiceStore = store
 }
 
+// This inserts the finalized block as the root of a resumed fork choice store, so that the
+// blocks which are later received on top of it are attached to a known parent. This matters
+// for a node started from a checkpoint, where the finalized block has no ancestors in the DB.
+func (s *Service) insertFinalizedBlockToForkChoice(ctx context.Context, finalizedCheckpoint, justifiedCheckpoint *ethpb.Checkpoint) error {
+	finalizedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalizedCheckpoint.Root))
+	if s.cfg.ForkChoiceStore.HasNode(finalizedRoot) {
+		return nil
+	}
+	finalizedBlock, err := s.cfg.BeaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block from db")
+	}
+	if finalizedBlock == nil || finalizedBlock.IsNil() {
+		return errors.New("finalized block can't be nil")
+	}
+	b := finalizedBlock.Block()
+	return s.cfg.ForkChoiceStore.ProcessBlock(ctx,
+		b.Slot(), finalizedRoot, bytesutil.ToBytes32(b.ParentRoot()), bytesutil.ToBytes32(b.Body().Graffiti()),
+		justifiedCheckpoint.Epoch,
+		finalizedCheckpoint.Epoch)
+}
+
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
 // 1.) Check fork choice store.
 // 2.) Check DB.
@@ -3,6 +3,9 @@ package blockchain
 import (
 	"bytes"
 	"context"
+	"io"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -16,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/journal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -155,6 +159,61 @@ func TestChainStartStop_Initialized(t *testing.T) {
 	require.LogsContain(t, hook, "data already exists")
 }
 
+func TestChainStartStop_Initialized_RecordsForkChoiceJournal(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	path := filepath.Join(t.TempDir(), "forkchoice.journal")
+	w, err := journal.NewWriter(path)
+	require.NoError(t, err)
+
+	chainService := setupBeaconChain(t, beaconDB)
+	chainService.cfg.ForkChoiceJournal = w
+	chainService.cfg.ForkChoiceStore = journal.New(w, 0, 0, params.BeaconConfig().ZeroHash)
+
+	genesisBlk := testutil.NewBeaconBlock()
+	blkRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(genesisBlk)))
+	s, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, s.SetSlot(1))
+	require.NoError(t, beaconDB.SaveState(ctx, s, blkRoot))
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, blkRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, blkRoot))
+	require.NoError(t, beaconDB.SaveJustifiedCheckpoint(ctx, &ethpb.Checkpoint{Root: blkRoot[:]}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: blkRoot[:]}))
+
+	chainService.Start()
+	require.NoError(t, chainService.Stop(), "Unable to stop chain service")
+	require.NoError(t, w.Close())
+
+	// The resumed store must keep recording, starting with its initialization from the finalized checkpoint.
+	_, ok := chainService.cfg.ForkChoiceStore.(*journal.Recorder)
+	require.Equal(t, true, ok, "Resumed fork choice store does not record to the journal")
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	r := journal.NewReader(f)
+	var resumed, inserted bool
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if e.Kind == journal.KindNew && e.Root == blkRoot {
+			resumed = true
+		}
+		if e.Kind == journal.KindBlock && e.Root == blkRoot {
+			inserted = true
+		}
+	}
+	assert.Equal(t, true, resumed, "Journal does not record the resumed fork choice store")
+	assert.Equal(t, true, inserted, "Journal does not record the finalized block")
+}
+
 func TestChainStartStop_GenesisZeroHashes(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
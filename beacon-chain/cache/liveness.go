@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// LivenessEpochs defines the number of most recent epochs the liveness cache keeps the observed
+// validators of. It covers the current and the previous epoch, which are the ones doppelganger
+// checks ask for, plus some slack for attestations of older epochs which are included late.
+const LivenessEpochs = types.Epoch(4)
+
+// LivenessCache records, per epoch, the indices of the validators which were observed attesting
+// or proposing on the network. Only the LivenessEpochs epochs up to the latest marked epoch are kept.
+type LivenessCache struct {
+	// live maps an epoch to a bitset of the live validators, indexed by validator index.
+	live        map[types.Epoch][]uint64
+	latestEpoch types.Epoch
+	lock        sync.RWMutex
+}
+
+// NewLivenessCache creates a new liveness cache.
+func NewLivenessCache() *LivenessCache {
+	return &LivenessCache{
+		live: make(map[types.Epoch][]uint64),
+	}
+}
+
+// MarkLive records the validators as live in the epoch. Epochs which are older than the kept window
+// are ignored, and marking a newer epoch than the latest one evicts the epochs leaving the window.
+func (c *LivenessCache) MarkLive(epoch types.Epoch, indices ...types.ValidatorIndex) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if epoch+LivenessEpochs <= c.latestEpoch {
+		return
+	}
+	if epoch > c.latestEpoch {
+		c.latestEpoch = epoch
+		for e := range c.live {
+			if e+LivenessEpochs <= epoch {
+				delete(c.live, e)
+			}
+		}
+	}
+	bits := c.live[epoch]
+	for _, idx := range indices {
+		word := int(idx / 64)
+		if word >= len(bits) {
+			grown := make([]uint64, word+1)
+			copy(grown, bits)
+			bits = grown
+		}
+		bits[word] |= 1 << (idx % 64)
+	}
+	c.live[epoch] = bits
+}
+
+// IsLive returns whether the validator was observed attesting or proposing in the epoch.
+func (c *LivenessCache) IsLive(epoch types.Epoch, idx types.ValidatorIndex) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	bits := c.live[epoch]
+	word := int(idx / 64)
+	if word >= len(bits) {
+		return false
+	}
+	return bits[word]&(1<<(idx%64)) != 0
+}
@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestLivenessCache_MarkLive(t *testing.T) {
+	c := NewLivenessCache()
+	assert.Equal(t, false, c.IsLive(0, 1))
+
+	c.MarkLive(1, 1, 100)
+	c.MarkLive(2, 64)
+	assert.Equal(t, true, c.IsLive(1, 1))
+	assert.Equal(t, true, c.IsLive(1, 100))
+	assert.Equal(t, false, c.IsLive(1, 64))
+	assert.Equal(t, false, c.IsLive(2, 1))
+	assert.Equal(t, true, c.IsLive(2, 64))
+	assert.Equal(t, false, c.IsLive(2, 1<<20))
+}
+
+func TestLivenessCache_BoundedWindow(t *testing.T) {
+	c := NewLivenessCache()
+	c.MarkLive(1, 1)
+	c.MarkLive(LivenessEpochs, 1)
+	assert.Equal(t, true, c.IsLive(1, 1))
+
+	// Marking a newer epoch evicts the epochs leaving the window.
+	c.MarkLive(LivenessEpochs+1, 1)
+	assert.Equal(t, false, c.IsLive(1, 1))
+	assert.Equal(t, true, c.IsLive(LivenessEpochs, 1))
+
+	// Epochs older than the window are ignored.
+	c.MarkLive(1, 2)
+	assert.Equal(t, false, c.IsLive(1, 2))
+	c.MarkLive(2, 2)
+	assert.Equal(t, true, c.IsLive(2, 2))
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+)
+
+var (
+	// maxAttestationRewardsSize defines the max number of epochs the rewards cache keeps the attestation
+	// rewards of all validators for. Every epoch takes a few megabytes on mainnet.
+	maxAttestationRewardsSize = 4
+	// maxBlockRewardsSize defines the max number of blocks the rewards cache keeps the rewards for.
+	maxBlockRewardsSize = 256
+
+	// Metrics.
+	rewardsCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rewards_cache_miss",
+		Help: "The number of rewards requests that aren't present in the cache.",
+	})
+	rewardsCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rewards_cache_hit",
+		Help: "The number of rewards requests that are present in the cache.",
+	})
+)
+
+// RewardsCache stores the rewards of finalized epochs and blocks, which do not change anymore,
+// so that they do not have to be computed by replaying the chain again.
+type RewardsCache struct {
+	attestationRewards *lru.Cache
+	blockRewards       *lru.Cache
+	lock               sync.RWMutex
+}
+
+// NewRewardsCache creates a new rewards cache.
+func NewRewardsCache() *RewardsCache {
+	attestationRewards, err := lru.New(maxAttestationRewardsSize)
+	if err != nil {
+		panic(err)
+	}
+	blockRewards, err := lru.New(maxBlockRewardsSize)
+	if err != nil {
+		panic(err)
+	}
+	return &RewardsCache{
+		attestationRewards: attestationRewards,
+		blockRewards:       blockRewards,
+	}
+}
+
+// AttestationRewards returns the attestation rewards of all validators for the epoch,
+// indexed by validator index, or nil if they are not in the cache.
+func (c *RewardsCache) AttestationRewards(epoch types.Epoch) []*ethpb.AttestationReward {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.attestationRewards.Get(epoch)
+	if exists && item != nil {
+		rewardsCacheHit.Inc()
+		return item.([]*ethpb.AttestationReward)
+	}
+	rewardsCacheMiss.Inc()
+	return nil
+}
+
+// AddAttestationRewards adds the attestation rewards of all validators for the epoch to the cache.
+func (c *RewardsCache) AddAttestationRewards(epoch types.Epoch, rewards []*ethpb.AttestationReward) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.attestationRewards.Add(epoch, rewards)
+}
+
+// BlockRewards returns the rewards of the block with the root, or nil if they are not in the cache.
+func (c *RewardsCache) BlockRewards(root [32]byte) *ethpb.BlockRewards {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.blockRewards.Get(root)
+	if exists && item != nil {
+		rewardsCacheHit.Inc()
+		return item.(*ethpb.BlockRewards)
+	}
+	rewardsCacheMiss.Inc()
+	return nil
+}
+
+// AddBlockRewards adds the rewards of the block with the root to the cache.
+func (c *RewardsCache) AddBlockRewards(root [32]byte, rewards *ethpb.BlockRewards) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.blockRewards.Add(root, rewards)
+}
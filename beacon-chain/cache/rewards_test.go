@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRewardsCache_AttestationRewards(t *testing.T) {
+	c := NewRewardsCache()
+	assert.Equal(t, 0, len(c.AttestationRewards(1)))
+
+	rewards := []*ethpb.AttestationReward{{ValidatorIndex: 0, Source: 10}, {ValidatorIndex: 1, Source: -10}}
+	c.AddAttestationRewards(1, rewards)
+	assert.DeepEqual(t, rewards, c.AttestationRewards(1))
+	assert.Equal(t, 0, len(c.AttestationRewards(2)))
+
+	// The least recently used epochs are evicted.
+	for i := 2; i <= maxAttestationRewardsSize+1; i++ {
+		c.AddAttestationRewards(types.Epoch(i), rewards)
+	}
+	assert.Equal(t, 0, len(c.AttestationRewards(1)))
+	assert.DeepEqual(t, rewards, c.AttestationRewards(types.Epoch(maxAttestationRewardsSize+1)))
+}
+
+func TestRewardsCache_BlockRewards(t *testing.T) {
+	c := NewRewardsCache()
+	root := [32]byte{'a'}
+	require.Equal(t, true, c.BlockRewards(root) == nil)
+
+	rewards := &ethpb.BlockRewards{ProposerIndex: 1, Total: 30, Attestations: 10, ProposerSlashings: 20}
+	c.AddBlockRewards(root, rewards)
+	assert.DeepEqual(t, rewards, c.BlockRewards(root))
+	assert.Equal(t, true, c.BlockRewards([32]byte{'b'}) == nil)
+}
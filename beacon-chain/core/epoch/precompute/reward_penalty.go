@@ -62,48 +62,65 @@ func ProcessRewardsAndPenaltiesPrecompute(
 // AttestationsDelta computes and returns the rewards and penalties differences for individual validators based on the
 // voting records.
 func AttestationsDelta(state iface.ReadOnlyBeaconState, pBal *Balance, vp []*Validator) ([]uint64, []uint64, error) {
+	deltas, err := AttestationsDeltaBreakdown(state, pBal, vp)
+	if err != nil {
+		return nil, nil, err
+	}
+	rewards := make([]uint64, len(deltas))
+	penalties := make([]uint64, len(deltas))
+	for i, d := range deltas {
+		rewards[i], penalties[i] = d.Reward(), d.Penalty()
+	}
+	return rewards, penalties, nil
+}
+
+// AttestationsDeltaBreakdown computes the rewards and penalties differences for individual validators based on the
+// voting records, broken down by the attestation duty they are applied for.
+func AttestationsDeltaBreakdown(state iface.ReadOnlyBeaconState, pBal *Balance, vp []*Validator) ([]*AttestationDelta, error) {
 	numOfVals := state.NumValidators()
-	rewards := make([]uint64, numOfVals)
-	penalties := make([]uint64, numOfVals)
+	if len(vp) != numOfVals {
+		return nil, errors.New("precomputed registries not the same length as state registries")
+	}
+	deltas := make([]*AttestationDelta, numOfVals)
 	prevEpoch := helpers.PrevEpoch(state)
 	finalizedEpoch := state.FinalizedCheckpointEpoch()
 
 	sqrtActiveCurrentEpoch := mathutil.IntegerSquareRoot(pBal.ActiveCurrentEpoch)
 	for i, v := range vp {
-		rewards[i], penalties[i] = attestationDelta(pBal, sqrtActiveCurrentEpoch, v, prevEpoch, finalizedEpoch)
+		deltas[i] = attestationDelta(pBal, sqrtActiveCurrentEpoch, v, prevEpoch, finalizedEpoch)
 	}
-	return rewards, penalties, nil
+	return deltas, nil
 }
 
-func attestationDelta(pBal *Balance, sqrtActiveCurrentEpoch uint64, v *Validator, prevEpoch, finalizedEpoch types.Epoch) (uint64, uint64) {
+func attestationDelta(pBal *Balance, sqrtActiveCurrentEpoch uint64, v *Validator, prevEpoch, finalizedEpoch types.Epoch) *AttestationDelta {
+	d := &AttestationDelta{}
 	if !EligibleForRewards(v) || pBal.ActiveCurrentEpoch == 0 {
-		return 0, 0
+		return d
 	}
 
 	baseRewardsPerEpoch := params.BeaconConfig().BaseRewardsPerEpoch
 	effectiveBalanceIncrement := params.BeaconConfig().EffectiveBalanceIncrement
 	vb := v.CurrentEpochEffectiveBalance
 	br := vb * params.BeaconConfig().BaseRewardFactor / sqrtActiveCurrentEpoch / baseRewardsPerEpoch
-	r, p := uint64(0), uint64(0)
 	currentEpochBalance := pBal.ActiveCurrentEpoch / effectiveBalanceIncrement
 
 	// Process source reward / penalty
 	if v.IsPrevEpochAttester && !v.IsSlashed {
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
 		maxAttesterReward := br - proposerReward
-		r += maxAttesterReward / uint64(v.InclusionDistance)
+		d.InclusionDelayReward = maxAttesterReward / uint64(v.InclusionDistance)
 
 		if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.SourceReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.SourceReward = rewardNumerator / currentEpochBalance
 
 		}
 	} else {
-		p += br
+		d.SourcePenalty = br
 	}
 
 	// Process target reward / penalty
@@ -111,13 +128,13 @@ func attestationDelta(pBal *Balance, sqrtActiveCurrentEpoch uint64, v *Validator
 		if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.TargetReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochTargetAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.TargetReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.TargetPenalty = br
 	}
 
 	// Process head reward / penalty
@@ -125,29 +142,29 @@ func attestationDelta(pBal *Balance, sqrtActiveCurrentEpoch uint64, v *Validator
 		if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.HeadReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochHeadAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.HeadReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.HeadPenalty = br
 	}
 
 	// Process finality delay penalty
 	if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
 		// If validator is performing optimally, this cancels all rewards for a neutral balance.
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
-		p += baseRewardsPerEpoch*br - proposerReward
+		d.InactivityPenalty = baseRewardsPerEpoch*br - proposerReward
 		// Apply an additional penalty to validators that did not vote on the correct target or has been slashed.
 		// Equivalent to the following condition from the spec:
 		// `index not in get_unslashed_attesting_indices(state, matching_target_attestations)`
 		if !v.IsPrevEpochTargetAttester || v.IsSlashed {
 			finalityDelay := helpers.FinalityDelay(prevEpoch, finalizedEpoch)
-			p += vb * uint64(finalityDelay) / params.BeaconConfig().InactivityPenaltyQuotient
+			d.InactivityPenalty += vb * uint64(finalityDelay) / params.BeaconConfig().InactivityPenaltyQuotient
 		}
 	}
-	return r, p
+	return d
 }
 
 // ProposersDelta computes and returns the rewards and penalties differences for individual validators based on the
@@ -350,3 +350,66 @@ func TestProposerDeltaPrecompute_SlashedCase(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, uint64(0), r[proposerIndex], "Unexpected proposer reward for slashed")
 }
+
+func TestAttestationsDeltaBreakdown(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	base := buildState(e+2, validatorCount)
+	var emptyRoot [32]byte
+	base.PreviousEpochAttestations = []*pb.PendingAttestation{
+		{
+			Data: &ethpb.AttestationData{
+				Target:          &ethpb.Checkpoint{Root: emptyRoot[:]},
+				Source:          &ethpb.Checkpoint{Root: emptyRoot[:]},
+				BeaconBlockRoot: emptyRoot[:],
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x00, 0x00, 0x00, 0x00, 0x01},
+			InclusionDelay:  2,
+		},
+	}
+	beaconState, err := stateV0.InitializeFromProto(base)
+	require.NoError(t, err)
+
+	vp, bp, err := New(context.Background(), beaconState)
+	require.NoError(t, err)
+	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	require.NoError(t, err)
+	deltas, err := AttestationsDeltaBreakdown(beaconState, bp, vp)
+	require.NoError(t, err)
+	rewards, penalties, err := AttestationsDelta(beaconState, bp, vp)
+	require.NoError(t, err)
+	require.Equal(t, len(rewards), len(deltas))
+	for i, d := range deltas {
+		assert.Equal(t, rewards[i], d.Reward(), "Unexpected reward of validator %d", i)
+		assert.Equal(t, penalties[i], d.Penalty(), "Unexpected penalty of validator %d", i)
+	}
+
+	attested := false
+	for i, v := range vp {
+		if !v.IsPrevEpochAttester {
+			continue
+		}
+		attested = true
+		br, err := epoch.BaseReward(beaconState, types.ValidatorIndex(i))
+		require.NoError(t, err)
+		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
+		assert.Equal(t, (br-proposerReward)/2, deltas[i].InclusionDelayReward)
+		assert.NotEqual(t, uint64(0), deltas[i].SourceReward)
+		assert.NotEqual(t, uint64(0), deltas[i].TargetReward)
+		assert.NotEqual(t, uint64(0), deltas[i].HeadReward)
+		assert.Equal(t, uint64(0), deltas[i].Penalty())
+	}
+	require.Equal(t, true, attested)
+
+	nonAttested := deltas[434]
+	br, err := epoch.BaseReward(beaconState, 434)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), nonAttested.Reward())
+	assert.Equal(t, br, nonAttested.SourcePenalty)
+	assert.Equal(t, br, nonAttested.TargetPenalty)
+	assert.Equal(t, br, nonAttested.HeadPenalty)
+	assert.Equal(t, uint64(0), nonAttested.InactivityPenalty)
+
+	_, err = AttestationsDeltaBreakdown(beaconState, bp, vp[1:])
+	assert.ErrorContains(t, "precomputed registries not the same length", err)
+}
@@ -60,3 +60,35 @@ type Balance struct {
 	// correctly for head block during prev epoch.
 	PrevEpochHeadAttested uint64
 }
+
+// AttestationDelta stores the rewards and penalties an individual validator receives for its attestation
+// in the previous epoch, broken down by the attestation duty they are applied for.
+type AttestationDelta struct {
+	// SourceReward is the reward for attesting to the correct source.
+	SourceReward uint64
+	// SourcePenalty is the penalty for not attesting to the correct source.
+	SourcePenalty uint64
+	// TargetReward is the reward for attesting to the correct target.
+	TargetReward uint64
+	// TargetPenalty is the penalty for not attesting to the correct target.
+	TargetPenalty uint64
+	// HeadReward is the reward for attesting to the correct head.
+	HeadReward uint64
+	// HeadPenalty is the penalty for not attesting to the correct head.
+	HeadPenalty uint64
+	// InclusionDelayReward is the reward for the attestation being included in a block, which
+	// decreases with the inclusion distance.
+	InclusionDelayReward uint64
+	// InactivityPenalty is the penalty applied while the chain is not finalizing.
+	InactivityPenalty uint64
+}
+
+// Reward returns the sum of the rewards.
+func (d *AttestationDelta) Reward() uint64 {
+	return d.SourceReward + d.TargetReward + d.HeadReward + d.InclusionDelayReward
+}
+
+// Penalty returns the sum of the penalties.
+func (d *AttestationDelta) Penalty() uint64 {
+	return d.SourcePenalty + d.TargetPenalty + d.HeadPenalty + d.InactivityPenalty
+}
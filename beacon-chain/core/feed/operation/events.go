@@ -18,6 +18,14 @@ const (
 
 	// ExitReceived is sent after an voluntary exit object has been received from the outside world (eg in RPC or sync)
 	ExitReceived
+
+	// ProposerSlashingReceived is sent after a proposer slashing object has been received from the outside world
+	// and inserted into the slashings pool. (eg. in RPC or sync)
+	ProposerSlashingReceived
+
+	// AttesterSlashingReceived is sent after an attester slashing object has been received from the outside world
+	// and inserted into the slashings pool. (eg. in RPC or sync)
+	AttesterSlashingReceived
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -37,3 +45,15 @@ type ExitReceivedData struct {
 	// Exit is the voluntary exit object.
 	Exit *ethpb.SignedVoluntaryExit
 }
+
+// ProposerSlashingReceivedData is the data sent with ProposerSlashingReceived events.
+type ProposerSlashingReceivedData struct {
+	// ProposerSlashing is the proposer slashing object.
+	ProposerSlashing *ethpb.ProposerSlashing
+}
+
+// AttesterSlashingReceivedData is the data sent with AttesterSlashingReceived events.
+type AttesterSlashingReceivedData struct {
+	// AttesterSlashing is the attester slashing object.
+	AttesterSlashing *ethpb.AttesterSlashing
+}
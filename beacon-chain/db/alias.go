@@ -1,6 +1,9 @@
 package db
 
-import "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
 
 // ReadOnlyDatabase exposes Prysm's eth2 data backend for read access only, no information about
 // head info. For head info, use github.com/prysmaticlabs/prysm/blockchain.HeadFetcher.
@@ -29,3 +32,11 @@ type SlasherDatabase = iface.SlasherDatabase
 // ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 // when one already exists in a database.
 var ErrExistingGenesisState = iface.ErrExistingGenesisState
+
+// ErrNotFoundOriginBlockRoot is returned when a database was not initialized from a
+// checkpoint and has no origin block root.
+var ErrNotFoundOriginBlockRoot = kv.ErrNotFoundOriginBlockRoot
+
+// ErrNotFoundBackfillBlockRoot is returned when no blocks have been backfilled below the
+// checkpoint origin yet.
+var ErrNotFoundBackfillBlockRoot = kv.ErrNotFoundBackfillBlockRoot
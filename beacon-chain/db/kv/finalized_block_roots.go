@@ -47,6 +47,7 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	root := checkpoint.Root
 	var previousRoot []byte
 	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
 
 	// De-index recent finalized block roots, to be re-indexed.
 	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
@@ -73,7 +74,7 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	}
 
 	// Walk up the ancestry chain until we reach a block root present in the finalized block roots
-	// index bucket or genesis block root.
+	// index bucket, the genesis block root or the checkpoint origin block root.
 	for {
 		if bytes.Equal(root, genesisRoot) {
 			break
@@ -125,6 +126,10 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 			}
 			break
 		}
+		// Blocks below the checkpoint origin are not expected to be in the database.
+		if originRoot != nil && bytes.Equal(root, originRoot) {
+			break
+		}
 		previousRoot = root
 		root = block.ParentRoot()
 	}
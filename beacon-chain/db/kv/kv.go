@@ -51,6 +51,9 @@ var blockedBuckets = [][]byte{
 // Config for the bolt db kv store.
 type Config struct {
 	InitialMMapSize int
+	// ReadOnly opens the database without write access, e.g. to inspect the database
+	// of a stopped beacon node. Buckets are neither created nor written to.
+	ReadOnly bool
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -61,6 +64,7 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorIndexCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	readOnly            bool
 	ctx                 context.Context
 }
 
@@ -80,6 +84,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		return nil, err
 	}
 	if !hasDir {
+		if config.ReadOnly {
+			return nil, errors.Errorf("database directory %s does not exist", dirPath)
+		}
 		if err := fileutil.MkdirAll(dirPath); err != nil {
 			return nil, err
 		}
@@ -91,6 +98,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		&bolt.Options{
 			Timeout:         1 * time.Second,
 			InitialMmapSize: config.InitialMMapSize,
+			ReadOnly:        config.ReadOnly,
 		},
 	)
 	if err != nil {
@@ -124,8 +132,12 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		blockCache:          blockCache,
 		validatorIndexCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
+		readOnly:            config.ReadOnly,
 		ctx:                 ctx,
 	}
+	if kv.readOnly {
+		return kv, prometheus.Register(createBoltCollector(kv.db))
+	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
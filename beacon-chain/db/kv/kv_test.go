@@ -2,8 +2,12 @@ package kv
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -16,3 +20,24 @@ func setupDB(t testing.TB) *Store {
 	})
 	return db
 }
+
+func TestNewKVStore_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	_, err := NewKVStore(ctx, filepath.Join(dir, "missing"), &Config{ReadOnly: true})
+	require.ErrorContains(t, "does not exist", err)
+
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(blk)))
+	require.NoError(t, db.Close())
+
+	db, err = NewKVStore(ctx, dir, &Config{ReadOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, true, db.HasBlock(ctx, root))
+	assert.NotNil(t, db.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(testutil.NewBeaconBlock())))
+	require.NoError(t, db.Close())
+}
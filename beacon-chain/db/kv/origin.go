@@ -0,0 +1,171 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	state "github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ErrNotFoundOriginBlockRoot is returned when the database was not initialized from a
+// checkpoint, and therefore has no origin block root.
+var ErrNotFoundOriginBlockRoot = errors.New("origin block root not found in db")
+
+// ErrNotFoundBackfillBlockRoot is returned when no blocks have been backfilled yet.
+var ErrNotFoundBackfillBlockRoot = errors.New("backfill block root not found in db")
+
+// SaveOrigin bootstraps the database from a trusted, finalized beacon state and its block,
+// both given as ssz encoded bytes. The block becomes the origin of the chain: it is saved as
+// head, justified and finalized checkpoint so that the node can sync forward from it instead
+// of from genesis. This should only be run on a database which has not synced any blocks.
+func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOrigin")
+	defer span.End()
+
+	st := &pbp2p.BeaconState{}
+	if err := st.UnmarshalSSZ(serState); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(serBlock); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	originState, err := state.InitializeFromProtoUnsafe(st)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize checkpoint state from proto")
+	}
+	if !helpers.IsEpochStart(originState.Slot()) {
+		return fmt.Errorf("checkpoint state slot %d is not at an epoch boundary", originState.Slot())
+	}
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute checkpoint block root")
+	}
+
+	// The latest block header of the state must point to the given block. The state root of
+	// the header is only filled in at the next slot, so compute it here if it is still empty.
+	latestHeader := originState.LatestBlockHeader()
+	if bytes.Equal(latestHeader.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := originState.HashTreeRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not compute checkpoint state root")
+		}
+		latestHeader.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := latestHeader.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute latest block header root")
+	}
+	if headerRoot != blockRoot {
+		return fmt.Errorf("checkpoint block root %#x does not match the latest block header of the "+
+			"checkpoint state %#x", blockRoot, headerRoot)
+	}
+
+	// Refuse a checkpoint from a different network than a genesis state we already know about.
+	genesisState, err := s.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if genesisState != nil && !genesisState.IsNil() &&
+		!bytes.Equal(genesisState.GenesisValidatorRoot(), originState.GenesisValidatorRoot()) {
+		return fmt.Errorf("checkpoint state genesis validators root %#x does not match the genesis "+
+			"validators root in the database %#x", originState.GenesisValidatorRoot(), genesisState.GenesisValidatorRoot())
+	}
+
+	if err := s.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(blk)); err != nil {
+		return errors.Wrap(err, "could not save checkpoint block")
+	}
+	if err := s.SaveState(ctx, originState, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := s.SaveStateSummary(ctx, &pbp2p.StateSummary{
+		Slot: originState.Slot(),
+		Root: blockRoot[:],
+	}); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state summary")
+	}
+	if err := s.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	if err := s.saveOriginCheckpointBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save origin block root")
+	}
+
+	checkpoint := &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(originState.Slot()),
+		Root:  blockRoot[:],
+	}
+	if err := s.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not mark checkpoint block as justified")
+	}
+	if err := s.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not mark checkpoint block as finalized")
+	}
+	return nil
+}
+
+// OriginCheckpointBlockRoot returns the root of the block the database was initialized
+// from with SaveOrigin. ErrNotFoundOriginBlockRoot is returned for databases which were
+// started from genesis.
+func (s *Store) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginCheckpointBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
+		if enc == nil {
+			return ErrNotFoundOriginBlockRoot
+		}
+		root = bytesutil.ToBytes32(enc)
+		return nil
+	})
+	return root, err
+}
+
+func (s *Store) saveOriginCheckpointBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.saveOriginCheckpointBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(blocksBucket).Put(originCheckpointBlockRootKey, blockRoot[:])
+	})
+}
+
+// BackfillBlockRoot returns the root of the oldest block saved while backfilling the chain
+// history below the checkpoint origin. ErrNotFoundBackfillBlockRoot is returned if no blocks
+// have been backfilled yet.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(blocksBucket).Get(backfillBlockRootKey)
+		if enc == nil {
+			return ErrNotFoundBackfillBlockRoot
+		}
+		root = bytesutil.ToBytes32(enc)
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlockRoot records the root of the oldest block saved while backfilling, so
+// that backfilling can resume from it after a restart.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(blocksBucket).Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
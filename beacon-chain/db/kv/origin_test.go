@@ -0,0 +1,126 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// originStateAndBlock returns an ssz encoded state at the given slot and the ssz encoded
+// block which was last applied to it.
+func originStateAndBlock(t *testing.T, slot types.Slot) ([]byte, []byte, [32]byte) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{0x01}, 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	return serState, serBlock, blockRoot
+}
+
+func TestStore_SaveOrigin(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	slot := params.BeaconConfig().SlotsPerEpoch * 10
+	serState, serBlock, blockRoot := originStateAndBlock(t, slot)
+
+	require.NoError(t, db.SaveOrigin(ctx, serState, serBlock))
+
+	originRoot, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, headRoot)
+
+	for _, getter := range []func(context.Context) (*ethpb.Checkpoint, error){db.FinalizedCheckpoint, db.JustifiedCheckpoint} {
+		cp, err := getter(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, types.Epoch(10), cp.Epoch)
+		assert.DeepEqual(t, blockRoot[:], cp.Root)
+	}
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, blockRoot))
+
+	st, err := db.State(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, slot, st.Slot())
+	summary, err := db.StateSummary(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, slot, summary.Slot)
+}
+
+func TestStore_SaveOrigin_NotEpochBoundary(t *testing.T) {
+	db := setupDB(t)
+	serState, serBlock, _ := originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch+1)
+	err := db.SaveOrigin(context.Background(), serState, serBlock)
+	assert.ErrorContains(t, "is not at an epoch boundary", err)
+}
+
+func TestStore_SaveOrigin_MismatchedBlock(t *testing.T) {
+	db := setupDB(t)
+	serState, _, _ := originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch)
+	_, otherBlock, _ := originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch*2)
+	err := db.SaveOrigin(context.Background(), serState, otherBlock)
+	assert.ErrorContains(t, "does not match the latest block header", err)
+}
+
+func TestStore_SaveOrigin_MismatchedGenesis(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, gs.SetGenesisValidatorRoot(bytesutil.PadTo([]byte{0x02}, 32)))
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+
+	serState, serBlock, _ := originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch)
+	err = db.SaveOrigin(ctx, serState, serBlock)
+	assert.ErrorContains(t, "does not match the genesis validators root", err)
+}
+
+func TestStore_OriginCheckpointBlockRoot_NotFound(t *testing.T) {
+	db := setupDB(t)
+	_, err := db.OriginCheckpointBlockRoot(context.Background())
+	assert.Equal(t, true, errors.Is(err, ErrNotFoundOriginBlockRoot))
+}
+
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	_, err := db.BackfillBlockRoot(ctx)
+	assert.Equal(t, true, errors.Is(err, ErrNotFoundBackfillBlockRoot))
+
+	root := bytesutil.ToBytes32([]byte{0x03})
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, root))
+	got, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, got)
+}
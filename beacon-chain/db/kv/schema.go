@@ -42,6 +42,11 @@ var (
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
 
+	// Block root of the finalized checkpoint a node was started from instead of genesis.
+	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
+	// Root of the oldest block saved by backfilling the history below the checkpoint origin.
+	backfillBlockRootKey = []byte("backfill-block-root")
+
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
@@ -91,7 +91,11 @@ func (s *Store) stateSummaryBytes(ctx context.Context, blockRoot [32]byte) ([]by
 }
 
 // This saves all cached state summary objects to DB, and clears up the cache.
+// A read only store keeps the state summaries in the cache.
 func (s *Store) saveCachedStateSummariesDB(ctx context.Context) error {
+	if s.readOnly {
+		return nil
+	}
 	summaries := s.stateSummaryCache.getAll()
 	encs := make([][]byte, len(summaries))
 	for i, s := range summaries {
@@ -36,6 +36,10 @@ func (s *Store) PruneProposals(
 		proposalBkt := tx.Bucket(proposalRecordsBucket)
 		c := proposalBkt.Cursor()
 		k, _ := c.First()
+		if k == nil {
+			lowestSlot = endPruneSlot
+			return nil
+		}
 		lowestSlot = slotFromProposalKey(k)
 		return nil
 	}); err != nil {
@@ -99,6 +103,8 @@ func (s *Store) PruneProposals(
 					return nil
 				}
 			}
+			// The bucket holds no more proposals to prune.
+			epochAtCursor = endEpoch
 			return nil
 		}); err != nil {
 			return err
@@ -125,6 +131,10 @@ func (s *Store) PruneAttestations(
 		bkt := tx.Bucket(attestationDataRootsBucket)
 		c := bkt.Cursor()
 		k, _ := c.First()
+		if k == nil {
+			lowestEpoch = endPruneEpoch
+			return nil
+		}
 		lowestEpoch = types.Epoch(binary.LittleEndian.Uint64(k))
 		return nil
 	}); err != nil {
@@ -191,6 +201,8 @@ func (s *Store) PruneAttestations(
 					return nil
 				}
 			}
+			// The bucket holds no more attestations to prune.
+			epochAtCursor = endPruneEpoch
 			return nil
 		}); err != nil {
 			return err
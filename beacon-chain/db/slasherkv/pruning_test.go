@@ -31,6 +31,16 @@ func TestStore_PruneProposals(t *testing.T) {
 		require.LogsContain(t, hook, "Current epoch 1 < history length 2, nothing to prune")
 	})
 
+	// If no proposals are stored, there is nothing to prune.
+	t.Run("empty_database", func(t *testing.T) {
+		beaconDB := setupDB(t)
+		epochPruningIncrements := types.Epoch(100)
+		currentEpoch := types.Epoch(20)
+		historyLength := types.Epoch(10)
+		err := beaconDB.PruneProposals(ctx, currentEpoch, epochPruningIncrements, historyLength)
+		require.NoError(t, err)
+	})
+
 	// If the lowest stored epoch in the database is >= the end epoch of the pruning process,
 	// there is nothing to prune, so we also expect exiting early.
 	t.Run("lowest_stored_epoch_greater_than_pruning_limit_epoch", func(t *testing.T) {
@@ -166,6 +176,16 @@ func TestStore_PruneAttestations_OK(t *testing.T) {
 		require.LogsContain(t, hook, "Current epoch 1 < history length 2, nothing to prune")
 	})
 
+	// If no attestations are stored, there is nothing to prune.
+	t.Run("empty_database", func(t *testing.T) {
+		beaconDB := setupDB(t)
+		epochPruningIncrements := types.Epoch(100)
+		currentEpoch := types.Epoch(20)
+		historyLength := types.Epoch(10)
+		err := beaconDB.PruneAttestations(ctx, currentEpoch, epochPruningIncrements, historyLength)
+		require.NoError(t, err)
+	})
+
 	// If the lowest stored epoch in the database is >= the end epoch of the pruning process,
 	// there is nothing to prune, so we also expect exiting early.
 	t.Run("lowest_stored_epoch_greater_than_pruning_limit_epoch", func(t *testing.T) {
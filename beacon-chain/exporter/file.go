@@ -0,0 +1,149 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// FileSink appends records to a newline-delimited JSON file. The file itself is the
+// cursor of the sink: the sequence number of the last line is where the exporter resumes.
+type FileSink struct {
+	lock   sync.Mutex
+	file   *os.File
+	size   int64
+	cursor uint64
+}
+
+// NewFileSink opens or creates a newline-delimited JSON file of records. An incomplete last line,
+// left by a beacon node which stopped in the middle of a write, is removed.
+func NewFileSink(path string) (*FileSink, error) {
+	expanded, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(expanded), params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory of %s", expanded)
+	}
+	file, err := os.OpenFile(expanded, os.O_RDWR|os.O_CREATE, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open %s", expanded)
+	}
+	cursor, size, err := recoverFile(file)
+	if err != nil {
+		if closeErr := file.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close file")
+		}
+		return nil, errors.Wrapf(err, "could not read last record of %s", expanded)
+	}
+	return &FileSink{file: file, size: size, cursor: cursor}, nil
+}
+
+// recoverFile truncates an incomplete last line, positions the file at its end and
+// returns the sequence number of the last record along with the size of the file.
+func recoverFile(file *os.File) (uint64, int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	size := info.Size()
+	var lastLine []byte
+	// Read the end of the file in growing chunks until the last complete line is found.
+	for chunkSize := int64(64 * 1024); ; chunkSize *= 2 {
+		offset := size - chunkSize
+		if offset < 0 {
+			offset = 0
+		}
+		chunk := make([]byte, size-offset)
+		if _, err := file.ReadAt(chunk, offset); err != nil && err != io.EOF {
+			return 0, 0, err
+		}
+		end := bytes.LastIndexByte(chunk, '\n')
+		if end < 0 && offset > 0 {
+			continue
+		}
+		// Everything after the last newline is an incomplete line.
+		size = offset + int64(end) + 1
+		if end < 0 {
+			break
+		}
+		start := bytes.LastIndexByte(chunk[:end], '\n')
+		if start < 0 && offset > 0 {
+			continue
+		}
+		lastLine = chunk[start+1 : end]
+		break
+	}
+	if err := truncateFile(file, size); err != nil {
+		return 0, 0, err
+	}
+	if len(lastLine) == 0 {
+		return 0, size, nil
+	}
+	record := &Record{}
+	if err := json.Unmarshal(lastLine, record); err != nil {
+		return 0, 0, err
+	}
+	return record.Sequence, size, nil
+}
+
+func truncateFile(file *os.File, size int64) error {
+	if err := file.Truncate(size); err != nil {
+		return err
+	}
+	_, err := file.Seek(size, io.SeekStart)
+	return err
+}
+
+// Cursor returns the sequence number of the last record in the file.
+func (s *FileSink) Cursor(_ context.Context) (uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.cursor, nil
+}
+
+// Write appends the records which are not in the file yet and syncs the file to disk.
+func (s *FileSink) Write(_ context.Context, records []*Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	records = recordsAfter(records, s.cursor)
+	if len(records) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return errors.Wrap(err, "could not encode record")
+		}
+	}
+	_, err := s.file.Write(buf.Bytes())
+	if err == nil {
+		err = s.file.Sync()
+	}
+	if err != nil {
+		// Remove any partially written line, so that the records can be written again.
+		if truncateErr := truncateFile(s.file, s.size); truncateErr != nil {
+			log.WithError(truncateErr).Error("Could not truncate file")
+		}
+		return errors.Wrap(err, "could not write records")
+	}
+	s.size += int64(buf.Len())
+	s.cursor = records[len(records)-1].Sequence
+	return nil
+}
+
+// Close the file.
+func (s *FileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.file.Close()
+}
@@ -0,0 +1,98 @@
+package exporter
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testRecords(from, to uint64) []*Record {
+	records := make([]*Record, 0, to-from+1)
+	for seq := from; seq <= to; seq++ {
+		records = append(records, &Record{Sequence: seq, Kind: BlockKind, Key: "key", Data: []byte("{}")})
+	}
+	return records
+}
+
+func TestFileSink_WriteAndResume(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "export", "records.ndjson")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	cursor, err := sink.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), cursor)
+
+	require.NoError(t, sink.Write(ctx, testRecords(1, 3)))
+	// Records which were already written are skipped.
+	require.NoError(t, sink.Write(ctx, testRecords(2, 4)))
+	require.NoError(t, sink.Close())
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	cursor, err = sink.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), cursor)
+	require.NoError(t, sink.Write(ctx, testRecords(5, 5)))
+	require.NoError(t, sink.Close())
+
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n")
+	require.Equal(t, 5, len(lines))
+	assert.Equal(t, `{"sequence":1,"kind":"block","key":"key","data":{}}`, lines[0])
+	assert.Equal(t, `{"sequence":5,"kind":"block","key":"key","data":{}}`, lines[4])
+}
+
+func TestFileSink_RemovesIncompleteLine(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "records.ndjson")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(ctx, testRecords(1, 2)))
+	require.NoError(t, sink.Close())
+
+	// Simulate a beacon node which stopped in the middle of writing the third record.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"sequence":3,"kind":"blo`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	cursor, err := sink.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cursor)
+	require.NoError(t, sink.Write(ctx, testRecords(3, 3)))
+	require.NoError(t, sink.Close())
+
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n")
+	require.Equal(t, 3, len(lines))
+	assert.Equal(t, `{"sequence":3,"kind":"block","key":"key","data":{}}`, lines[2])
+}
+
+func TestFileSink_LongLastLine(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "records.ndjson")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	long := &Record{Sequence: 1, Kind: BlockKind, Key: strings.Repeat("a", 200*1024), Data: []byte("{}")}
+	require.NoError(t, sink.Write(ctx, []*Record{long}))
+	require.NoError(t, sink.Close())
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	cursor, err := sink.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), cursor)
+	require.NoError(t, sink.Close())
+}
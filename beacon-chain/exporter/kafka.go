@@ -0,0 +1,154 @@
+// +build kafka_enabled
+
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	_ "gopkg.in/confluentinc/confluent-kafka-go.v1/kafka/librdkafka" // Required for c++ kafka library.
+)
+
+// All records are produced to a single partition, which keeps them in order.
+const kafkaPartition = 0
+
+const kafkaTimeout = 10 * time.Second
+
+// KafkaSink produces records to a Kafka topic, keyed by the record keys. The last message
+// of the topic is the cursor of the sink. The producer is idempotent, so that retries
+// within the producer neither duplicate nor reorder records.
+type KafkaSink struct {
+	bootstrapServers string
+	topic            string
+	producer         *kafka.Producer
+	lock             sync.Mutex
+	cursor           uint64
+}
+
+// NewKafkaSink creates a sink which produces records to the topic.
+func NewKafkaSink(bootstrapServers, topic string) (Sink, error) {
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":  bootstrapServers,
+		"enable.idempotence": true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create Kafka producer")
+	}
+	return &KafkaSink{
+		bootstrapServers: bootstrapServers,
+		topic:            topic,
+		producer:         producer,
+	}, nil
+}
+
+// Cursor reads the sequence number of the last message of the topic.
+func (s *KafkaSink) Cursor(_ context.Context) (uint64, error) {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  s.bootstrapServers,
+		"group.id":           "prysm-exporter-cursor",
+		"enable.auto.commit": false,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not create Kafka consumer")
+	}
+	defer func() {
+		if err := consumer.Close(); err != nil {
+			log.WithError(err).Error("Could not close Kafka consumer")
+		}
+	}()
+
+	_, high, err := consumer.QueryWatermarkOffsets(s.topic, kafkaPartition, int(kafkaTimeout.Milliseconds()))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not query offsets")
+	}
+	var cursor uint64
+	if high > 0 {
+		if err := consumer.Assign([]kafka.TopicPartition{{
+			Topic:     &s.topic,
+			Partition: kafkaPartition,
+			Offset:    kafka.Offset(high - 1),
+		}}); err != nil {
+			return 0, errors.Wrap(err, "could not assign partition")
+		}
+		msg, err := consumer.ReadMessage(kafkaTimeout)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not read last message")
+		}
+		record := &Record{}
+		if err := json.Unmarshal(msg.Value, record); err != nil {
+			return 0, errors.Wrap(err, "could not decode last message")
+		}
+		cursor = record.Sequence
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cursor = cursor
+	return cursor, nil
+}
+
+// Write produces the records which were not delivered yet and waits for their delivery.
+func (s *KafkaSink) Write(ctx context.Context, records []*Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	records = recordsAfter(records, s.cursor)
+	if len(records) == 0 {
+		return nil
+	}
+	deliveries := make(chan kafka.Event, len(records))
+	for i, record := range records {
+		value, err := json.Marshal(record)
+		if err != nil {
+			return errors.Wrap(err, "could not encode record")
+		}
+		if err := s.producer.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &s.topic, Partition: kafkaPartition},
+			Key:            []byte(record.Key),
+			Value:          value,
+			Opaque:         i,
+		}, deliveries); err != nil {
+			return errors.Wrap(err, "could not produce record")
+		}
+	}
+
+	delivered := make([]bool, len(records))
+	var deliveryErr error
+	for range records {
+		select {
+		case event := <-deliveries:
+			msg, ok := event.(*kafka.Message)
+			if !ok {
+				continue
+			}
+			if msg.TopicPartition.Error != nil {
+				deliveryErr = msg.TopicPartition.Error
+				continue
+			}
+			delivered[msg.Opaque.(int)] = true
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	// Records are delivered in order, so the cursor is the last record of the delivered prefix.
+	for i := range records {
+		if !delivered[i] {
+			break
+		}
+		s.cursor = records[i].Sequence
+	}
+	if deliveryErr != nil {
+		return errors.Wrap(deliveryErr, "could not deliver records")
+	}
+	return nil
+}
+
+// Close flushes and closes the producer.
+func (s *KafkaSink) Close() error {
+	s.producer.Flush(int(kafkaTimeout.Milliseconds()))
+	s.producer.Close()
+	return nil
+}
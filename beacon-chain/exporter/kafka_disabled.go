@@ -0,0 +1,11 @@
+// +build !kafka_enabled
+
+package exporter
+
+import "github.com/pkg/errors"
+
+// NewKafkaSink returns an error, as the beacon node was built without Kafka support.
+// Build with the kafka_enabled tag to export records to Kafka.
+func NewKafkaSink(_, _ string) (Sink, error) {
+	return nil, errors.New("beacon node was built without Kafka support, build with --config=kafka_enabled")
+}
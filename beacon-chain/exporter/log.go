@@ -1,4 +1,4 @@
-package kafka
+package exporter
 
 import "github.com/sirupsen/logrus"
 
@@ -0,0 +1,25 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	exportedRecords = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "exporter_records_exported_total",
+		Help: "The number of records written to the export sink.",
+	})
+	droppedRecords = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "exporter_records_dropped_total",
+		Help: "The number of records dropped because the export queue was full.",
+	})
+	failedWrites = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "exporter_failed_writes_total",
+		Help: "The number of failed writes to the export sink.",
+	})
+	queuedRecords = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "exporter_records_queued",
+		Help: "The number of records waiting to be written to the export sink.",
+	})
+)
@@ -0,0 +1,179 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Kinds of the exported records.
+const (
+	BlockKind               = "block"
+	AttestationKind         = "attestation"
+	FinalizedCheckpointKind = "finalized_checkpoint"
+	ChainReorgKind          = "chain_reorg"
+	ProposerSlashingKind    = "proposer_slashing"
+	AttesterSlashingKind    = "attester_slashing"
+)
+
+var marshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+// Record is a single exported object. Records are written in order of their sequence
+// numbers, which start at 1 and are never reused by a sink, so that consumers can resume
+// from the last sequence number they processed. The key identifies the exported object,
+// so that consumers can recognize an object which was exported more than once, for
+// example after a restart of the beacon node.
+type Record struct {
+	Sequence uint64          `json:"sequence"`
+	Kind     string          `json:"kind"`
+	Key      string          `json:"key"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func newRecord(kind, key string, msg proto.Message) (*Record, error) {
+	data, err := marshaler.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal %s", kind)
+	}
+	return &Record{Kind: kind, Key: key, Data: data}, nil
+}
+
+// blockRecords converts an event of the block feed into records. The slashings included
+// in a block are exported along with the block.
+func blockRecords(event *feed.Event) ([]*Record, error) {
+	if event.Type != blockfeed.ReceivedBlock {
+		return nil, nil
+	}
+	data, ok := event.Data.(*blockfeed.ReceivedBlockData)
+	if !ok || data.SignedBlock == nil || data.SignedBlock.IsNil() {
+		return nil, nil
+	}
+	root, err := data.SignedBlock.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash tree root block")
+	}
+	v1Block, err := migration.SignedBeaconBlock(data.SignedBlock)
+	if err != nil {
+		return nil, err
+	}
+	blockRecord, err := newRecord(BlockKind, fmt.Sprintf("%#x", root), v1Block)
+	if err != nil {
+		return nil, err
+	}
+	records := []*Record{blockRecord}
+	body := data.SignedBlock.Block().Body()
+	for _, slashing := range body.ProposerSlashings() {
+		record, err := proposerSlashingRecord(slashing)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	for _, slashing := range body.AttesterSlashings() {
+		record, err := attesterSlashingRecord(slashing)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// operationRecords converts an event of the operation feed into records.
+func operationRecords(event *feed.Event) ([]*Record, error) {
+	var record *Record
+	var err error
+	switch event.Type {
+	case operation.UnaggregatedAttReceived:
+		data, ok := event.Data.(*operation.UnAggregatedAttReceivedData)
+		if !ok || data.Attestation == nil {
+			return nil, nil
+		}
+		record, err = attestationRecord(data.Attestation)
+	case operation.AggregatedAttReceived:
+		data, ok := event.Data.(*operation.AggregatedAttReceivedData)
+		if !ok || data.Attestation == nil || data.Attestation.Aggregate == nil {
+			return nil, nil
+		}
+		record, err = attestationRecord(data.Attestation.Aggregate)
+	case operation.ProposerSlashingReceived:
+		data, ok := event.Data.(*operation.ProposerSlashingReceivedData)
+		if !ok || data.ProposerSlashing == nil {
+			return nil, nil
+		}
+		record, err = proposerSlashingRecord(data.ProposerSlashing)
+	case operation.AttesterSlashingReceived:
+		data, ok := event.Data.(*operation.AttesterSlashingReceivedData)
+		if !ok || data.AttesterSlashing == nil {
+			return nil, nil
+		}
+		record, err = attesterSlashingRecord(data.AttesterSlashing)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*Record{record}, nil
+}
+
+// stateRecords converts an event of the state feed into records.
+func stateRecords(event *feed.Event) ([]*Record, error) {
+	var record *Record
+	var err error
+	switch event.Type {
+	case statefeed.FinalizedCheckpoint:
+		data, ok := event.Data.(*ethpbv1.EventFinalizedCheckpoint)
+		if !ok {
+			return nil, nil
+		}
+		record, err = newRecord(FinalizedCheckpointKind, fmt.Sprintf("%d/%#x", data.Epoch, data.Block), data)
+	case statefeed.Reorg:
+		data, ok := event.Data.(*ethpbv1.EventChainReorg)
+		if !ok {
+			return nil, nil
+		}
+		key := fmt.Sprintf("%d/%#x/%#x", data.Slot, data.OldHeadBlock, data.NewHeadBlock)
+		record, err = newRecord(ChainReorgKind, key, data)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*Record{record}, nil
+}
+
+func attestationRecord(att *ethpb.Attestation) (*Record, error) {
+	root, err := att.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash tree root attestation")
+	}
+	return newRecord(AttestationKind, fmt.Sprintf("%#x", root), migration.V1Alpha1AttestationToV1(att))
+}
+
+func proposerSlashingRecord(slashing *ethpb.ProposerSlashing) (*Record, error) {
+	root, err := slashing.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash tree root proposer slashing")
+	}
+	return newRecord(ProposerSlashingKind, fmt.Sprintf("%#x", root), migration.V1Alpha1ProposerSlashingToV1(slashing))
+}
+
+func attesterSlashingRecord(slashing *ethpb.AttesterSlashing) (*Record, error) {
+	root, err := slashing.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash tree root attester slashing")
+	}
+	return newRecord(AttesterSlashingKind, fmt.Sprintf("%#x", root), migration.V1Alpha1AttSlashingToV1(slashing))
+}
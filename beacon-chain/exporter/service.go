@@ -0,0 +1,251 @@
+// Package exporter defines a service which exports blocks, finalized checkpoints,
+// chain reorgs, attestations and slashings seen by the beacon node to a sink for
+// data analysis. Records are written in order, numbered with sequence numbers that
+// continue from the cursor of the sink, so that the export resumes where it stopped
+// when the beacon node restarts.
+package exporter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+const (
+	defaultMaxQueueSize  = 1 << 16
+	defaultBatchSize     = 256
+	defaultRetryInterval = 5 * time.Second
+	// Number of record keys remembered to skip objects which were already exported.
+	exportedKeysSize = 1 << 16
+)
+
+// Config options for the exporter service.
+type Config struct {
+	StateNotifier     statefeed.Notifier
+	BlockNotifier     blockfeed.Notifier
+	OperationNotifier opfeed.Notifier
+	Sink              Sink
+	// MaxQueueSize is the maximum number of records waiting to be written. Records which
+	// do not fit into the queue, because the sink is unavailable, are dropped.
+	MaxQueueSize int
+	// BatchSize is the maximum number of records written at once.
+	BatchSize int
+	// RetryInterval is the interval between attempts to write to an unavailable sink.
+	RetryInterval time.Duration
+}
+
+// Service exports the events of the block, state and operation feeds to a sink.
+type Service struct {
+	cfg          *Config
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	exportedKeys *lru.Cache
+	queueLock    sync.Mutex
+	queue        []*Record
+	queueSignal  chan struct{}
+	errLock      sync.RWMutex
+	err          error
+}
+
+// NewService creates an exporter service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Sink == nil {
+		return nil, errors.New("no sink configured")
+	}
+	if cfg.MaxQueueSize <= 0 {
+		cfg.MaxQueueSize = defaultMaxQueueSize
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = defaultRetryInterval
+	}
+	exportedKeys, err := lru.New(exportedKeysSize)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:          cfg,
+		ctx:          ctx,
+		cancel:       cancel,
+		exportedKeys: exportedKeys,
+		queueSignal:  make(chan struct{}, 1),
+	}, nil
+}
+
+// Start subscribes to the feeds and exports their events.
+func (s *Service) Start() {
+	blockChan := make(chan *feed.Event, 1)
+	blockSub := s.cfg.BlockNotifier.BlockFeed().Subscribe(blockChan)
+	opsChan := make(chan *feed.Event, 1)
+	opsSub := s.cfg.OperationNotifier.OperationFeed().Subscribe(opsChan)
+	stateChan := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChan)
+
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		defer blockSub.Unsubscribe()
+		defer opsSub.Unsubscribe()
+		defer stateSub.Unsubscribe()
+		for {
+			select {
+			case event := <-blockChan:
+				s.enqueueEvent(event, blockRecords)
+			case event := <-opsChan:
+				s.enqueueEvent(event, operationRecords)
+			case event := <-stateChan:
+				s.enqueueEvent(event, stateRecords)
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		s.exportRecords()
+	}()
+}
+
+// Stop the service and close the sink. Records which were not written yet are dropped.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	return s.cfg.Sink.Close()
+}
+
+// Status returns the error of the last failed write to the sink, if the sink did not recover yet.
+func (s *Service) Status() error {
+	s.errLock.RLock()
+	defer s.errLock.RUnlock()
+	return s.err
+}
+
+func (s *Service) setStatus(err error) {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+	s.err = err
+}
+
+func (s *Service) enqueueEvent(event *feed.Event, toRecords func(event *feed.Event) ([]*Record, error)) {
+	records, err := toRecords(event)
+	if err != nil {
+		log.WithError(err).Error("Could not convert event to records")
+		return
+	}
+	s.queueLock.Lock()
+	defer s.queueLock.Unlock()
+	for _, record := range records {
+		key := record.Kind + "/" + record.Key
+		if s.exportedKeys.Contains(key) {
+			continue
+		}
+		if len(s.queue) >= s.cfg.MaxQueueSize {
+			droppedRecords.Inc()
+			log.WithField("kind", record.Kind).Warn("Export queue is full, dropping record")
+			continue
+		}
+		s.exportedKeys.Add(key, true)
+		s.queue = append(s.queue, record)
+	}
+	queuedRecords.Set(float64(len(s.queue)))
+	select {
+	case s.queueSignal <- struct{}{}:
+	default:
+	}
+}
+
+// nextBatch waits for queued records and removes up to a batch of them from the queue.
+// It returns nil when the service is stopped.
+func (s *Service) nextBatch() []*Record {
+	for {
+		s.queueLock.Lock()
+		if len(s.queue) > 0 {
+			size := len(s.queue)
+			if size > s.cfg.BatchSize {
+				size = s.cfg.BatchSize
+			}
+			batch := make([]*Record, size)
+			copy(batch, s.queue)
+			s.queue = s.queue[size:]
+			queuedRecords.Set(float64(len(s.queue)))
+			s.queueLock.Unlock()
+			return batch
+		}
+		s.queueLock.Unlock()
+		select {
+		case <-s.queueSignal:
+		case <-s.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// exportRecords writes the queued records to the sink, numbering them after the cursor of the sink.
+// A batch which could not be written is retried until it is written, so that no record is skipped.
+// Before each retry, the records which the sink received in spite of the failure are removed from
+// the batch.
+func (s *Service) exportRecords() {
+	cursor, ok := s.sinkCursor()
+	if !ok {
+		return
+	}
+	log.WithField("cursor", cursor).Info("Exporting records")
+	for {
+		batch := s.nextBatch()
+		if batch == nil {
+			return
+		}
+		for _, record := range batch {
+			cursor++
+			record.Sequence = cursor
+		}
+		for len(batch) > 0 {
+			err := s.cfg.Sink.Write(s.ctx, batch)
+			if err == nil {
+				exportedRecords.Add(float64(len(batch)))
+				s.setStatus(nil)
+				break
+			}
+			failedWrites.Inc()
+			s.setStatus(err)
+			log.WithError(err).Error("Could not write records, retrying")
+			select {
+			case <-time.After(s.cfg.RetryInterval):
+			case <-s.ctx.Done():
+				return
+			}
+			if sinkCursor, err := s.cfg.Sink.Cursor(s.ctx); err == nil {
+				batch = recordsAfter(batch, sinkCursor)
+			}
+		}
+	}
+}
+
+// sinkCursor retrieves the cursor of the sink, retrying until the sink is available.
+func (s *Service) sinkCursor() (uint64, bool) {
+	for {
+		cursor, err := s.cfg.Sink.Cursor(s.ctx)
+		if err == nil {
+			s.setStatus(nil)
+			return cursor, true
+		}
+		s.setStatus(err)
+		log.WithError(err).Error("Could not retrieve cursor of sink, retrying")
+		select {
+		case <-time.After(s.cfg.RetryInterval):
+		case <-s.ctx.Done():
+			return 0, false
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupService(t *testing.T, sink Sink) (*Service, *mockChain.ChainService) {
+	chainService := &mockChain.ChainService{}
+	s, err := NewService(context.Background(), &Config{
+		StateNotifier:     chainService.StateNotifier(),
+		BlockNotifier:     chainService.BlockNotifier(),
+		OperationNotifier: chainService.OperationNotifier(),
+		Sink:              sink,
+		RetryInterval:     10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	s.Start()
+	return s, chainService
+}
+
+func waitForCursor(t *testing.T, sink Sink, cursor uint64) {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		current, err := sink.Cursor(context.Background())
+		if err == nil && current == cursor {
+			return
+		}
+	}
+	t.Fatalf("Cursor of sink did not reach %d", cursor)
+}
+
+func readRecords(t *testing.T, path string) []*Record {
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	var records []*Record
+	for _, line := range strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n") {
+		record := &Record{}
+		require.NoError(t, json.Unmarshal([]byte(line), record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestService_ExportsFeedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "records.ndjson")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	s, chainService := setupService(t, sink)
+
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{Slot: 1},
+		}),
+	}
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{}),
+		Attestation_2: testutil.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{Slot: 1},
+		}),
+	}
+	att := testutil.HydrateAttestation(&ethpb.Attestation{})
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 5
+	block.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{proposerSlashing}
+	blockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	blockFeed := chainService.BlockNotifier().BlockFeed()
+	opFeed := chainService.OperationNotifier().OperationFeed()
+	stateFeed := chainService.StateNotifier().StateFeed()
+	blockFeed.Send(&feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: interfaces.WrappedPhase0SignedBeaconBlock(block)},
+	})
+	waitForCursor(t, sink, 2)
+	opFeed.Send(&feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{Attestation: att},
+	})
+	waitForCursor(t, sink, 3)
+	// Objects which were already exported are skipped.
+	opFeed.Send(&feed.Event{
+		Type: operation.AggregatedAttReceived,
+		Data: &operation.AggregatedAttReceivedData{Attestation: &ethpb.AggregateAttestationAndProof{Aggregate: att}},
+	})
+	opFeed.Send(&feed.Event{
+		Type: operation.ProposerSlashingReceived,
+		Data: &operation.ProposerSlashingReceivedData{ProposerSlashing: proposerSlashing},
+	})
+	opFeed.Send(&feed.Event{
+		Type: operation.AttesterSlashingReceived,
+		Data: &operation.AttesterSlashingReceivedData{AttesterSlashing: attesterSlashing},
+	})
+	waitForCursor(t, sink, 4)
+	// Events which are not exported are ignored.
+	stateFeed.Send(&feed.Event{Type: statefeed.NewHead, Data: &ethpbv1.EventHead{Slot: 5}})
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &ethpbv1.EventFinalizedCheckpoint{Epoch: 1, Block: blockRoot[:]},
+	})
+	waitForCursor(t, sink, 5)
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &ethpbv1.EventChainReorg{Slot: 6, Depth: 1, OldHeadBlock: blockRoot[:], NewHeadBlock: make([]byte, 32)},
+	})
+	waitForCursor(t, sink, 6)
+	require.NoError(t, s.Stop())
+	require.NoError(t, s.Status())
+
+	records := readRecords(t, path)
+	require.Equal(t, 6, len(records))
+	wantedKinds := []string{
+		BlockKind,
+		ProposerSlashingKind,
+		AttestationKind,
+		AttesterSlashingKind,
+		FinalizedCheckpointKind,
+		ChainReorgKind,
+	}
+	for i, record := range records {
+		assert.Equal(t, uint64(i+1), record.Sequence)
+		assert.Equal(t, wantedKinds[i], record.Kind)
+	}
+	assert.Equal(t, fmt.Sprintf("%#x", blockRoot), records[0].Key)
+	v1Block := &struct {
+		Block struct {
+			Slot string `json:"slot"`
+		} `json:"block"`
+	}{}
+	require.NoError(t, json.Unmarshal(records[0].Data, v1Block))
+	assert.Equal(t, "5", v1Block.Block.Slot)
+}
+
+func TestService_ResumesFromSinkCursor(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "records.ndjson")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), testRecords(1, 2)))
+	require.NoError(t, sink.Close())
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	s, chainService := setupService(t, sink)
+	chainService.StateNotifier().StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &ethpbv1.EventFinalizedCheckpoint{Epoch: 1, Block: make([]byte, 32)},
+	})
+	waitForCursor(t, sink, 3)
+	require.NoError(t, s.Stop())
+
+	records := readRecords(t, path)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, uint64(3), records[2].Sequence)
+	assert.Equal(t, FinalizedCheckpointKind, records[2].Kind)
+}
+
+func TestService_RetriesFailedWrites(t *testing.T) {
+	receiver := &webhookReceiver{trackCursor: true, failures: 3}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+	sink := NewWebhookSink(srv.URL)
+	s, chainService := setupService(t, sink)
+
+	for epoch := 1; epoch <= 3; epoch++ {
+		chainService.StateNotifier().StateFeed().Send(&feed.Event{
+			Type: statefeed.FinalizedCheckpoint,
+			Data: &ethpbv1.EventFinalizedCheckpoint{Epoch: 1, Block: []byte{byte(epoch)}},
+		})
+	}
+	waitForCursor(t, sink, 3)
+	require.NoError(t, s.Stop())
+	assert.DeepEqual(t, []uint64{1, 2, 3}, receiver.sequences())
+}
+
+func TestNewSink(t *testing.T) {
+	sink, err := NewSink("file://" + filepath.Join(t.TempDir(), "records.ndjson"))
+	require.NoError(t, err)
+	_, ok := sink.(*FileSink)
+	assert.Equal(t, true, ok)
+	require.NoError(t, sink.Close())
+
+	sink, err = NewSink("http://localhost:8080/records")
+	require.NoError(t, err)
+	_, ok = sink.(*WebhookSink)
+	assert.Equal(t, true, ok)
+
+	_, err = NewSink("kafka://localhost:9092")
+	assert.ErrorContains(t, "must contain bootstrap servers and a topic", err)
+	_, err = NewSink("ftp://localhost/records")
+	assert.ErrorContains(t, "unsupported sink URL scheme ftp", err)
+}
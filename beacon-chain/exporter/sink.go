@@ -0,0 +1,61 @@
+package exporter
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Sink is a backend the exporter writes records to.
+type Sink interface {
+	// Cursor returns the sequence number of the last record written to the sink,
+	// or 0 if no record was written yet. The exporter resumes numbering records after it.
+	Cursor(ctx context.Context) (uint64, error)
+	// Write writes records in order of their sequence numbers. A failed write may be
+	// retried with the same records, so records which were already written with the
+	// same sequence number must not be written again.
+	Write(ctx context.Context, records []*Record) error
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+// NewSink creates the sink of a sink URL. Supported URLs are
+//
+//	file:///path/to/records.ndjson      newline-delimited JSON file
+//	http://localhost:8080/records       HTTP webhook, https is supported as well
+//	kafka://host1:9092,host2:9092/topic Kafka topic
+func NewSink(rawURL string) (Sink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse sink URL %s", rawURL)
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			return nil, errors.Errorf("no file path in sink URL %s", rawURL)
+		}
+		return NewFileSink(u.Path)
+	case "http", "https":
+		return NewWebhookSink(rawURL), nil
+	case "kafka":
+		topic := strings.TrimPrefix(u.Path, "/")
+		if u.Host == "" || topic == "" {
+			return nil, errors.Errorf("sink URL %s must contain bootstrap servers and a topic", rawURL)
+		}
+		return NewKafkaSink(u.Host, topic)
+	default:
+		return nil, errors.Errorf("unsupported sink URL scheme %s", u.Scheme)
+	}
+}
+
+// recordsAfter returns the records with a sequence number greater than the cursor.
+func recordsAfter(records []*Record, cursor uint64) []*Record {
+	for i, record := range records {
+		if record.Sequence > cursor {
+			return records[i:]
+		}
+	}
+	return nil
+}
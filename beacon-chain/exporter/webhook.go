@@ -0,0 +1,123 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// WebhookSink posts records to an HTTP endpoint, such as a collector running next to the beacon node.
+//
+// Records are sent as newline-delimited JSON in the body of POST requests, and any 2xx status
+// acknowledges all records of a request. A receiver which tracks the records it stored answers
+// GET requests to the same URL with its cursor
+//
+//	{"cursor": 42}
+//
+// so that the exporter resumes after the last stored record when the beacon node restarts.
+// Receivers which answer GET requests with 404 or 405 do not track a cursor, in which case
+// the exporter resumes after the last record acknowledged since the beacon node started.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+	lock   sync.Mutex
+	cursor uint64
+}
+
+type webhookCursorJson struct {
+	Cursor uint64 `json:"cursor"`
+}
+
+// NewWebhookSink creates a sink which posts records to the URL.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Cursor requests the cursor of the receiver.
+func (s *WebhookSink) Cursor(ctx context.Context) (uint64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not request cursor")
+	}
+	defer closeBody(resp.Body)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return s.cursor, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("cursor request failed with status %d", resp.StatusCode)
+	}
+	cursor := &webhookCursorJson{}
+	if err := json.NewDecoder(resp.Body).Decode(cursor); err != nil {
+		return 0, errors.Wrap(err, "could not decode cursor")
+	}
+	s.cursor = cursor.Cursor
+	return s.cursor, nil
+}
+
+// Write posts the records which were not acknowledged yet.
+func (s *WebhookSink) Write(ctx context.Context, records []*Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	records = recordsAfter(records, s.cursor)
+	if len(records) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return errors.Wrap(err, "could not encode record")
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not post records")
+	}
+	defer closeBody(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("posting records failed with status %d", resp.StatusCode)
+	}
+	s.cursor = records[len(records)-1].Sequence
+	return nil
+}
+
+// Close closes idle connections to the receiver.
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+func closeBody(body io.ReadCloser) {
+	// Drain the body so that the connection can be reused.
+	if _, err := io.Copy(ioutil.Discard, body); err != nil {
+		log.WithError(err).Debug("Could not read response body")
+	}
+	if err := body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close response body")
+	}
+}
@@ -0,0 +1,109 @@
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// webhookReceiver stores the records posted to it and reports its cursor.
+type webhookReceiver struct {
+	lock         sync.Mutex
+	records      []*Record
+	failures     int
+	trackCursor  bool
+	postRequests int
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	switch req.Method {
+	case http.MethodGet:
+		if !r.trackCursor {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var cursor uint64
+		if len(r.records) > 0 {
+			cursor = r.records[len(r.records)-1].Sequence
+		}
+		_, err := fmt.Fprintf(w, `{"cursor":%d}`, cursor)
+		if err != nil {
+			panic(err)
+		}
+	case http.MethodPost:
+		r.postRequests++
+		if r.failures > 0 {
+			r.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		scanner := bufio.NewScanner(req.Body)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			record := &Record{}
+			if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			r.records = append(r.records, record)
+		}
+	}
+}
+
+func (r *webhookReceiver) sequences() []uint64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	sequences := make([]uint64, len(r.records))
+	for i, record := range r.records {
+		sequences[i] = record.Sequence
+	}
+	return sequences
+}
+
+func TestWebhookSink_Cursor(t *testing.T) {
+	ctx := context.Background()
+	receiver := &webhookReceiver{trackCursor: true, records: testRecords(1, 7)}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	sink := NewWebhookSink(srv.URL)
+	cursor, err := sink.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), cursor)
+	require.NoError(t, sink.Write(ctx, testRecords(6, 9)))
+	assert.DeepEqual(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9}, receiver.sequences())
+
+	// Without a cursor of the receiver, the sink resumes after the last acknowledged record.
+	receiver.trackCursor = false
+	cursor, err = sink.Cursor(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), cursor)
+	require.NoError(t, sink.Close())
+}
+
+func TestWebhookSink_Write(t *testing.T) {
+	ctx := context.Background()
+	receiver := &webhookReceiver{failures: 1}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	sink := NewWebhookSink(srv.URL)
+	err := sink.Write(ctx, testRecords(1, 2))
+	assert.ErrorContains(t, "posting records failed with status 503", err)
+	require.NoError(t, sink.Write(ctx, testRecords(1, 2)))
+	require.NoError(t, sink.Write(ctx, testRecords(1, 3)))
+	assert.DeepEqual(t, []uint64{1, 2, 3}, receiver.sequences())
+	// Records which were all acknowledged are not posted again.
+	require.NoError(t, sink.Write(ctx, testRecords(1, 3)))
+	assert.Equal(t, 3, receiver.postRequests)
+}
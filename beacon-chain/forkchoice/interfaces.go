@@ -2,6 +2,7 @@ package forkchoice
 
 import (
 	"context"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -12,6 +13,8 @@ type ForkChoicer interface {
 	HeadRetriever        // to compute head.
 	BlockProcessor       // to track new block for fork choice.
 	AttestationProcessor // to track new attestation for fork choice.
+	ProposerBooster      // to boost timely blocks for fork choice.
+	SlashingProcessor    // to discount equivocating validators for fork choice.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
 }
@@ -31,6 +34,17 @@ type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, types.Epoch)
 }
 
+// ProposerBooster boosts the weight of the timely block of the current slot, and resets it on the next slot.
+type ProposerBooster interface {
+	BoostProposerRoot(context.Context, types.Slot, [32]byte, time.Time)
+	ResetBoostedProposerRoot(context.Context)
+}
+
+// SlashingProcessor processes the equivocating validator indices of attester slashings, whose votes are not accounted.
+type SlashingProcessor interface {
+	InsertSlashedIndex(context.Context, types.ValidatorIndex)
+}
+
 // Pruner prunes the fork choice upon new finalization. This is used to keep fork choice sane.
 type Pruner interface {
 	Prune(context.Context, [32]byte) error
@@ -45,4 +59,5 @@ type Getter interface {
 	HasParent(root [32]byte) bool
 	AncestorRoot(ctx context.Context, root [32]byte, slot types.Slot) ([]byte, error)
 	IsCanonical(root [32]byte) bool
+	TreeNodes(ctx context.Context) ([]*protoarray.TreeNode, error)
 }
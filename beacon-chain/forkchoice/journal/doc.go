@@ -0,0 +1,7 @@
+/*
+Package journal records the inputs of the fork choice store to an on-disk journal, and replays them into a
+fresh store. It is used to reproduce and debug the head selection of a node: the replay reports the head
+and the weights of the nodes at each recorded head computation, and whether it diverges from the head the
+node computed.
+*/
+package journal
@@ -0,0 +1,274 @@
+package journal
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Kind of a journal entry, which is the fork choice input it records.
+type Kind byte
+
+// Kinds of the journal entries.
+const (
+	// KindNew records the initialization of a new fork choice store, with the justified and finalized epochs and
+	// the finalized root.
+	KindNew Kind = iota + 1
+	// KindBlock records a processed block, with its slot, root, parent root, graffiti and the justified and
+	// finalized epochs of its post state.
+	KindBlock
+	// KindAttestation records a processed attestation, with the attesting indices, the block root and the
+	// target epoch.
+	KindAttestation
+	// KindBalances records the justified balances given to the following head computations.
+	KindBalances
+	// KindHead records a head computation, with the justified epoch and root, the finalized epoch, and the
+	// resulting head root. The root is zero if the head computation failed.
+	KindHead
+	// KindPrune records a pruning of the store to the finalized root.
+	KindPrune
+	// KindBoost records the boost of the timely block of the root and slot.
+	KindBoost
+	// KindResetBoost records the reset of the boost at the start of a slot.
+	KindResetBoost
+	// KindSlashedIndex records an equivocating validator index.
+	KindSlashedIndex
+)
+
+// String returns the name of the kind of entry.
+func (k Kind) String() string {
+	switch k {
+	case KindNew:
+		return "new"
+	case KindBlock:
+		return "block"
+	case KindAttestation:
+		return "attestation"
+	case KindBalances:
+		return "balances"
+	case KindHead:
+		return "head"
+	case KindPrune:
+		return "prune"
+	case KindBoost:
+		return "boost"
+	case KindResetBoost:
+		return "reset_boost"
+	case KindSlashedIndex:
+		return "slashed_index"
+	default:
+		return fmt.Sprintf("unknown(%d)", k)
+	}
+}
+
+// Entry of the journal, only the fields of its kind are set.
+type Entry struct {
+	Kind           Kind
+	Slot           types.Slot
+	Root           [32]byte // block, head, finalized or pruned root depending on the kind.
+	ParentRoot     [32]byte
+	Graffiti       [32]byte
+	JustifiedRoot  [32]byte
+	JustifiedEpoch types.Epoch
+	FinalizedEpoch types.Epoch
+	TargetEpoch    types.Epoch
+	Indices        []uint64
+	Balances       []uint64
+	ValidatorIndex types.ValidatorIndex
+	Failed         bool // whether the head computation failed.
+}
+
+// marshal encodes the entry as its kind followed by its fields, integers being varints.
+func (e *Entry) marshal() ([]byte, error) {
+	b := []byte{byte(e.Kind)}
+	switch e.Kind {
+	case KindNew:
+		b = appendUvarint(b, uint64(e.JustifiedEpoch))
+		b = appendUvarint(b, uint64(e.FinalizedEpoch))
+		b = append(b, e.Root[:]...)
+	case KindBlock:
+		b = appendUvarint(b, uint64(e.Slot))
+		b = append(b, e.Root[:]...)
+		b = append(b, e.ParentRoot[:]...)
+		b = append(b, e.Graffiti[:]...)
+		b = appendUvarint(b, uint64(e.JustifiedEpoch))
+		b = appendUvarint(b, uint64(e.FinalizedEpoch))
+	case KindAttestation:
+		b = append(b, e.Root[:]...)
+		b = appendUvarint(b, uint64(e.TargetEpoch))
+		b = appendUvarint(b, uint64(len(e.Indices)))
+		// Attesting indices are close to each other, they are encoded as differences to the previous one.
+		previous := int64(0)
+		for _, index := range e.Indices {
+			b = appendVarint(b, int64(index)-previous)
+			previous = int64(index)
+		}
+	case KindBalances:
+		b = appendUvarint(b, uint64(len(e.Balances)))
+		for _, balance := range e.Balances {
+			b = appendUvarint(b, balance)
+		}
+	case KindHead:
+		b = appendUvarint(b, uint64(e.JustifiedEpoch))
+		b = append(b, e.JustifiedRoot[:]...)
+		b = appendUvarint(b, uint64(e.FinalizedEpoch))
+		b = append(b, e.Root[:]...)
+		if e.Failed {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	case KindPrune:
+		b = append(b, e.Root[:]...)
+	case KindBoost:
+		b = appendUvarint(b, uint64(e.Slot))
+		b = append(b, e.Root[:]...)
+	case KindResetBoost:
+	case KindSlashedIndex:
+		b = appendUvarint(b, uint64(e.ValidatorIndex))
+	default:
+		return nil, fmt.Errorf("unknown entry kind %d", e.Kind)
+	}
+	return b, nil
+}
+
+// unmarshal decodes an entry encoded by marshal.
+func (e *Entry) unmarshal(b []byte) error {
+	if len(b) == 0 {
+		return errors.New("empty entry")
+	}
+	d := &decoder{b: b[1:]}
+	e.Kind = Kind(b[0])
+	switch e.Kind {
+	case KindNew:
+		e.JustifiedEpoch = types.Epoch(d.uvarint())
+		e.FinalizedEpoch = types.Epoch(d.uvarint())
+		e.Root = d.root()
+	case KindBlock:
+		e.Slot = types.Slot(d.uvarint())
+		e.Root = d.root()
+		e.ParentRoot = d.root()
+		e.Graffiti = d.root()
+		e.JustifiedEpoch = types.Epoch(d.uvarint())
+		e.FinalizedEpoch = types.Epoch(d.uvarint())
+	case KindAttestation:
+		e.Root = d.root()
+		e.TargetEpoch = types.Epoch(d.uvarint())
+		e.Indices = make([]uint64, d.length())
+		previous := int64(0)
+		for i := range e.Indices {
+			previous += d.varint()
+			e.Indices[i] = uint64(previous)
+		}
+	case KindBalances:
+		e.Balances = make([]uint64, d.length())
+		for i := range e.Balances {
+			e.Balances[i] = d.uvarint()
+		}
+	case KindHead:
+		e.JustifiedEpoch = types.Epoch(d.uvarint())
+		e.JustifiedRoot = d.root()
+		e.FinalizedEpoch = types.Epoch(d.uvarint())
+		e.Root = d.root()
+		e.Failed = d.byte() == 1
+	case KindPrune:
+		e.Root = d.root()
+	case KindBoost:
+		e.Slot = types.Slot(d.uvarint())
+		e.Root = d.root()
+	case KindResetBoost:
+	case KindSlashedIndex:
+		e.ValidatorIndex = types.ValidatorIndex(d.uvarint())
+	default:
+		return fmt.Errorf("unknown entry kind %d", e.Kind)
+	}
+	if d.err != nil {
+		return errors.Wrapf(d.err, "could not decode %s entry", e.Kind)
+	}
+	if len(d.b) != 0 {
+		return fmt.Errorf("%d trailing bytes in %s entry", len(d.b), e.Kind)
+	}
+	return nil
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func appendVarint(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutVarint(buf[:], v)]...)
+}
+
+// decoder reads the fields of an entry, the first error is kept and the following reads return zero values.
+type decoder struct {
+	b   []byte
+	err error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.err = errors.New("invalid varint")
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.b)
+	if n <= 0 {
+		d.err = errors.New("invalid varint")
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+// length reads the length of a list, which can not be larger than the remaining bytes as each element
+// takes at least one byte.
+func (d *decoder) length() uint64 {
+	n := d.uvarint()
+	if n > uint64(len(d.b)) {
+		d.err = errors.New("invalid list length")
+		return 0
+	}
+	return n
+}
+
+func (d *decoder) root() [32]byte {
+	var r [32]byte
+	if d.err != nil {
+		return r
+	}
+	if len(d.b) < len(r) {
+		d.err = errors.New("truncated root")
+		return r
+	}
+	copy(r[:], d.b)
+	d.b = d.b[len(r):]
+	return r
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.b) == 0 {
+		d.err = errors.New("truncated entry")
+		return 0
+	}
+	v := d.b[0]
+	d.b = d.b[1:]
+	return v
+}
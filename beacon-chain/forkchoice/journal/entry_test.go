@@ -0,0 +1,55 @@
+package journal
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEntry_MarshalUnmarshal(t *testing.T) {
+	entries := []*Entry{
+		{Kind: KindNew, JustifiedEpoch: 3, FinalizedEpoch: 2, Root: [32]byte{'a'}},
+		{Kind: KindBlock, Slot: 100, Root: [32]byte{'b'}, ParentRoot: [32]byte{'a'}, Graffiti: [32]byte{'g'}, JustifiedEpoch: 3, FinalizedEpoch: 2},
+		{Kind: KindAttestation, Root: [32]byte{'b'}, TargetEpoch: 4, Indices: []uint64{1000, 3, 1 << 40, 0}},
+		{Kind: KindAttestation, Root: [32]byte{'b'}, TargetEpoch: 4, Indices: []uint64{}},
+		{Kind: KindBalances, Balances: []uint64{32000000000, 0, 31000000000}},
+		{Kind: KindHead, JustifiedEpoch: 3, JustifiedRoot: [32]byte{'a'}, FinalizedEpoch: 2, Root: [32]byte{'b'}},
+		{Kind: KindHead, JustifiedEpoch: 3, JustifiedRoot: [32]byte{'a'}, FinalizedEpoch: 2, Failed: true},
+		{Kind: KindPrune, Root: [32]byte{'a'}},
+		{Kind: KindBoost, Slot: 101, Root: [32]byte{'c'}},
+		{Kind: KindResetBoost},
+		{Kind: KindSlashedIndex, ValidatorIndex: 12345},
+	}
+	for _, e := range entries {
+		t.Run(e.Kind.String(), func(t *testing.T) {
+			b, err := e.marshal()
+			require.NoError(t, err)
+			decoded := &Entry{}
+			require.NoError(t, decoded.unmarshal(b))
+			assert.DeepEqual(t, e, decoded)
+		})
+	}
+}
+
+func TestEntry_UnmarshalInvalid(t *testing.T) {
+	b, err := (&Entry{Kind: KindBlock, Slot: 100}).marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		b    []byte
+		err  string
+	}{
+		{name: "empty", b: []byte{}, err: "empty entry"},
+		{name: "unknown kind", b: []byte{100}, err: "unknown entry kind 100"},
+		{name: "truncated", b: b[:len(b)-1], err: "could not decode block entry"},
+		{name: "trailing bytes", b: append(b, 0), err: "1 trailing bytes in block entry"},
+		{name: "invalid length", b: []byte{byte(KindBalances), 10, 1}, err: "invalid list length"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, tt.err, (&Entry{}).unmarshal(tt.b))
+		})
+	}
+}
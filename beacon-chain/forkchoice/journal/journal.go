@@ -0,0 +1,109 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// maxEntrySize bounds the size of a decoded entry, which is at most the balances of a few million validators.
+const maxEntrySize = 1 << 26
+
+// Writer appends entries to a journal file. Each entry is prefixed by its length, and the file is a snappy
+// stream which can span several runs of the node.
+type Writer struct {
+	file *os.File
+	w    *snappy.Writer
+	lock sync.Mutex
+}
+
+// NewWriter opens the journal file of the path, the entries are appended to the existing ones.
+func NewWriter(path string) (*Writer, error) {
+	expanded, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not expand path")
+	}
+	if err := os.MkdirAll(filepath.Dir(expanded), params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, errors.Wrap(err, "could not create journal directory")
+	}
+	file, err := os.OpenFile(expanded, os.O_APPEND|os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open journal file")
+	}
+	return &Writer{file: file, w: snappy.NewBufferedWriter(file)}, nil
+}
+
+// Write appends an entry to the journal. The entry is only written to the file on the next flush.
+func (w *Writer) Write(e *Entry) error {
+	b, err := e.marshal()
+	if err != nil {
+		return err
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if _, err := w.w.Write(appendUvarint(nil, uint64(len(b)))); err != nil {
+		return errors.Wrap(err, "could not write entry length")
+	}
+	if _, err := w.w.Write(b); err != nil {
+		return errors.Wrap(err, "could not write entry")
+	}
+	return nil
+}
+
+// Flush writes the buffered entries to the file.
+func (w *Writer) Flush() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.w.Flush()
+}
+
+// Close flushes the buffered entries and closes the file.
+func (w *Writer) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if err := w.w.Close(); err != nil {
+		return errors.Wrap(err, "could not flush journal")
+	}
+	return w.file.Close()
+}
+
+// Reader reads the entries of a journal.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader reads the journal from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(snappy.NewReader(r))}
+}
+
+// Next returns the next entry of the journal, or io.EOF at the end of the journal.
+func (r *Reader) Next() (*Entry, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrap(err, "could not read entry length")
+	}
+	if size > maxEntrySize {
+		return nil, errors.Errorf("entry of %d bytes is too large", size)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return nil, errors.Wrap(err, "could not read entry")
+	}
+	e := &Entry{}
+	if err := e.unmarshal(b); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
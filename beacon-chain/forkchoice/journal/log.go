@@ -0,0 +1,5 @@
+package journal
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "journal")
@@ -0,0 +1,174 @@
+package journal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+)
+
+// Recorder is a fork choice store which records all its inputs to a journal. The inputs are applied to the
+// store and recorded under the same lock, so that the journal has the order in which they were applied.
+type Recorder struct {
+	forkchoice.ForkChoicer
+	w        *Writer
+	lock     sync.Mutex
+	balances []uint64 // last recorded balances.
+	failed   bool     // whether a write failed, recording stops after the first failure.
+}
+
+// New initializes a new fork choice store recording its inputs to the journal.
+func New(w *Writer, justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) *Recorder {
+	r := &Recorder{
+		ForkChoicer: protoarray.New(justifiedEpoch, finalizedEpoch, finalizedRoot),
+		w:           w,
+	}
+	r.write(&Entry{
+		Kind:           KindNew,
+		JustifiedEpoch: justifiedEpoch,
+		FinalizedEpoch: finalizedEpoch,
+		Root:           finalizedRoot,
+	})
+	return r
+}
+
+// Head computes the head of the store and records it, along with the balances if they changed since the
+// last head computation. The journal is flushed after each head computation.
+func (r *Recorder) Head(
+	ctx context.Context,
+	justifiedEpoch types.Epoch,
+	justifiedRoot [32]byte,
+	justifiedStateBalances []uint64,
+	finalizedEpoch types.Epoch,
+) ([32]byte, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	head, err := r.ForkChoicer.Head(ctx, justifiedEpoch, justifiedRoot, justifiedStateBalances, finalizedEpoch)
+	if !equalBalances(r.balances, justifiedStateBalances) {
+		r.balances = make([]uint64, len(justifiedStateBalances))
+		copy(r.balances, justifiedStateBalances)
+		r.write(&Entry{Kind: KindBalances, Balances: r.balances})
+	}
+	r.write(&Entry{
+		Kind:           KindHead,
+		JustifiedEpoch: justifiedEpoch,
+		JustifiedRoot:  justifiedRoot,
+		FinalizedEpoch: finalizedEpoch,
+		Root:           head,
+		Failed:         err != nil,
+	})
+	r.flush()
+	return head, err
+}
+
+// ProcessBlock processes the block and records it.
+func (r *Recorder) ProcessBlock(
+	ctx context.Context,
+	slot types.Slot,
+	blockRoot, parentRoot, graffiti [32]byte,
+	justifiedEpoch, finalizedEpoch types.Epoch,
+) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.write(&Entry{
+		Kind:           KindBlock,
+		Slot:           slot,
+		Root:           blockRoot,
+		ParentRoot:     parentRoot,
+		Graffiti:       graffiti,
+		JustifiedEpoch: justifiedEpoch,
+		FinalizedEpoch: finalizedEpoch,
+	})
+	return r.ForkChoicer.ProcessBlock(ctx, slot, blockRoot, parentRoot, graffiti, justifiedEpoch, finalizedEpoch)
+}
+
+// ProcessAttestation processes the attestation and records it.
+func (r *Recorder) ProcessAttestation(ctx context.Context, validatorIndices []uint64, blockRoot [32]byte, targetEpoch types.Epoch) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.write(&Entry{
+		Kind:        KindAttestation,
+		Indices:     validatorIndices,
+		Root:        blockRoot,
+		TargetEpoch: targetEpoch,
+	})
+	r.ForkChoicer.ProcessAttestation(ctx, validatorIndices, blockRoot, targetEpoch)
+}
+
+// Prune prunes the store and records it.
+func (r *Recorder) Prune(ctx context.Context, finalizedRoot [32]byte) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.write(&Entry{Kind: KindPrune, Root: finalizedRoot})
+	return r.ForkChoicer.Prune(ctx, finalizedRoot)
+}
+
+// BoostProposerRoot boosts the block if it is timely, and records the boost. As the timeliness depends on
+// the arrival time of the block, only the boosts which happened are recorded.
+func (r *Recorder) BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.ForkChoicer.BoostProposerRoot(ctx, blockSlot, blockRoot, genesisTime)
+	if r.Store().ProposerBoostRoot() == blockRoot {
+		r.write(&Entry{Kind: KindBoost, Slot: blockSlot, Root: blockRoot})
+	}
+}
+
+// ResetBoostedProposerRoot resets the boost and records it.
+func (r *Recorder) ResetBoostedProposerRoot(ctx context.Context) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.write(&Entry{Kind: KindResetBoost})
+	r.ForkChoicer.ResetBoostedProposerRoot(ctx)
+}
+
+// InsertSlashedIndex inserts the equivocating validator index and records it.
+func (r *Recorder) InsertSlashedIndex(ctx context.Context, index types.ValidatorIndex) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.write(&Entry{Kind: KindSlashedIndex, ValidatorIndex: index})
+	r.ForkChoicer.InsertSlashedIndex(ctx, index)
+}
+
+// write records the entry, recording stops after the first failure as the journal could not be replayed.
+func (r *Recorder) write(e *Entry) {
+	if r.failed {
+		return
+	}
+	if err := r.w.Write(e); err != nil {
+		r.failed = true
+		log.WithError(err).Error("Could not write to fork choice journal, no longer recording fork choice inputs")
+	}
+}
+
+func (r *Recorder) flush() {
+	if r.failed {
+		return
+	}
+	if err := r.w.Flush(); err != nil {
+		r.failed = true
+		log.WithError(err).Error("Could not flush fork choice journal, no longer recording fork choice inputs")
+	}
+}
+
+func equalBalances(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
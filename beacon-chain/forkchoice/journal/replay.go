@@ -0,0 +1,94 @@
+package journal
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// Step is the replay of a head computation recorded in the journal.
+type Step struct {
+	Entry          int                // index of the head entry in the journal.
+	Recorded       [32]byte           // head recorded in the journal.
+	RecordedFailed bool               // whether the recorded head computation failed.
+	Head           [32]byte           // head computed by the replay.
+	Err            error              // error of the head computation of the replay.
+	Nodes          []*protoarray.Node // copies of the nodes of the store after the head computation of the replay.
+}
+
+// Diverged returns whether the replay computed a different head than the recorded one.
+func (s *Step) Diverged() bool {
+	return s.Recorded != s.Head || s.RecordedFailed != (s.Err != nil)
+}
+
+// Replay applies the entries of the journal to a fresh fork choice store, and calls fn with the result of
+// each head computation. A new store is used for each initialization recorded in the journal. It stops at
+// the end of the journal, at the first error of fn, or at the first input the store fails to apply.
+func Replay(ctx context.Context, r *Reader, fn func(*Step) error) error {
+	var f *protoarray.ForkChoice
+	var balances []uint64
+	for i := 0; ; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		e, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not read entry %d", i)
+		}
+		if f == nil && e.Kind != KindNew {
+			return errors.Errorf("%s entry %d before the initialization of the store", e.Kind, i)
+		}
+
+		switch e.Kind {
+		case KindNew:
+			f = protoarray.New(e.JustifiedEpoch, e.FinalizedEpoch, e.Root)
+			balances = nil
+		case KindBlock:
+			if err := f.ProcessBlock(ctx, e.Slot, e.Root, e.ParentRoot, e.Graffiti, e.JustifiedEpoch, e.FinalizedEpoch); err != nil {
+				return errors.Wrapf(err, "could not process block of entry %d", i)
+			}
+		case KindAttestation:
+			f.ProcessAttestation(ctx, e.Indices, e.Root, e.TargetEpoch)
+		case KindBalances:
+			balances = e.Balances
+		case KindHead:
+			head, err := f.Head(ctx, e.JustifiedEpoch, e.JustifiedRoot, balances, e.FinalizedEpoch)
+			nodes := f.Nodes()
+			for j, n := range nodes {
+				nodes[j] = f.Node(n.Root())
+			}
+			step := &Step{
+				Entry:          i,
+				Recorded:       e.Root,
+				RecordedFailed: e.Failed,
+				Head:           head,
+				Err:            err,
+				Nodes:          nodes,
+			}
+			if err := fn(step); err != nil {
+				return err
+			}
+		case KindPrune:
+			if err := f.Prune(ctx, e.Root); err != nil {
+				return errors.Wrapf(err, "could not prune store of entry %d", i)
+			}
+		case KindBoost:
+			// Only the boosts which happened are recorded, the block is replayed as arriving at the start of its slot.
+			slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+			genesisTime := timeutils.Now().Add(-time.Duration(e.Slot) * slotDuration)
+			f.BoostProposerRoot(ctx, e.Slot, e.Root, genesisTime)
+		case KindResetBoost:
+			f.ResetBoostedProposerRoot(ctx)
+		case KindSlashedIndex:
+			f.InsertSlashedIndex(ctx, e.ValidatorIndex)
+		}
+	}
+}
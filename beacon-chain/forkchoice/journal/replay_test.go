@@ -0,0 +1,141 @@
+package journal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRecorder_Replay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "journal", "forkchoice.journal")
+	genesis := [32]byte{'g'}
+	// With 100 validators of balance 10, the boost of a timely block is 12.
+	balances := make([]uint64, 100)
+	for i := range balances {
+		balances[i] = 10
+	}
+
+	// Record a first run, in which head moves from block a to block b, then back to a as the voters of b
+	// equivocate, and to the timely block c during its slot.
+	w, err := NewWriter(path)
+	require.NoError(t, err)
+	r := New(w, 0, 0, [32]byte{})
+	require.NoError(t, r.ProcessBlock(ctx, 0, genesis, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, r.ProcessBlock(ctx, 1, [32]byte{'a'}, genesis, [32]byte{}, 0, 0))
+	require.NoError(t, r.ProcessBlock(ctx, 1, [32]byte{'b'}, genesis, [32]byte{}, 0, 0))
+	r.ProcessAttestation(ctx, []uint64{0}, [32]byte{'a'}, 0)
+	recorded := make([][32]byte, 0)
+	head, err := r.Head(ctx, 0, genesis, balances, 0)
+	require.NoError(t, err)
+	recorded = append(recorded, head)
+	r.ProcessAttestation(ctx, []uint64{1, 2}, [32]byte{'b'}, 0)
+	head, err = r.Head(ctx, 0, genesis, balances, 0)
+	require.NoError(t, err)
+	recorded = append(recorded, head)
+	r.InsertSlashedIndex(ctx, 1)
+	r.InsertSlashedIndex(ctx, 2)
+	head, err = r.Head(ctx, 0, genesis, balances, 0)
+	require.NoError(t, err)
+	recorded = append(recorded, head)
+	// Block c arrives at the start of its slot and is boosted.
+	require.NoError(t, r.ProcessBlock(ctx, 2, [32]byte{'c'}, genesis, [32]byte{}, 0, 0))
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	r.BoostProposerRoot(ctx, 2, [32]byte{'c'}, time.Now().Add(-2*slotDuration))
+	head, err = r.Head(ctx, 0, genesis, balances, 0)
+	require.NoError(t, err)
+	recorded = append(recorded, head)
+	r.ResetBoostedProposerRoot(ctx)
+	head, err = r.Head(ctx, 0, genesis, balances, 0)
+	require.NoError(t, err)
+	recorded = append(recorded, head)
+	assert.DeepEqual(t, [][32]byte{{'a'}, {'b'}, {'a'}, {'c'}, {'a'}}, recorded)
+	require.NoError(t, w.Close())
+
+	// Record a second run appended to the same journal, with a failed head computation.
+	w, err = NewWriter(path)
+	require.NoError(t, err)
+	r = New(w, 0, 0, [32]byte{})
+	_, err = r.Head(ctx, 0, genesis, balances, 0)
+	require.NotNil(t, err)
+	recorded = append(recorded, [32]byte{})
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	steps := make([]*Step, 0)
+	require.NoError(t, Replay(ctx, NewReader(f), func(s *Step) error {
+		steps = append(steps, s)
+		return nil
+	}))
+	require.Equal(t, len(recorded), len(steps))
+	for i, s := range steps {
+		assert.Equal(t, recorded[i], s.Head, "Unexpected head at step %d", i)
+		assert.Equal(t, false, s.Diverged(), "Replay diverged at step %d", i)
+	}
+	assert.NotNil(t, steps[len(steps)-1].Err)
+
+	// The weights of the nodes are reported at each step.
+	weights := make(map[[32]byte]uint64)
+	for _, n := range steps[3].Nodes {
+		weights[n.Root()] = n.Weight()
+	}
+	assert.Equal(t, uint64(10), weights[[32]byte{'a'}])
+	assert.Equal(t, uint64(0), weights[[32]byte{'b'}])
+	assert.Equal(t, uint64(12), weights[[32]byte{'c'}])
+}
+
+func TestReplay_Diverged(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "forkchoice.journal")
+	w, err := NewWriter(path)
+	require.NoError(t, err)
+	genesis := [32]byte{'g'}
+	for _, e := range []*Entry{
+		{Kind: KindNew},
+		{Kind: KindBlock, Root: genesis},
+		{Kind: KindBlock, Slot: 1, Root: [32]byte{'a'}, ParentRoot: genesis},
+		{Kind: KindHead, JustifiedRoot: genesis, Root: [32]byte{'a'}},
+		{Kind: KindHead, JustifiedRoot: genesis, Root: [32]byte{'b'}},
+	} {
+		require.NoError(t, w.Write(e))
+	}
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	diverged := make([]bool, 0)
+	require.NoError(t, Replay(ctx, NewReader(f), func(s *Step) error {
+		diverged = append(diverged, s.Diverged())
+		return nil
+	}))
+	assert.DeepEqual(t, []bool{false, true}, diverged)
+}
+
+func TestReplay_NoInitialization(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "forkchoice.journal")
+	w, err := NewWriter(path)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(&Entry{Kind: KindPrune}))
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	err = Replay(context.Background(), NewReader(f), func(*Step) error { return nil })
+	assert.ErrorContains(t, "prune entry 0 before the initialization of the store", err)
+}
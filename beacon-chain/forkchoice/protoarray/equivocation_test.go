@@ -0,0 +1,63 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEquivocation_CanFindHead(t *testing.T) {
+	balances := []uint64{3, 2, 2}
+	ctx := context.Background()
+	f := setup(1, 1)
+
+	// Insert block 1 and 2 into the tree, vote for block 1 with validator 0 and for block 2 with
+	// validators 1 and 2, verify head is at 2:
+	//             0
+	//            / \
+	//  vote 0 -> 1  2 <- votes 1 and 2, head
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	f.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(2), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with votes for block 2")
+	assert.Equal(t, uint64(4), f.Node(indexToHash(2)).Weight(), "Incorrect weight of block 2")
+
+	// Validator 1 gets slashed, its vote is removed from block 2 right away, and only once:
+	//                     0
+	//                    / \
+	// vote 0, new head -> 1  2 <- vote 2
+	f.InsertSlashedIndex(ctx, 1)
+	assert.Equal(t, uint64(2), f.Node(indexToHash(2)).Weight(), "Incorrect weight of block 2 after slashing")
+	f.InsertSlashedIndex(ctx, 1)
+	assert.Equal(t, uint64(2), f.Node(indexToHash(2)).Weight(), "Incorrect weight of block 2 after slashing twice")
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after slashing")
+
+	// A new vote of validator 1 for block 2 is ignored:
+	//             0
+	//            / \
+	//  vote 0 -> 1  2 <- votes 1 (ignored) and 2
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(2), 3)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with a new vote of a slashed validator")
+	assert.Equal(t, uint64(2), f.Node(indexToHash(2)).Weight(), "Incorrect weight of block 2")
+
+	// A change of balance of validator 1 is ignored.
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{3, 10, 2}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with a new balance of a slashed validator")
+	assert.Equal(t, uint64(2), f.Node(indexToHash(2)).Weight(), "Incorrect weight of block 2")
+
+	// A slashed validator which never voted does not change any weight.
+	f.InsertSlashedIndex(ctx, 100)
+	assert.Equal(t, uint64(3), f.Node(indexToHash(1)).Weight(), "Incorrect weight of block 1")
+	assert.Equal(t, uint64(2), f.Node(indexToHash(2)).Weight(), "Incorrect weight of block 2")
+}
@@ -3,6 +3,7 @@ package protoarray
 import (
 	"context"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
@@ -14,6 +15,7 @@ func computeDeltas(
 	blockIndices map[[32]byte]uint64,
 	votes []Vote,
 	oldBalances, newBalances []uint64,
+	slashedIndices map[types.ValidatorIndex]bool,
 ) ([]int, []Vote, error) {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.computeDeltas")
 	defer span.End()
@@ -21,6 +23,11 @@ func computeDeltas(
 	deltas := make([]int, len(blockIndices))
 
 	for validatorIndex, vote := range votes {
+		// Skip if the validator is equivocating, the weight of its vote was removed when it got slashed.
+		if slashedIndices[types.ValidatorIndex(validatorIndex)] {
+			continue
+		}
+
 		oldBalance := uint64(0)
 		newBalance := uint64(0)
 
@@ -88,5 +95,6 @@ func copyNode(node *Node) *Node {
 		weight:         node.weight,
 		bestChild:      node.bestChild,
 		bestDescendant: node.bestDescendant,
+		graffiti:       node.graffiti,
 	}
 }
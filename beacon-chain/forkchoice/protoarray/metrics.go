@@ -42,6 +42,18 @@ var (
 			Help: "The number of times an attestation is processed for fork choice.",
 		},
 	)
+	boostedProposerCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proto_array_proposer_boosted_count",
+			Help: "The number of times a timely block is boosted for fork choice.",
+		},
+	)
+	equivocatingIndicesCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proto_array_equivocating_indices_count",
+			Help: "The number of equivocating validator indices whose votes are removed from fork choice.",
+		},
+	)
 	prunedCount = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "proto_array_pruned_count",
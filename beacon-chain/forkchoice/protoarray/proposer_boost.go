@@ -0,0 +1,96 @@
+package protoarray
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"go.opencensus.io/trace"
+)
+
+// BoostProposerRoot boosts the block of the given root if it is timely, that is if the block is for the
+// current slot and arrived before the attestation deadline of the slot. The boost is given to the block
+// on the next head computations, until it is reset at the start of the next slot.
+func (f *ForkChoice) BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime time.Time) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.BoostProposerRoot")
+	defer span.End()
+
+	sinceGenesis := timeutils.Since(genesisTime)
+	if sinceGenesis < 0 {
+		return
+	}
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	currentSlot := types.Slot(sinceGenesis / slotDuration)
+	timeIntoSlot := sinceGenesis % slotDuration
+	attestationDeadline := slotDuration / time.Duration(params.BeaconConfig().IntervalsPerSlot)
+	if blockSlot != currentSlot || timeIntoSlot >= attestationDeadline {
+		return
+	}
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = blockRoot
+	boostedProposerCount.Inc()
+}
+
+// ResetBoostedProposerRoot removes the boost of the timely block of the previous slot. The score given to
+// the block is removed on the next head computation.
+func (f *ForkChoice) ResetBoostedProposerRoot(ctx context.Context) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.ResetBoostedProposerRoot")
+	defer span.End()
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = [32]byte{}
+}
+
+// ProposerBoostRoot of fork choice store.
+func (s *Store) ProposerBoostRoot() [32]byte {
+	s.proposerBoostLock.RLock()
+	defer s.proposerBoostLock.RUnlock()
+	return s.proposerBoostRoot
+}
+
+// applyProposerBoostScore adds to the deltas the removal of the score of the previously boosted block,
+// and the score of the currently boosted block. The caller must hold the nodes lock.
+func (s *Store) applyProposerBoostScore(newBalances []uint64, delta []int) error {
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+
+	if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash {
+		// The previously boosted block may have been pruned since, along with its weight.
+		if i, ok := s.nodesIndices[s.previousProposerBoostRoot]; ok {
+			if int(i) >= len(delta) {
+				return errInvalidNodeDelta
+			}
+			delta[i] -= int(s.previousProposerBoostScore)
+		}
+	}
+
+	score := uint64(0)
+	if s.proposerBoostRoot != params.BeaconConfig().ZeroHash {
+		if i, ok := s.nodesIndices[s.proposerBoostRoot]; ok {
+			if int(i) >= len(delta) {
+				return errInvalidNodeDelta
+			}
+			score = computeProposerBoostScore(newBalances)
+			delta[i] += int(score)
+		}
+	}
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = score
+	return nil
+}
+
+// computeProposerBoostScore returns the score given to a timely block, which is a percentage of the
+// average weight of a committee based on the justified balances.
+func computeProposerBoostScore(justifiedStateBalances []uint64) uint64 {
+	totalBalance := uint64(0)
+	for _, balance := range justifiedStateBalances {
+		totalBalance += balance
+	}
+	committeeWeight := totalBalance / uint64(params.BeaconConfig().SlotsPerEpoch)
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+}
@@ -0,0 +1,122 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposerBoost_CanFindHead(t *testing.T) {
+	// With 128 validators of balance 10, the weight of a committee is 40 and the boost is 16,
+	// which is more than one vote and less than two.
+	balances := make([]uint64, 128)
+	for i := range balances {
+		balances[i] = 10
+	}
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	ctx := context.Background()
+	f := setup(1, 1)
+
+	// Insert block 1 and 2 into the tree and vote for block 1, verify head is at 1:
+	//            0
+	//           / \
+	//  vote -> 1  2
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with a vote for block 1")
+
+	// Block 2 arrives at the start of slot 2, it is boosted and becomes head:
+	//            0
+	//           / \
+	//  vote -> 1  2 <- boost, new head
+	genesisTime := time.Now().Add(-2 * slotDuration)
+	f.BoostProposerRoot(ctx, 2, indexToHash(2), genesisTime)
+	assert.Equal(t, indexToHash(2), f.store.ProposerBoostRoot(), "Timely block is not boosted")
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with a boost for block 2")
+	assert.Equal(t, uint64(16), f.Node(indexToHash(2)).Weight(), "Incorrect weight of boosted block")
+
+	// The boost is not applied twice by a new head computation.
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with a boost for block 2")
+	assert.Equal(t, uint64(16), f.Node(indexToHash(2)).Weight(), "Incorrect weight of boosted block")
+
+	// The boost is reset at the start of slot 3, head goes back to 1:
+	//                     0
+	//                    / \
+	// vote, new head -> 1  2
+	f.ResetBoostedProposerRoot(ctx)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after the boost is reset")
+	assert.Equal(t, uint64(0), f.Node(indexToHash(2)).Weight(), "Incorrect weight of block after the boost is reset")
+
+	// Insert block 3 on top of 2, it arrives at the start of slot 3 and is boosted. The boost is not
+	// enough against two votes for block 1:
+	//            0
+	//           / \
+	//  votes -> 1  2
+	//              |
+	//              3 <- boost
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(1), 2)
+	require.NoError(t, f.ProcessBlock(ctx, 3, indexToHash(3), indexToHash(2), [32]byte{}, 1, 1))
+	genesisTime = time.Now().Add(-3 * slotDuration)
+	f.BoostProposerRoot(ctx, 3, indexToHash(3), genesisTime)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with two votes against a boost")
+	assert.Equal(t, uint64(16), f.Node(indexToHash(2)).Weight(), "Incorrect weight of the parent of boosted block")
+
+	// A vote for block 3 along with the boost makes it head:
+	//            0
+	//           / \
+	//  votes -> 1  2
+	//              |
+	//              3 <- vote, boost, new head
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(3), 2)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r, "Incorrect head with a vote and a boost for block 3")
+	assert.Equal(t, uint64(26), f.Node(indexToHash(3)).Weight(), "Incorrect weight of boosted block")
+}
+
+func TestProposerBoost_LateBlock(t *testing.T) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+
+	// The block arrives after the attestation deadline of its slot.
+	genesisTime := time.Now().Add(-2*slotDuration - slotDuration/2)
+	f.BoostProposerRoot(ctx, 2, indexToHash(1), genesisTime)
+	assert.Equal(t, [32]byte{}, f.store.ProposerBoostRoot(), "Late block is boosted")
+
+	// The block arrives during the next slot.
+	genesisTime = time.Now().Add(-3 * slotDuration)
+	f.BoostProposerRoot(ctx, 2, indexToHash(1), genesisTime)
+	assert.Equal(t, [32]byte{}, f.store.ProposerBoostRoot(), "Block of a past slot is boosted")
+
+	// The block arrives at the start of its slot.
+	genesisTime = time.Now().Add(-2 * slotDuration)
+	f.BoostProposerRoot(ctx, 2, indexToHash(1), genesisTime)
+	assert.Equal(t, indexToHash(1), f.store.ProposerBoostRoot(), "Timely block is not boosted")
+}
+
+func TestComputeProposerBoostScore(t *testing.T) {
+	assert.Equal(t, uint64(0), computeProposerBoostScore(nil))
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	// The weight of a committee is 2 validators, the boost is 40% of it.
+	assert.Equal(t, 2*params.BeaconConfig().MaxEffectiveBalance*40/100, computeProposerBoostScore(balances))
+}
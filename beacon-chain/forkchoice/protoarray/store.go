@@ -28,6 +28,7 @@ func New(justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) *Fo
 		nodes:          make([]*Node, 0),
 		nodesIndices:   make(map[[32]byte]uint64),
 		canonicalNodes: make(map[[32]byte]bool),
+		slashedIndices: make(map[types.ValidatorIndex]bool),
 		pruneThreshold: defaultPruneThreshold,
 	}
 
@@ -58,12 +59,16 @@ func (f *ForkChoice) Head(
 	// Using the write lock here because `updateCanonicalNodes` that gets called subsequently requires a write operation.
 	f.store.nodesLock.Lock()
 	defer f.store.nodesLock.Unlock()
-	deltas, newVotes, err := computeDeltas(ctx, f.store.nodesIndices, f.votes, f.balances, newBalances)
+	deltas, newVotes, err := computeDeltas(ctx, f.store.nodesIndices, f.votes, f.balances, newBalances, f.store.slashedIndices)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not compute deltas")
 	}
 	f.votes = newVotes
 
+	if err := f.store.applyProposerBoostScore(newBalances, deltas); err != nil {
+		return [32]byte{}, errors.Wrap(err, "Could not apply proposer boost score")
+	}
+
 	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
@@ -100,6 +105,44 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	processedAttestationCount.Inc()
 }
 
+// InsertSlashedIndex marks the validator index as equivocating, from a processed attester slashing.
+// The weight of its latest vote is removed from the tree, and its votes are ignored from now on.
+func (f *ForkChoice) InsertSlashedIndex(ctx context.Context, index types.ValidatorIndex) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.InsertSlashedIndex")
+	defer span.End()
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+	f.store.nodesLock.Lock()
+	defer f.store.nodesLock.Unlock()
+
+	if f.store.slashedIndices[index] {
+		return
+	}
+	f.store.slashedIndices[index] = true
+	equivocatingIndicesCount.Inc()
+
+	// Only the current vote has been applied to the weights, with the last balance of the validator.
+	if uint64(index) >= uint64(len(f.votes)) || uint64(index) >= uint64(len(f.balances)) {
+		return
+	}
+	balance := f.balances[index]
+	i, ok := f.store.nodesIndices[f.votes[index].currentRoot]
+	if !ok {
+		return
+	}
+	// The weight of a node includes the weights of its descendants, so the balance is removed from
+	// the voted node and all its ancestors. Best children are updated on the next head computation.
+	for i != NonExistentNode && i < uint64(len(f.store.nodes)) {
+		n := f.store.nodes[i]
+		if n.weight < balance {
+			n.weight = 0
+		} else {
+			n.weight -= balance
+		}
+		i = n.parent
+	}
+}
+
 // ProcessBlock processes a new block by inserting it to the fork choice store.
 func (f *ForkChoice) ProcessBlock(
 	ctx context.Context,
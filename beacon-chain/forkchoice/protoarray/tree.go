@@ -0,0 +1,85 @@
+package protoarray
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// TreeNode is a copy of a node of the fork choice store, along with its state in fork choice.
+type TreeNode struct {
+	*Node
+	Viable      bool // whether the justified and finalized epochs of the node make it viable for head.
+	WeightDelta int  // change of weight pending since the last head computation, applied on the next one.
+}
+
+// TreeNodes returns copies of all the nodes of the fork choice store, in insertion order. The weight delta
+// of a node is computed from the votes received since the last head computation, with the balances of that
+// computation, and from the change of proposer boost. It includes the deltas of the descendants of the node.
+func (f *ForkChoice) TreeNodes(ctx context.Context) ([]*TreeNode, error) {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.TreeNodes")
+	defer span.End()
+
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	// The votes are rotated by the computation of the deltas, which must not affect the store.
+	votes := make([]Vote, len(f.votes))
+	copy(votes, f.votes)
+	deltas, _, err := computeDeltas(ctx, f.store.nodesIndices, votes, f.balances, f.balances, f.store.slashedIndices)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.store.pendingProposerBoostScore(f.balances, deltas); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*TreeNode, len(f.store.nodes))
+	for i := len(f.store.nodes) - 1; i >= 0; i-- {
+		n := f.store.nodes[i]
+		nodes[i] = &TreeNode{
+			Node:   copyNode(n),
+			Viable: f.store.viableForHead(n),
+		}
+		// As in the head computation, the weight of the zero hash alias of the genesis block is not updated.
+		if n.root == params.BeaconConfig().ZeroHash {
+			continue
+		}
+		nodes[i].WeightDelta = deltas[i]
+		if n.parent == NonExistentNode {
+			continue
+		}
+		if int(n.parent) >= len(deltas) {
+			return nil, errInvalidParentDelta
+		}
+		deltas[n.parent] += deltas[i]
+	}
+	return nodes, nil
+}
+
+// pendingProposerBoostScore adds to the deltas the change of proposer boost which the next head computation
+// applies, without updating the store. The caller must hold the nodes lock.
+func (s *Store) pendingProposerBoostScore(balances []uint64, delta []int) error {
+	s.proposerBoostLock.RLock()
+	defer s.proposerBoostLock.RUnlock()
+
+	if s.proposerBoostRoot == s.previousProposerBoostRoot {
+		return nil
+	}
+	if i, ok := s.nodesIndices[s.previousProposerBoostRoot]; ok && s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash {
+		if int(i) >= len(delta) {
+			return errInvalidNodeDelta
+		}
+		delta[i] -= int(s.previousProposerBoostScore)
+	}
+	if i, ok := s.nodesIndices[s.proposerBoostRoot]; ok && s.proposerBoostRoot != params.BeaconConfig().ZeroHash {
+		if int(i) >= len(delta) {
+			return errInvalidNodeDelta
+		}
+		delta[i] += int(computeProposerBoostScore(balances))
+	}
+	return nil
+}
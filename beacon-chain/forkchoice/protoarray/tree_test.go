@@ -0,0 +1,72 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestTreeNodes_PendingWeightDeltas(t *testing.T) {
+	// With 128 validators of balance 10, the boost is 16.
+	balances := make([]uint64, 128)
+	for i := range balances {
+		balances[i] = 10
+	}
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	ctx := context.Background()
+	f := setup(1, 1)
+
+	// Insert blocks 1, 2 and 3, where block 3 is not viable for head, and vote for block 1:
+	//            0
+	//           / \
+	//  vote -> 1  2
+	//          |
+	//          3
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{'g'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 3, indexToHash(3), indexToHash(1), [32]byte{}, 2, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+
+	// Move the vote to block 2, vote for block 3 and boost block 2, which are pending until the next head computation.
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 3)
+	f.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(3), 3)
+	f.BoostProposerRoot(ctx, 2, indexToHash(2), time.Now().Add(-2*slotDuration))
+
+	nodes, err := f.TreeNodes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(nodes))
+	wanted := []struct {
+		root   [32]byte
+		weight uint64
+		delta  int
+		viable bool
+	}{
+		{root: params.BeaconConfig().ZeroHash, weight: 0, delta: 0, viable: true},
+		{root: indexToHash(1), weight: 10, delta: 10, viable: true},
+		{root: indexToHash(2), weight: 0, delta: 26, viable: true},
+		{root: indexToHash(3), weight: 0, delta: 20, viable: false},
+	}
+	for i, w := range wanted {
+		assert.Equal(t, w.root, nodes[i].Root(), "Unexpected root of node %d", i)
+		assert.Equal(t, w.weight, nodes[i].Weight(), "Unexpected weight of node %d", i)
+		assert.Equal(t, w.delta, nodes[i].WeightDelta, "Unexpected weight delta of node %d", i)
+		assert.Equal(t, w.viable, nodes[i].Viable, "Unexpected viability of node %d", i)
+	}
+	assert.Equal(t, [32]byte{'g'}, nodes[2].Graffiti())
+
+	// The deltas are applied by the next head computation, and are then no longer pending.
+	_, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	nodes, err = f.TreeNodes(ctx)
+	require.NoError(t, err)
+	for i, w := range wanted {
+		assert.Equal(t, w.weight+uint64(w.delta), nodes[i].Weight(), "Unexpected weight of node %d", i)
+		assert.Equal(t, 0, nodes[i].WeightDelta, "Unexpected weight delta of node %d", i)
+	}
+}
@@ -24,6 +24,13 @@ type Store struct {
 	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
 	nodesLock      sync.RWMutex
+
+	slashedIndices map[types.ValidatorIndex]bool // the indices of the equivocating validators, their votes are not counted.
+
+	proposerBoostRoot          [32]byte // root of the timely block of the current slot, which is boosted.
+	previousProposerBoostRoot  [32]byte // root of the block boosted during the last head computation.
+	previousProposerBoostScore uint64   // score given to the block boosted during the last head computation.
+	proposerBoostLock          sync.RWMutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -19,7 +19,7 @@ type MuxConfig struct {
 }
 
 // DefaultConfig returns a fully configured MuxConfig with standard gateway behavior.
-func DefaultConfig(enableDebugRPCEndpoints bool) MuxConfig {
+func DefaultConfig(enableDebugRPCEndpoints, enablePeerAdminEndpoints bool) MuxConfig {
 	v1Alpha1Registrations := []gateway.PbHandlerRegistration{
 		ethpb.RegisterNodeHandler,
 		ethpb.RegisterBeaconChainHandler,
@@ -37,6 +37,9 @@ func DefaultConfig(enableDebugRPCEndpoints bool) MuxConfig {
 		v1Registrations = append(v1Registrations, ethpbv1.RegisterBeaconDebugHandler)
 
 	}
+	if enablePeerAdminEndpoints {
+		v1Alpha1Registrations = append(v1Alpha1Registrations, pbrpc.RegisterPeerAdminHandler)
+	}
 	v1Alpha1Mux := gwruntime.NewServeMux(
 		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
 			Marshaler: &gwruntime.JSONPb{
@@ -9,7 +9,7 @@ import (
 
 func TestDefaultConfig(t *testing.T) {
 	t.Run("Without debug endpoints", func(t *testing.T) {
-		cfg := DefaultConfig(false)
+		cfg := DefaultConfig(false, false)
 		assert.NotNil(t, cfg.Handler)
 		assert.NotNil(t, cfg.V1PbMux.Mux)
 		require.Equal(t, 1, len(cfg.V1PbMux.Patterns))
@@ -22,7 +22,7 @@ func TestDefaultConfig(t *testing.T) {
 	})
 
 	t.Run("With debug endpoints", func(t *testing.T) {
-		cfg := DefaultConfig(true)
+		cfg := DefaultConfig(true, false)
 		assert.NotNil(t, cfg.Handler)
 		assert.NotNil(t, cfg.V1PbMux.Mux)
 		require.Equal(t, 1, len(cfg.V1PbMux.Patterns))
@@ -33,4 +33,13 @@ func TestDefaultConfig(t *testing.T) {
 		assert.Equal(t, "/eth/v1alpha1/", cfg.V1Alpha1PbMux.Patterns[0])
 		assert.Equal(t, 5, len(cfg.V1Alpha1PbMux.Registrations))
 	})
+
+	t.Run("With peer admin endpoints", func(t *testing.T) {
+		cfg := DefaultConfig(false, true)
+		assert.NotNil(t, cfg.Handler)
+		assert.NotNil(t, cfg.V1PbMux.Mux)
+		assert.Equal(t, 4, len(cfg.V1PbMux.Registrations))
+		assert.NotNil(t, cfg.V1Alpha1PbMux.Mux)
+		assert.Equal(t, 5, len(cfg.V1Alpha1PbMux.Registrations))
+	})
 }
@@ -0,0 +1,49 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	eventsDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_monitor_events_dropped_total",
+		Help: "The number of feed events dropped because they did not fit into the queue.",
+	})
+	balanceGwei = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_monitor_balance_gwei",
+		Help: "The balance of a monitored validator at the latest epoch boundary.",
+	}, []string{"validator_index"})
+	attestationsSeenTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_monitor_attestations_seen_total",
+		Help: "The number of epochs in which an attestation of a monitored validator was seen on gossip.",
+	}, []string{"validator_index"})
+	attestationsIncludedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_monitor_attestations_included_total",
+		Help: "The number of epochs in which an attestation of a monitored validator was included in a block.",
+	}, []string{"validator_index"})
+	missedAttestationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_monitor_missed_attestations_total",
+		Help: "The number of epochs in which no attestation of an active monitored validator was included.",
+	}, []string{"validator_index"})
+	inclusionDistance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_monitor_attestation_inclusion_distance",
+		Help: "The number of slots between the latest included attestation of a monitored validator and its inclusion.",
+	}, []string{"validator_index"})
+	correctVotesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_monitor_correct_votes_total",
+		Help: "The number of included attestations of a monitored validator with a correct head, target or source vote.",
+	}, []string{"validator_index", "vote"})
+	proposalsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_monitor_proposals_total",
+		Help: "The number of processed blocks proposed by a monitored validator.",
+	}, []string{"validator_index"})
+	slashingsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_monitor_slashings_total",
+		Help: "The number of included slashings of a monitored validator.",
+	}, []string{"validator_index", "type"})
+	exitsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "validator_monitor_exits_total",
+		Help: "The number of included voluntary exits of a monitored validator.",
+	}, []string{"validator_index"})
+)
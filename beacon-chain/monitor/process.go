@@ -0,0 +1,372 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/sirupsen/logrus"
+)
+
+// epochSummary records the duties of a validator in an epoch. Attestations are recorded at
+// their target epoch, while proposals, slashings and exits are recorded at the epoch of the
+// block which includes them.
+type epochSummary struct {
+	attestationSeen   bool
+	included          bool
+	inclusionSlot     types.Slot
+	inclusionDistance types.Slot
+	correctSource     bool
+	correctTarget     bool
+	correctHead       bool
+	proposedSlots     []types.Slot
+	slashed           bool
+	exited            bool
+}
+
+type trackedValidator struct {
+	// label is the validator index, as used in metric labels.
+	label      string
+	summaries  map[types.Epoch]*epochSummary
+	balance    uint64
+	hasBalance bool
+}
+
+// summary returns the record of an epoch, or nil if the epoch is not monitored: the first epoch
+// is only partially observed, and epochs are not monitored anymore once they are summarized.
+func (s *Service) summary(v *trackedValidator, epoch types.Epoch) *epochSummary {
+	if epoch <= s.startEpoch || epoch < s.nextSummary {
+		return nil
+	}
+	summary, ok := v.summaries[epoch]
+	if !ok {
+		summary = &epochSummary{}
+		v.summaries[epoch] = summary
+	}
+	return summary
+}
+
+func (s *Service) processStateEvent(ctx context.Context, event *feed.Event) {
+	if event.Type != statefeed.BlockProcessed {
+		return
+	}
+	data, ok := event.Data.(*statefeed.BlockProcessedData)
+	if !ok || data.SignedBlock == nil || data.SignedBlock.IsNil() {
+		return
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, data.BlockRoot)
+	if err != nil {
+		log.WithError(err).Debug("Could not get post-state of processed block")
+		return
+	}
+	if st == nil || st.IsNil() {
+		return
+	}
+	s.processBlock(data.SignedBlock, st)
+}
+
+// processBlock records the duties of the tracked validators found in a processed block, given
+// the post-state of the block. The first block of an epoch summarizes the epochs whose
+// attestations can no longer be included.
+func (s *Service) processBlock(blk interfaces.SignedBeaconBlock, st iface.ReadOnlyBeaconState) {
+	slot := blk.Block().Slot()
+	epoch := helpers.SlotToEpoch(slot)
+	s.resolvePubkeys(st)
+	if !s.started {
+		s.started = true
+		s.startEpoch = epoch
+		s.lastEpoch = epoch
+		s.nextSummary = epoch + 1
+		s.updateBalances(st)
+	} else if epoch > s.lastEpoch {
+		s.processEpochBoundary(st, epoch)
+	}
+	s.lastState = st
+
+	if v, ok := s.tracked[blk.Block().ProposerIndex()]; ok {
+		if summary := s.summary(v, epoch); summary != nil {
+			summary.proposedSlots = append(summary.proposedSlots, slot)
+		}
+		proposalsTotal.WithLabelValues(v.label).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": blk.Block().ProposerIndex(),
+			"slot":           slot,
+		}).Info("Block proposed by monitored validator")
+	}
+
+	body := blk.Block().Body()
+	for _, att := range body.Attestations() {
+		s.processIncludedAttestation(st, slot, att)
+	}
+	for _, slashing := range body.ProposerSlashings() {
+		if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+			continue
+		}
+		s.recordSlashing(slashing.Header_1.Header.ProposerIndex, slot, "proposer")
+	}
+	for _, slashing := range body.AttesterSlashings() {
+		if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+			continue
+		}
+		indices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+		for _, idx := range indices {
+			s.recordSlashing(types.ValidatorIndex(idx), slot, "attester")
+		}
+	}
+	for _, exit := range body.VoluntaryExits() {
+		if exit.Exit == nil {
+			continue
+		}
+		v, ok := s.tracked[exit.Exit.ValidatorIndex]
+		if !ok {
+			continue
+		}
+		if summary := s.summary(v, epoch); summary != nil {
+			summary.exited = true
+		}
+		exitsTotal.WithLabelValues(v.label).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": exit.Exit.ValidatorIndex,
+			"slot":           slot,
+			"exitEpoch":      exit.Exit.Epoch,
+		}).Info("Voluntary exit of monitored validator included")
+	}
+}
+
+// processIncludedAttestation records the inclusion of an attestation in the block of a slot,
+// and whether its votes were correct. The source vote of an included attestation is always
+// correct, as blocks with attestations of another source are invalid.
+func (s *Service) processIncludedAttestation(st iface.ReadOnlyBeaconState, slot types.Slot, att *ethpb.Attestation) {
+	indices, ok := s.trackedAttesters(st, att)
+	if !ok {
+		return
+	}
+	correctHead, correctTarget := false, false
+	if root, err := helpers.BlockRootAtSlot(st, att.Data.Slot); err == nil {
+		correctHead = bytes.Equal(root, att.Data.BeaconBlockRoot)
+	}
+	if targetSlot, err := helpers.StartSlot(att.Data.Target.Epoch); err == nil {
+		if root, err := helpers.BlockRootAtSlot(st, targetSlot); err == nil {
+			correctTarget = bytes.Equal(root, att.Data.Target.Root)
+		}
+	}
+	for _, idx := range indices {
+		v := s.tracked[idx]
+		summary := s.summary(v, att.Data.Target.Epoch)
+		if summary == nil || summary.included {
+			continue
+		}
+		summary.included = true
+		summary.inclusionSlot = slot
+		summary.inclusionDistance = slot - att.Data.Slot
+		summary.correctSource = true
+		summary.correctHead = correctHead
+		summary.correctTarget = correctTarget
+
+		attestationsIncludedTotal.WithLabelValues(v.label).Inc()
+		inclusionDistance.WithLabelValues(v.label).Set(float64(summary.inclusionDistance))
+		correctVotesTotal.WithLabelValues(v.label, "source").Inc()
+		if correctHead {
+			correctVotesTotal.WithLabelValues(v.label, "head").Inc()
+		}
+		if correctTarget {
+			correctVotesTotal.WithLabelValues(v.label, "target").Inc()
+		}
+	}
+}
+
+// processGossipAttestation records the attestations of the tracked validators seen on gossip, once
+// they passed gossip validation.
+func (s *Service) processGossipAttestation(att *ethpb.Attestation) {
+	if s.lastState == nil {
+		return
+	}
+	indices, ok := s.trackedAttesters(s.lastState, att)
+	if !ok {
+		return
+	}
+	for _, idx := range indices {
+		v := s.tracked[idx]
+		summary := s.summary(v, att.Data.Target.Epoch)
+		if summary == nil || summary.attestationSeen {
+			continue
+		}
+		summary.attestationSeen = true
+		attestationsSeenTotal.WithLabelValues(v.label).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"slot":           att.Data.Slot,
+		}).Debug("Attestation of monitored validator seen on gossip")
+	}
+}
+
+// trackedAttesters returns the tracked validators among the attesters of an attestation.
+func (s *Service) trackedAttesters(st iface.ReadOnlyBeaconState, att *ethpb.Attestation) ([]types.ValidatorIndex, bool) {
+	if att.Data == nil || att.Data.Target == nil {
+		return nil, false
+	}
+	committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		log.WithError(err).Debug("Could not get attestation committee")
+		return nil, false
+	}
+	indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		log.WithError(err).Debug("Could not get attesting indices")
+		return nil, false
+	}
+	tracked := make([]types.ValidatorIndex, 0)
+	for _, idx := range indices {
+		if _, ok := s.tracked[types.ValidatorIndex(idx)]; ok {
+			tracked = append(tracked, types.ValidatorIndex(idx))
+		}
+	}
+	return tracked, len(tracked) > 0
+}
+
+func (s *Service) recordSlashing(idx types.ValidatorIndex, slot types.Slot, kind string) {
+	v, ok := s.tracked[idx]
+	if !ok {
+		return
+	}
+	if summary := s.summary(v, helpers.SlotToEpoch(slot)); summary != nil {
+		summary.slashed = true
+	}
+	slashingsTotal.WithLabelValues(v.label, kind).Inc()
+	log.WithFields(logrus.Fields{
+		"validatorIndex": idx,
+		"slot":           slot,
+		"type":           kind,
+	}).Warn("Slashing of monitored validator included")
+}
+
+// processEpochBoundary summarizes every epoch before the previous epoch which was not summarized
+// yet, given the post-state of the first block of an epoch. The state holds the rewards of the
+// attestations of the epoch before the previous one, so the balance changes since the previous
+// epoch boundary are only reported for that epoch, and only if the previous epoch had a boundary.
+func (s *Service) processEpochBoundary(st iface.ReadOnlyBeaconState, epoch types.Epoch) {
+	firstEpoch := s.nextSummary
+	consecutive := s.lastEpoch+1 == epoch
+	s.lastEpoch = epoch
+	if epoch-1 > s.nextSummary {
+		s.nextSummary = epoch - 1
+	}
+	balanceChanges := s.updateBalances(st)
+	indices := make([]types.ValidatorIndex, 0, len(s.tracked))
+	for idx := range s.tracked {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	for _, idx := range indices {
+		v := s.tracked[idx]
+		for e := firstEpoch; e+2 <= epoch; e++ {
+			summary, ok := v.summaries[e]
+			if !ok {
+				summary = &epochSummary{}
+			}
+			balanceChange, ok := balanceChanges[idx]
+			if !consecutive || e+2 != epoch {
+				ok = false
+			}
+			s.logSummary(st, idx, v, e, summary, balanceChange, ok)
+		}
+		for e := range v.summaries {
+			if e+2 <= epoch {
+				delete(v.summaries, e)
+			}
+		}
+	}
+}
+
+func (s *Service) logSummary(
+	st iface.ReadOnlyBeaconState,
+	idx types.ValidatorIndex,
+	v *trackedValidator,
+	epoch types.Epoch,
+	summary *epochSummary,
+	balanceChange int64,
+	hasBalanceChange bool,
+) {
+	val, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		// The validator is not known to the state yet.
+		return
+	}
+	active := helpers.IsActiveValidatorUsingTrie(val, epoch)
+	if !active && len(summary.proposedSlots) == 0 && !summary.slashed && !summary.exited {
+		return
+	}
+	fields := logrus.Fields{
+		"validatorIndex":  idx,
+		"epoch":           epoch,
+		"attestationSeen": summary.attestationSeen,
+		"included":        summary.included,
+		"proposedSlots":   summary.proposedSlots,
+		"slashed":         summary.slashed,
+		"exited":          summary.exited,
+		"balance":         v.balance,
+	}
+	if hasBalanceChange {
+		fields["balanceChange"] = balanceChange
+	}
+	if summary.included {
+		fields["inclusionSlot"] = summary.inclusionSlot
+		fields["inclusionDistance"] = summary.inclusionDistance
+		fields["correctSource"] = summary.correctSource
+		fields["correctTarget"] = summary.correctTarget
+		fields["correctHead"] = summary.correctHead
+	}
+	if active && !summary.included {
+		missedAttestationsTotal.WithLabelValues(v.label).Inc()
+		log.WithFields(fields).Warn("Attestation of monitored validator was not included")
+		return
+	}
+	log.WithFields(fields).Info("Monitored validator summary")
+}
+
+// updateBalances records the balances of the tracked validators in a state and returns their
+// changes since the balances were last recorded.
+func (s *Service) updateBalances(st iface.ReadOnlyBeaconState) map[types.ValidatorIndex]int64 {
+	changes := make(map[types.ValidatorIndex]int64, len(s.tracked))
+	for idx, v := range s.tracked {
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			continue
+		}
+		if v.hasBalance {
+			changes[idx] = int64(balance) - int64(v.balance)
+		}
+		v.balance = balance
+		v.hasBalance = true
+		balanceGwei.WithLabelValues(v.label).Set(float64(balance))
+	}
+	return changes
+}
+
+// resolvePubkeys starts tracking the validators of public keys known to a state.
+func (s *Service) resolvePubkeys(st iface.ReadOnlyBeaconState) {
+	for pubkey := range s.pendingPubkeys {
+		idx, ok := st.ValidatorIndexByPubkey(pubkey)
+		if !ok {
+			continue
+		}
+		delete(s.pendingPubkeys, pubkey)
+		s.track(idx)
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"pubkey":         fmt.Sprintf("%#x", pubkey),
+		}).Info("Monitoring validator of public key")
+	}
+}
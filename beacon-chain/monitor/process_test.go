@@ -0,0 +1,157 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_ProcessBlocks(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	genesis, privKeys := testutil.DeterministicGenesisState(t, 64)
+	pubkey := genesis.PubkeyAtIndex(5)
+	s, err := NewService(ctx, &Config{
+		TrackedIndices: []types.ValidatorIndex{1, 2, 3, 4},
+		TrackedPubkeys: [][48]byte{pubkey},
+	})
+	require.NoError(t, err)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	st := genesis.Copy()
+	var gossipAtt *ethpb.Attestation
+	var proposer types.ValidatorIndex
+	for slot := types.Slot(1); slot <= 2*slotsPerEpoch; slot++ {
+		blk, err := testutil.GenerateFullBlock(st, privKeys, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wrapped := interfaces.WrappedPhase0SignedBeaconBlock(blk)
+		st, err = state.ExecuteStateTransition(ctx, st, wrapped)
+		require.NoError(t, err)
+		if slot == slotsPerEpoch+8 {
+			// The attestation of the block is seen on gossip before the block is processed.
+			gossipAtt = blk.Block.Body.Attestations[0]
+			s.processGossipAttestation(gossipAtt)
+			proposer = blk.Block.ProposerIndex
+			s.track(proposer)
+		}
+		s.processBlock(wrapped, st)
+	}
+
+	// Public keys are resolved once the first block is processed.
+	require.NotNil(t, s.tracked[5])
+	assert.Equal(t, 0, len(s.pendingPubkeys))
+
+	// Every validator attested in epoch 1 and was included in the next slot.
+	for _, idx := range []types.ValidatorIndex{1, 2, 3, 4, 5} {
+		summary, ok := s.tracked[idx].summaries[1]
+		require.Equal(t, true, ok, "No summary of validator %d", idx)
+		assert.Equal(t, true, summary.included)
+		assert.Equal(t, types.Slot(1), summary.inclusionDistance)
+		assert.Equal(t, true, summary.correctSource)
+		assert.Equal(t, true, summary.correctTarget)
+		assert.Equal(t, true, summary.correctHead)
+		// The first epoch is not monitored, as it was only partially observed.
+		_, ok = s.tracked[idx].summaries[0]
+		assert.Equal(t, false, ok)
+	}
+	committee, err := helpers.BeaconCommitteeFromState(st, gossipAtt.Data.Slot, gossipAtt.Data.CommitteeIndex)
+	require.NoError(t, err)
+	attesters, err := attestationutil.AttestingIndices(gossipAtt.AggregationBits, committee)
+	require.NoError(t, err)
+	for _, idx := range attesters {
+		if v, ok := s.tracked[types.ValidatorIndex(idx)]; ok {
+			assert.Equal(t, true, v.summaries[1].attestationSeen)
+		}
+	}
+	proposedSlots := s.tracked[proposer].summaries[1].proposedSlots
+	require.NotEqual(t, 0, len(proposedSlots))
+	assert.Equal(t, slotsPerEpoch+8, proposedSlots[0])
+
+	// Epoch 1 is summarized once a block of epoch 3 is processed.
+	s.tracked[2].summaries[1].included = false
+	blk, err := testutil.GenerateFullBlock(st, privKeys, testutil.DefaultBlockGenConfig(), 3*slotsPerEpoch)
+	require.NoError(t, err)
+	wrapped := interfaces.WrappedPhase0SignedBeaconBlock(blk)
+	st, err = state.ExecuteStateTransition(ctx, st, wrapped)
+	require.NoError(t, err)
+	s.processBlock(wrapped, st)
+	_, ok := s.tracked[1].summaries[1]
+	assert.Equal(t, false, ok)
+	assert.Equal(t, types.Epoch(2), s.nextSummary)
+	require.LogsContain(t, hook, "Monitored validator summary")
+	require.LogsContain(t, hook, "Attestation of monitored validator was not included")
+	balance, err := st.BalanceAtIndex(1)
+	require.NoError(t, err)
+	assert.Equal(t, balance, s.tracked[1].balance)
+
+	// Attestations of summarized epochs are not recorded anymore.
+	assert.Equal(t, (*epochSummary)(nil), s.summary(s.tracked[1], 1))
+}
+
+func TestService_ProcessBlock_Slashings(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	genesis, privKeys := testutil.DeterministicGenesisState(t, 64)
+	s, err := NewService(ctx, &Config{TrackedIndices: []types.ValidatorIndex{7}})
+	require.NoError(t, err)
+
+	blk, err := testutil.GenerateFullBlock(genesis, privKeys, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	blk.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{
+		{Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 7}}},
+	}
+	blk.Block.Body.AttesterSlashings = []*ethpb.AttesterSlashing{
+		{
+			Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{6, 7}},
+			Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{7, 8}},
+		},
+	}
+	blk.Block.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 7}},
+	}
+	s.processBlock(interfaces.WrappedPhase0SignedBeaconBlock(blk), genesis)
+	require.LogsContain(t, hook, "type=proposer")
+	require.LogsContain(t, hook, "type=attester")
+	require.LogsContain(t, hook, "Voluntary exit of monitored validator included")
+}
+
+func TestService_ProcessEpochBoundary_BalanceChange(t *testing.T) {
+	hook := logTest.NewGlobal()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	s, err := NewService(context.Background(), &Config{TrackedIndices: []types.ValidatorIndex{1}})
+	require.NoError(t, err)
+	s.started = true
+	s.lastEpoch = 4
+	s.nextSummary = 3
+	balance, err := st.BalanceAtIndex(1)
+	require.NoError(t, err)
+	s.updateBalances(st)
+
+	// Epochs 3 and 4 are summarized without a balance change, as the state skipped the boundary of epoch 5.
+	require.NoError(t, st.UpdateBalancesAtIndex(1, balance+100))
+	s.processEpochBoundary(st, 6)
+	require.Equal(t, 2, len(hook.Entries))
+	for _, entry := range hook.Entries {
+		_, ok := entry.Data["balanceChange"]
+		assert.Equal(t, false, ok, "Balance change reported for epoch %v", entry.Data["epoch"])
+	}
+	hook.Reset()
+
+	// The balance change between consecutive boundaries is reported for the epoch whose rewards it holds.
+	require.NoError(t, st.UpdateBalancesAtIndex(1, balance+150))
+	s.processEpochBoundary(st, 7)
+	require.Equal(t, 1, len(hook.Entries))
+	assert.Equal(t, types.Epoch(5), hook.LastEntry().Data["epoch"])
+	assert.Equal(t, int64(50), hook.LastEntry().Data["balanceChange"])
+}
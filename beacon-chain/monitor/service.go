@@ -0,0 +1,200 @@
+// Package monitor defines a service which tracks the duties of a configured set of validators.
+// It follows the attestations which passed gossip validation and the blocks processed by the beacon node, and
+// records per validator and epoch whether an attestation was seen, when and how correctly it
+// was included, as well as proposals, slashings, exits and balance changes. The records are
+// exposed as Prometheus metrics labeled with the validator index and summarized in logs at
+// every epoch boundary.
+package monitor
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// Number of feed events waiting to be processed. Events which do not fit are dropped, as the
+// feeds are blocked until every subscriber received an event.
+const defaultMaxQueueSize = 1 << 12
+
+// Config options for the validator monitor service.
+type Config struct {
+	StateNotifier statefeed.Notifier
+	StateGen      stategen.StateManager
+	// TrackedIndices and TrackedPubkeys are the validators to track. Public keys are resolved
+	// to indices once the validators are known to the beacon state.
+	TrackedIndices []types.ValidatorIndex
+	TrackedPubkeys [][48]byte
+	// MaxQueueSize is the maximum number of feed events waiting to be processed.
+	MaxQueueSize int
+}
+
+// Service tracks the attestations, proposals, slashings, exits and balances of a set of validators.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	events chan *queuedEvent
+
+	// The fields below are only accessed by the goroutine processing events.
+	tracked        map[types.ValidatorIndex]*trackedValidator
+	pendingPubkeys map[[48]byte]bool
+	// lastState is the post-state of the last processed block, used to compute the committees
+	// of attestations seen on gossip.
+	lastState iface.ReadOnlyBeaconState
+	// startEpoch is the epoch of the first processed block, lastEpoch the epoch of the latest
+	// one, and nextSummary the first epoch which was not summarized yet.
+	startEpoch  types.Epoch
+	lastEpoch   types.Epoch
+	nextSummary types.Epoch
+	started     bool
+}
+
+// NewService creates a validator monitor service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.TrackedIndices) == 0 && len(cfg.TrackedPubkeys) == 0 {
+		return nil, errors.New("no validators to track")
+	}
+	if cfg.MaxQueueSize <= 0 {
+		cfg.MaxQueueSize = defaultMaxQueueSize
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:            cfg,
+		ctx:            ctx,
+		cancel:         cancel,
+		events:         make(chan *queuedEvent, cfg.MaxQueueSize),
+		tracked:        make(map[types.ValidatorIndex]*trackedValidator),
+		pendingPubkeys: make(map[[48]byte]bool),
+	}
+	for _, idx := range cfg.TrackedIndices {
+		s.track(idx)
+	}
+	for _, pubkey := range cfg.TrackedPubkeys {
+		s.pendingPubkeys[pubkey] = true
+	}
+	return s, nil
+}
+
+// Start subscribes to the state feed and processes its events, along with the observed attestations.
+func (s *Service) Start() {
+	stateChan := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChan)
+
+	log.WithField("validators", len(s.cfg.TrackedIndices)+len(s.cfg.TrackedPubkeys)).Info("Monitoring validators")
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		defer stateSub.Unsubscribe()
+		for {
+			select {
+			case event := <-stateChan:
+				if event.Type == statefeed.BlockProcessed {
+					s.enqueue(&queuedEvent{event: event})
+				}
+			case err := <-stateSub.Err():
+				log.WithError(err).Error("Subscription to state feed failed")
+				return
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		for {
+			select {
+			case queued := <-s.events:
+				if queued.attestation != nil {
+					s.processGossipAttestation(queued.attestation)
+				} else {
+					s.processStateEvent(s.ctx, queued.event)
+				}
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+// Status of the validator monitor service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// ObserveAttestation records an attestation seen on gossip. It must only be called with attestations
+// which passed gossip validation, as the attestations of the tracked validators are counted as seen.
+func (s *Service) ObserveAttestation(att *ethpb.Attestation) {
+	s.enqueue(&queuedEvent{attestation: att})
+}
+
+// queuedEvent is either an event of the state feed, or an observed attestation.
+type queuedEvent struct {
+	event       *feed.Event
+	attestation *ethpb.Attestation
+}
+
+func (s *Service) enqueue(event *queuedEvent) {
+	select {
+	case s.events <- event:
+	default:
+		eventsDroppedTotal.Inc()
+	}
+}
+
+func (s *Service) track(idx types.ValidatorIndex) {
+	if _, ok := s.tracked[idx]; ok {
+		return
+	}
+	s.tracked[idx] = &trackedValidator{
+		label:     strconv.FormatUint(uint64(idx), 10),
+		summaries: make(map[types.Epoch]*epochSummary),
+	}
+}
+
+// ParseTrackedValidators parses a list of validator indices and hex encoded public keys.
+func ParseTrackedValidators(values []string) ([]types.ValidatorIndex, [][48]byte, error) {
+	indices := make([]types.ValidatorIndex, 0, len(values))
+	pubkeys := make([][48]byte, 0)
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if strings.HasPrefix(value, "0x") {
+			pubkey, err := hexutil.Decode(value)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not decode public key %s", value)
+			}
+			if len(pubkey) != 48 {
+				return nil, nil, errors.Errorf("public key %s is not 48 bytes long", value)
+			}
+			pubkeys = append(pubkeys, bytesutil.ToBytes48(pubkey))
+			continue
+		}
+		idx, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "%s is neither a validator index nor a public key", value)
+		}
+		indices = append(indices, types.ValidatorIndex(idx))
+	}
+	return indices, pubkeys, nil
+}
@@ -0,0 +1,31 @@
+package monitor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNewService_NoValidators(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{})
+	assert.ErrorContains(t, "no validators to track", err)
+}
+
+func TestParseTrackedValidators(t *testing.T) {
+	pubkey := "0x" + strings.Repeat("ab", 48)
+	indices, pubkeys, err := ParseTrackedValidators([]string{"1", " 20 ", pubkey, ""})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 20}, indices)
+	require.Equal(t, 1, len(pubkeys))
+	assert.Equal(t, byte(0xab), pubkeys[0][0])
+	assert.Equal(t, byte(0xab), pubkeys[0][47])
+
+	_, _, err = ParseTrackedValidators([]string{"0xabcd"})
+	assert.ErrorContains(t, "is not 48 bytes long", err)
+	_, _, err = ParseTrackedValidators([]string{"validator"})
+	assert.ErrorContains(t, "neither a validator index nor a public key", err)
+}
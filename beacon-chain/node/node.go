@@ -17,14 +17,19 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/exporter"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/journal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	gateway2 "github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
@@ -32,9 +37,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apiaccess"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator/bodyprovider"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -66,16 +77,20 @@ type BeaconNode struct {
 	lock            sync.RWMutex
 	stop            chan struct{} // Channel to wait for termination notifications.
 	db              db.Database
+	slasherDB       db.SlasherDatabase
 	attestationPool attestations.Pool
 	exitPool        voluntaryexits.PoolManager
 	slashingsPool   slashings.PoolManager
 	depositCache    *depositcache.DepositCache
+	livenessCache   *cache.LivenessCache
 	stateFeed       *event.Feed
 	blockFeed       *event.Feed
 	opFeed          *event.Feed
 	forkChoiceStore forkchoice.ForkChoicer
 	stateGen        *stategen.State
 	collector       *bcnodeCollector
+	apiAccess       *apiaccess.Controller
+	journal         *journal.Writer
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -110,6 +125,7 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		attestationPool: attestations.NewPool(),
 		exitPool:        voluntaryexits.NewPool(),
 		slashingsPool:   slashings.NewPool(),
+		livenessCache:   cache.NewLivenessCache(),
 	}
 
 	depositAddress, err := registration.DepositContractAddress()
@@ -120,6 +136,12 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.SlasherFlag.Name) {
+		if err := beacon.startSlasherDB(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -138,12 +160,26 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
-	beacon.startForkChoice()
+	if err := beacon.registerExporterService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.startForkChoice(); err != nil {
+		return nil, err
+	}
 
 	if err := beacon.registerBlockchainService(); err != nil {
 		return nil, err
 	}
 
+	if err := beacon.registerSlasherService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerValidatorMonitorService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerInitialSyncService(); err != nil {
 		return nil, err
 	}
@@ -152,6 +188,14 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerAPIAccessController(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -237,14 +281,36 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.slasherDB != nil {
+		if err := b.slasherDB.Close(); err != nil {
+			log.Errorf("Failed to close slasher database: %v", err)
+		}
+	}
+	if b.journal != nil {
+		if err := b.journal.Close(); err != nil {
+			log.Errorf("Failed to close fork choice journal: %v", err)
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
 }
 
-func (b *BeaconNode) startForkChoice() {
-	f := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
-	b.forkChoiceStore = f
+func (b *BeaconNode) startForkChoice() error {
+	path := b.cliCtx.String(flags.ForkChoiceJournal.Name)
+	if path == "" {
+		f := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+		b.forkChoiceStore = f
+		return nil
+	}
+	w, err := journal.NewWriter(path)
+	if err != nil {
+		return errors.Wrap(err, "could not open fork choice journal")
+	}
+	log.WithField("path", path).Info("Recording fork choice inputs to journal")
+	b.journal = w
+	b.forkChoiceStore = journal.New(w, 0, 0, params.BeaconConfig().ZeroHash)
+	return nil
 }
 
 func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
@@ -324,6 +390,16 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		return err
 	}
 
+	checkpointInitializer, err := newCheckpointInitializer(cliCtx)
+	if err != nil {
+		return err
+	}
+	if checkpointInitializer != nil {
+		if err := checkpointInitializer.Initialize(b.ctx, b.db); err != nil {
+			return errors.Wrap(err, "could not initialize database from checkpoint")
+		}
+	}
+
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
 		return err
@@ -345,6 +421,72 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+// startSlasherDB opens the slasher database, clearing it first if requested by the cli flags.
+func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
+	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if cliCtx.IsSet(flags.SlasherDirFlag.Name) {
+		baseDir = cliCtx.String(flags.SlasherDirFlag.Name)
+	}
+	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
+	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
+	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
+
+	log.WithField("database-path", dbPath).Info("Checking slasher DB")
+
+	d, err := db.NewSlasherDB(b.ctx, dbPath, &slasherkv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	clearDBConfirmed := false
+	if clearDB && !forceClearDB {
+		actionText := "This will delete your slasher database stored in your data directory. " +
+			"Your database backups will not be removed - do you want to proceed? (Y/N)"
+		deniedText := "Slasher database will not be deleted. No changes have been made."
+		clearDBConfirmed, err = cmd.ConfirmAction(actionText, deniedText)
+		if err != nil {
+			return err
+		}
+	}
+	if clearDBConfirmed || forceClearDB {
+		log.Warning("Removing slasher database")
+		if err := d.Close(); err != nil {
+			return errors.Wrap(err, "could not close slasher db prior to clearing")
+		}
+		if err := d.ClearDB(); err != nil {
+			return errors.Wrap(err, "could not clear slasher database")
+		}
+		d, err = db.NewSlasherDB(b.ctx, dbPath, &slasherkv.Config{
+			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not create new slasher database")
+		}
+	}
+	b.slasherDB = d
+	return nil
+}
+
+// newCheckpointInitializer returns the checkpoint sync initializer selected by the cli flags,
+// or nil if the node should sync from genesis.
+func newCheckpointInitializer(cliCtx *cli.Context) (checkpoint.Initializer, error) {
+	statePath := cliCtx.String(flags.CheckpointStatePath.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlockPath.Name)
+	remoteURL := cliCtx.String(flags.CheckpointSyncURL.Name)
+	if remoteURL != "" {
+		if statePath != "" || blockPath != "" {
+			return nil, fmt.Errorf("--%s can't be used together with --%s or --%s", flags.CheckpointSyncURL.Name,
+				flags.CheckpointStatePath.Name, flags.CheckpointBlockPath.Name)
+		}
+		return checkpoint.NewAPIInitializer(remoteURL)
+	}
+	if statePath != "" || blockPath != "" {
+		return checkpoint.NewFileInitializer(statePath, blockPath)
+	}
+	return nil, nil
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
 }
@@ -429,9 +571,11 @@ func (b *BeaconNode) registerBlockchainService() error {
 		MaxRoutines:             maxRoutines,
 		StateNotifier:           b,
 		ForkChoiceStore:         b.forkChoiceStore,
+		ForkChoiceJournal:       b.journal,
 		AttService:              attService,
 		StateGen:                b.stateGen,
 		WeakSubjectivityCheckpt: wsCheckpt,
+		LivenessCache:           b.livenessCache,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -489,6 +633,15 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
+	var attestationObserver regularsync.AttestationObserver
+	if len(b.cliCtx.StringSlice(flags.MonitorValidatorsFlag.Name)) > 0 {
+		var monitorService *monitor.Service
+		if err := b.services.FetchService(&monitorService); err != nil {
+			return err
+		}
+		attestationObserver = monitorService
+	}
+
 	rs := regularsync.NewService(b.ctx, &regularsync.Config{
 		DB:                  b.db,
 		P2P:                 b.fetchP2P(),
@@ -501,6 +654,8 @@ func (b *BeaconNode) registerSyncService() error {
 		ExitPool:            b.exitPool,
 		SlashingPool:        b.slashingsPool,
 		StateGen:            b.stateGen,
+		LivenessCache:       b.livenessCache,
+		AttestationObserver: attestationObserver,
 	})
 
 	return b.services.RegisterService(rs)
@@ -522,6 +677,46 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		P2P:         b.fetchP2P(),
+		DB:          b.db,
+		Chain:       chainService,
+		InitialSync: initSync,
+	})
+	return b.services.RegisterService(bs)
+}
+
+// registerAPIAccessController loads the access control of the API, which applies to both the gRPC server and
+// the HTTP gateway.
+func (b *BeaconNode) registerAPIAccessController() error {
+	path := b.cliCtx.String(flags.ApiAccessConfig.Name)
+	if path == "" {
+		return nil
+	}
+	cfg, err := apiaccess.LoadConfig(path)
+	if err != nil {
+		return err
+	}
+	controller, err := apiaccess.NewController(cfg)
+	if err != nil {
+		return err
+	}
+	log.WithField("path", path).Info("Loaded API access config")
+	b.apiAccess = controller
+	return nil
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -538,6 +733,11 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var backfillService *backfill.Service
+	if err := b.services.FetchService(&backfillService); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -562,44 +762,62 @@ func (b *BeaconNode) registerRPCService() error {
 	key := b.cliCtx.String(flags.KeyFlag.Name)
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	enablePeerAdminEndpoints := b.cliCtx.Bool(flags.EnablePeerAdminRPCEndpoints.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	p2pService := b.fetchP2P()
+	var peerListManager *p2p.Service
+	if err := b.services.FetchService(&peerListManager); err != nil {
+		return err
+	}
+	var blockBodyProvider bodyprovider.Provider
+	if url := b.cliCtx.String(flags.BlockBodyProviderURL.Name); url != "" {
+		log.WithField("url", url).Info("Using block body provider for proposals")
+		blockBodyProvider = bodyprovider.NewHTTPProvider(url)
+	}
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
-		Host:                    host,
-		Port:                    port,
-		BeaconMonitoringHost:    beaconMonitoringHost,
-		BeaconMonitoringPort:    beaconMonitoringPort,
-		CertFlag:                cert,
-		KeyFlag:                 key,
-		BeaconDB:                b.db,
-		Broadcaster:             p2pService,
-		PeersFetcher:            p2pService,
-		PeerManager:             p2pService,
-		MetadataProvider:        p2pService,
-		ChainInfoFetcher:        chainService,
-		HeadFetcher:             chainService,
-		CanonicalFetcher:        chainService,
-		ForkFetcher:             chainService,
-		FinalizationFetcher:     chainService,
-		BlockReceiver:           chainService,
-		AttestationReceiver:     chainService,
-		GenesisTimeFetcher:      chainService,
-		GenesisFetcher:          chainService,
-		AttestationsPool:        b.attestationPool,
-		ExitPool:                b.exitPool,
-		SlashingsPool:           b.slashingsPool,
-		POWChainService:         web3Service,
-		ChainStartFetcher:       chainStartFetcher,
-		MockEth1Votes:           mockEth1DataVotes,
-		SyncService:             syncService,
-		DepositFetcher:          depositFetcher,
-		PendingDepositFetcher:   b.depositCache,
-		BlockNotifier:           b,
-		StateNotifier:           b,
-		OperationNotifier:       b,
-		StateGen:                b.stateGen,
-		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
-		MaxMsgSize:              maxMsgSize,
+		Host:                     host,
+		Port:                     port,
+		BeaconMonitoringHost:     beaconMonitoringHost,
+		BeaconMonitoringPort:     beaconMonitoringPort,
+		CertFlag:                 cert,
+		KeyFlag:                  key,
+		BeaconDB:                 b.db,
+		Broadcaster:              p2pService,
+		PeersFetcher:             p2pService,
+		PeerManager:              p2pService,
+		PeerListManager:          peerListManager,
+		MetadataProvider:         p2pService,
+		ChainInfoFetcher:         chainService,
+		HeadFetcher:              chainService,
+		CanonicalFetcher:         chainService,
+		ForkFetcher:              chainService,
+		FinalizationFetcher:      chainService,
+		ForkChoiceTreeFetcher:    chainService,
+		BlockReceiver:            chainService,
+		AttestationReceiver:      chainService,
+		GenesisTimeFetcher:       chainService,
+		GenesisFetcher:           chainService,
+		AttestationsPool:         b.attestationPool,
+		ExitPool:                 b.exitPool,
+		SlashingsPool:            b.slashingsPool,
+		POWChainService:          web3Service,
+		ChainStartFetcher:        chainStartFetcher,
+		MockEth1Votes:            mockEth1DataVotes,
+		SyncService:              syncService,
+		BackfillStatusFetcher:    backfillService,
+		DepositFetcher:           depositFetcher,
+		PendingDepositFetcher:    b.depositCache,
+		BlockNotifier:            b,
+		StateNotifier:            b,
+		OperationNotifier:        b,
+		StateGen:                 b.stateGen,
+		LivenessCache:            b.livenessCache,
+		EnableDebugRPCEndpoints:  enableDebugRPCEndpoints,
+		EnablePeerAdminEndpoints: enablePeerAdminEndpoints,
+		MaxMsgSize:               maxMsgSize,
+		AccessController:         b.apiAccess,
+		BlockBodyProvider:        blockBodyProvider,
+		BlockBodyProviderTimeout: b.cliCtx.Duration(flags.BlockBodyProviderTimeout.Name),
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -653,10 +871,20 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	apiMiddlewareAddress := fmt.Sprintf("%s:%d", gatewayHost, apiMiddlewarePort)
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	enablePeerAdminEndpoints := b.cliCtx.Bool(flags.EnablePeerAdminRPCEndpoints.Name)
 	selfCert := b.cliCtx.String(flags.CertFlag.Name)
 	maxCallSize := b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 
-	gatewayConfig := gateway2.DefaultConfig(enableDebugRPCEndpoints)
+	gatewayConfig := gateway2.DefaultConfig(enableDebugRPCEndpoints, enablePeerAdminEndpoints)
+
+	eventStreamer := eventsv1.NewStreamer(b.ctx, &eventsv1.StreamerConfig{
+		StateNotifier:     b,
+		BlockNotifier:     b,
+		OperationNotifier: b,
+	})
+	if err := b.services.RegisterService(eventStreamer); err != nil {
+		return err
+	}
 
 	g := gateway.New(
 		b.ctx,
@@ -667,11 +895,75 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	).WithAllowedOrigins(allowedOrigins).
 		WithRemoteCert(selfCert).
 		WithMaxCallRecvMsgSize(maxCallSize).
-		WithApiMiddleware(apiMiddlewareAddress, &apimiddleware.BeaconEndpointFactory{})
+		WithApiMiddleware(apiMiddlewareAddress, &apimiddleware.BeaconEndpointFactory{EventStreamer: eventStreamer, AccessController: b.apiAccess})
 
 	return b.services.RegisterService(g)
 }
 
+func (b *BeaconNode) registerExporterService() error {
+	sinkURL := b.cliCtx.String(flags.ExporterSinkFlag.Name)
+	if sinkURL == "" {
+		return nil
+	}
+	sink, err := exporter.NewSink(sinkURL)
+	if err != nil {
+		return errors.Wrap(err, "could not create exporter sink")
+	}
+	svc, err := exporter.NewService(b.ctx, &exporter.Config{
+		StateNotifier:     b,
+		BlockNotifier:     b,
+		OperationNotifier: b,
+		Sink:              sink,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create exporter service")
+	}
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerSlasherService() error {
+	if b.slasherDB == nil {
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc, err := slasher.NewService(b.ctx, &slasher.Config{
+		Database:          b.slasherDB,
+		HeadStateFetcher:  chainService,
+		StateNotifier:     b,
+		BlockNotifier:     b,
+		OperationNotifier: b,
+		SlashingsPool:     b.slashingsPool,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create slasher service")
+	}
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerValidatorMonitorService() error {
+	values := b.cliCtx.StringSlice(flags.MonitorValidatorsFlag.Name)
+	if len(values) == 0 {
+		return nil
+	}
+	indices, pubkeys, err := monitor.ParseTrackedValidators(values)
+	if err != nil {
+		return errors.Wrap(err, "could not parse monitored validators")
+	}
+	svc, err := monitor.NewService(b.ctx, &monitor.Config{
+		StateNotifier:  b,
+		StateGen:       b.stateGen,
+		TrackedIndices: indices,
+		TrackedPubkeys: pubkeys,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create validator monitor service")
+	}
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerInteropServices() error {
 	genesisTime := b.cliCtx.Uint64(flags.InteropGenesisTimeFlag.Name)
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -25,8 +25,9 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (s *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// Disallow dialing banned peers.
+	return !s.peers.IsBanned(pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
@@ -49,7 +50,9 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
-	if s.isPeerAtLimit(true /* inbound */) {
+	// Trusted peers are accepted regardless of the peer limit. As their identity is not known yet, they are
+	// recognized by their ip address here, and the peer limit is enforced again once the connection is secured.
+	if s.isPeerAtLimit(true /* inbound */) && !s.peers.IsTrustedAddress(n.RemoteMultiaddr()) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
 		return false
@@ -59,7 +62,19 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(direction network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	// Disallow banned peers, whose identity is only known once the connection is secured.
+	if s.peers.IsBanned(pid) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned"}).Trace("Not accepting connection from peer")
+		return false
+	}
+	// Other peers sharing the ip address of a trusted peer are subject to the peer limit.
+	if direction == network.DirInbound && !s.peers.IsTrusted(pid) && s.isPeerAtLimit(true /* inbound */) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "at peer limit"}).Trace("Not accepting connection from peer")
+		return false
+	}
 	return true
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
@@ -471,3 +472,60 @@ func (c *maEndpoints) LocalMultiaddr() ma.Multiaddr {
 func (c *maEndpoints) RemoteMultiaddr() ma.Multiaddr {
 	return c.raddr
 }
+
+func TestService_AcceptTrustedPeerBeyondLimit(t *testing.T) {
+	limit := 20
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    limit,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: mockp2p.NewTestP2P(t).BHost,
+		cfg:  &Config{MaxPeers: uint(limit)},
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+
+	inboundLimit := float64(limit)*peers.InboundRatio + highWatermarkBuffer + 1
+	for i := 0; i < int(inboundLimit); i++ {
+		addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	trustedAddress, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	otherAddress, err := ma.NewMultiaddr("/ip4/212.67.10.123/tcp/3000")
+	require.NoError(t, err)
+	s.peers.AddTrusted("fake", trustedAddress)
+
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: trustedAddress}), "Trusted peer was rejected")
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: otherAddress}), "Peer beyond limit was accepted")
+
+	// Only the trusted peer is accepted once its identity is known, not the other peers sharing its ip address.
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, "fake", &maEndpoints{raddr: trustedAddress}), "Trusted peer was rejected")
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, "other", &maEndpoints{raddr: trustedAddress}), "Peer beyond limit was accepted")
+	assert.Equal(t, true, s.InterceptSecured(network.DirOutbound, "other", &maEndpoints{raddr: trustedAddress}), "Outbound peer was rejected")
+}
+
+func TestService_InterceptBannedPeer(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    20,
+			ScorerParams: &scorers.Config{},
+		}),
+		host: mockp2p.NewTestP2P(t).BHost,
+		cfg:  &Config{MaxPeers: 20},
+	}
+	multiAddress, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	pid := peer.ID("banned")
+
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}))
+
+	s.peers.Ban(pid, 0, "spam")
+	assert.Equal(t, false, s.InterceptPeerDial(pid), "Banned peer was dialed")
+	assert.Equal(t, false, s.InterceptAddrDial(pid, multiAddress), "Banned peer was dialed")
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}), "Banned peer was accepted")
+	assert.Equal(t, true, s.InterceptPeerDial("other"))
+}
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/connmgr"
@@ -90,6 +91,14 @@ type PeersProvider interface {
 	Peers() *peers.Status
 }
 
+// PeerListManager bans and trusts peers. The banned and trusted peers persist across restarts of the node.
+type PeerListManager interface {
+	BanPeer(pid peer.ID, duration time.Duration, reason string) error
+	UnbanPeer(pid peer.ID) bool
+	AddTrustedPeer(info peer.AddrInfo) error
+	RemoveTrustedPeer(pid peer.ID) bool
+}
+
 // MetadataProvider returns the metadata related information for the local peer.
 type MetadataProvider interface {
 	Metadata() interfaces.Metadata
@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -25,6 +27,21 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	gossipPeerScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_peer_score",
+		Help: "The gossip score of a peer, as computed by gossipsub.",
+	},
+		[]string{"peer"})
+	gossipPeerBehaviourPenalty = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_peer_behaviour_penalty",
+		Help: "The gossipsub behaviour penalty of a peer.",
+	},
+		[]string{"peer"})
+	gossipPeerTopicStats = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_peer_topic_stats",
+		Help: "The gossip score statistics of a peer in a topic, with the time in mesh in seconds.",
+	},
+		[]string{"peer", "topic", "stat"})
 )
 
 func (s *Service) updateMetrics() {
@@ -34,3 +51,22 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
 }
+
+// updateGossipScoreMetrics replaces the gossip score metrics with the scores of the peers gossipsub
+// currently tracks.
+func updateGossipScoreMetrics(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
+	gossipPeerScore.Reset()
+	gossipPeerBehaviourPenalty.Reset()
+	gossipPeerTopicStats.Reset()
+	for pid, snap := range peerMap {
+		id := pid.String()
+		gossipPeerScore.WithLabelValues(id).Set(snap.Score)
+		gossipPeerBehaviourPenalty.WithLabelValues(id).Set(snap.BehaviourPenalty)
+		for topic, stats := range snap.Topics {
+			gossipPeerTopicStats.WithLabelValues(id, topic, "time_in_mesh").Set(stats.TimeInMesh.Seconds())
+			gossipPeerTopicStats.WithLabelValues(id, topic, "first_message_deliveries").Set(stats.FirstMessageDeliveries)
+			gossipPeerTopicStats.WithLabelValues(id, topic, "mesh_message_deliveries").Set(stats.MeshMessageDeliveries)
+			gossipPeerTopicStats.WithLabelValues(id, topic, "invalid_message_deliveries").Set(stats.InvalidMessageDeliveries)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package p2p
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+)
+
+// peerListSaveInterval is how often the banned and trusted peers are saved to the data directory.
+const peerListSaveInterval = 5 * time.Minute
+
+// BanPeer bans the peer for the given duration, or until it is unbanned if the duration is zero, and
+// disconnects from it.
+func (s *Service) BanPeer(pid peer.ID, duration time.Duration, reason string) error {
+	s.peers.Ban(pid, duration, reason)
+	s.savePeerList()
+	return s.Disconnect(pid)
+}
+
+// UnbanPeer lifts the ban of the peer. It returns false if the peer was not banned.
+func (s *Service) UnbanPeer(pid peer.ID) bool {
+	unbanned := s.peers.Unban(pid)
+	s.savePeerList()
+	return unbanned
+}
+
+// AddTrustedPeer trusts the peer and connects to it. Trusted peers are not subject to the peer limit nor
+// to the scoring of peers, and the node reconnects to them when they disconnect. The peer must have exactly
+// one address, which is the one the node reconnects to.
+func (s *Service) AddTrustedPeer(info peer.AddrInfo) error {
+	if len(info.Addrs) != 1 {
+		return errors.Errorf("trusted peer must have exactly one address, got %d", len(info.Addrs))
+	}
+	s.peers.AddTrusted(info.ID, info.Addrs[0])
+	s.savePeerList()
+	s.host.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
+	return connectWithTimeout(s.ctx, s.host, &info)
+}
+
+// RemoveTrustedPeer stops trusting the peer. It returns false if the peer was not trusted.
+func (s *Service) RemoveTrustedPeer(pid peer.ID) bool {
+	removed := s.peers.RemoveTrusted(pid)
+	s.savePeerList()
+	return removed
+}
+
+// ensureTrustedPeerConnections reconnects to the trusted peers the node is not connected to.
+func (s *Service) ensureTrustedPeerConnections(ctx context.Context) {
+	for _, trusted := range s.peers.TrustedPeers() {
+		if trusted.Address == nil || len(s.host.Network().ConnsToPeer(trusted.ID)) > 0 {
+			continue
+		}
+		info := &peer.AddrInfo{ID: trusted.ID, Addrs: []ma.Multiaddr{trusted.Address}}
+		if err := connectWithTimeout(ctx, s.host, info); err != nil {
+			log.WithField("peer", trusted.ID).WithError(err).Debug("Failed to reconnect to trusted peer")
+		}
+	}
+}
+
+// peerListFile is the file of the data directory which persists the banned and trusted peers. It is empty
+// if the node has no data directory.
+func (s *Service) peerListFile() string {
+	if s.cfg.DataDir == "" {
+		return ""
+	}
+	return path.Join(s.cfg.DataDir, peerListPath)
+}
+
+func (s *Service) loadPeerList() {
+	file := s.peerListFile()
+	if file == "" {
+		return
+	}
+	if err := s.peers.LoadPeerList(file); err != nil {
+		log.WithError(err).Error("Could not load banned and trusted peers")
+	}
+}
+
+func (s *Service) savePeerList() {
+	file := s.peerListFile()
+	if file == "" {
+		return
+	}
+	if err := s.peers.SavePeerList(file); err != nil {
+		log.WithError(err).Error("Could not save banned and trusted peers")
+	}
+}
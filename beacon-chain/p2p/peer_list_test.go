@@ -0,0 +1,33 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_AddTrustedPeer_RequiresSingleAddress(t *testing.T) {
+	s := &Service{
+		cfg: &Config{},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	first, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	second, err := ma.NewMultiaddr("/ip4/212.67.10.123/tcp/3000")
+	require.NoError(t, err)
+	pid := peer.ID("trusted")
+
+	err = s.AddTrustedPeer(peer.AddrInfo{ID: pid})
+	assert.ErrorContains(t, "exactly one address", err)
+	err = s.AddTrustedPeer(peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{first, second}})
+	assert.ErrorContains(t, "exactly one address", err)
+	assert.Equal(t, false, s.peers.IsTrusted(pid), "Peer with several addresses was trusted")
+}
@@ -0,0 +1,175 @@
+package peers
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// BannedPeer describes a peer which is not allowed to connect to the node, either because it was banned
+// by the operator or because it is considered bad by the peer scorers.
+type BannedPeer struct {
+	ID           peer.ID
+	Reason       string
+	Expiry       time.Time // zero if the ban does not expire.
+	Manual       bool      // whether the peer was banned by the operator.
+	BadResponses int
+}
+
+// TrustedPeer describes a peer which is always accepted by the node, regardless of its peer limit and
+// of the score of the peer.
+type TrustedPeer struct {
+	ID      peer.ID
+	Address ma.Multiaddr
+}
+
+// manualBan is a ban of a peer by the operator.
+type manualBan struct {
+	reason string
+	expiry time.Time
+}
+
+// Ban bans the peer for the given duration, or until it is unbanned if the duration is zero. A banned
+// peer is no longer trusted.
+func (p *Status) Ban(pid peer.ID, duration time.Duration, reason string) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	ban := &manualBan{reason: reason}
+	if duration > 0 {
+		ban.expiry = timeutils.Now().Add(duration)
+	}
+	p.bans[pid] = ban
+	delete(p.trusted, pid)
+}
+
+// Unban lifts the ban of the peer by the operator, and clears the bad responses which make the peer
+// bad for the scorers. It returns false if the peer was not banned.
+func (p *Status) Unban(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	unbanned := p.isBanned(pid)
+	delete(p.bans, pid)
+	if peerData, ok := p.store.PeerData(pid); ok && peerData.BadResponses > 0 {
+		unbanned = unbanned || peerData.BadResponses >= p.scorers.BadResponsesScorer().Params().Threshold
+		peerData.BadResponses = 0
+	}
+	return unbanned
+}
+
+// IsBanned states if the peer is currently banned by the operator.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.isBanned(pid)
+}
+
+// isBanned is a lock-free version of IsBanned.
+func (p *Status) isBanned(pid peer.ID) bool {
+	ban, ok := p.bans[pid]
+	if !ok {
+		return false
+	}
+	return ban.expiry.IsZero() || timeutils.Now().Before(ban.expiry)
+}
+
+// BannedPeers returns the peers banned by the operator, and the peers which the bad responses scorer
+// considers bad. The bans of the scorer expire once the bad responses of the peer have decayed.
+func (p *Status) BannedPeers() []*BannedPeer {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	banned := make([]*BannedPeer, 0, len(p.bans))
+	for pid, ban := range p.bans {
+		if !p.isBanned(pid) {
+			delete(p.bans, pid)
+			continue
+		}
+		banned = append(banned, &BannedPeer{
+			ID:     pid,
+			Reason: ban.reason,
+			Expiry: ban.expiry,
+			Manual: true,
+		})
+	}
+	params := p.scorers.BadResponsesScorer().Params()
+	for pid, peerData := range p.store.Peers() {
+		if _, ok := p.bans[pid]; ok || peerData.BadResponses < params.Threshold {
+			continue
+		}
+		banned = append(banned, &BannedPeer{
+			ID:           pid,
+			Reason:       "bad responses",
+			Expiry:       timeutils.Now().Add(time.Duration(peerData.BadResponses) * params.DecayInterval),
+			BadResponses: peerData.BadResponses,
+		})
+	}
+	return banned
+}
+
+// AddTrusted trusts the peer at the given address. Trusted peers are not subject to the peer limit, nor
+// to the scoring of peers. A trusted peer is no longer banned.
+func (p *Status) AddTrusted(pid peer.ID, address ma.Multiaddr) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	p.trusted[pid] = address
+	delete(p.bans, pid)
+}
+
+// RemoveTrusted stops trusting the peer. It returns false if the peer was not trusted.
+func (p *Status) RemoveTrusted(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	_, ok := p.trusted[pid]
+	delete(p.trusted, pid)
+	return ok
+}
+
+// IsTrusted states if the peer is trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	_, ok := p.trusted[pid]
+	return ok
+}
+
+// IsTrustedAddress states if the address has the ip address of a trusted peer. It is used to accept the
+// inbound connections of trusted peers, before their identity is known.
+func (p *Status) IsTrustedAddress(address ma.Multiaddr) bool {
+	ip, err := manet.ToIP(address)
+	if err != nil {
+		return false
+	}
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	for _, trustedAddress := range p.trusted {
+		if trustedAddress == nil {
+			continue
+		}
+		trustedIP, err := manet.ToIP(trustedAddress)
+		if err == nil && trustedIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// TrustedPeers returns the trusted peers.
+func (p *Status) TrustedPeers() []*TrustedPeer {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	trusted := make([]*TrustedPeer, 0, len(p.trusted))
+	for pid, address := range p.trusted {
+		trusted = append(trusted, &TrustedPeer{ID: pid, Address: address})
+	}
+	return trusted
+}
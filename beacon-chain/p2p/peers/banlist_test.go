@@ -0,0 +1,148 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_Ban(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, false, p.IsBad(pid))
+
+	p.Ban(pid, 0, "spam")
+	assert.Equal(t, true, p.IsBanned(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+	banned := p.BannedPeers()
+	require.Equal(t, 1, len(banned))
+	assert.Equal(t, pid, banned[0].ID)
+	assert.Equal(t, "spam", banned[0].Reason)
+	assert.Equal(t, true, banned[0].Manual)
+	assert.Equal(t, true, banned[0].Expiry.IsZero())
+
+	assert.Equal(t, true, p.Unban(pid))
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, false, p.IsBad(pid))
+	assert.Equal(t, false, p.Unban(pid), "Peer was unbanned twice")
+}
+
+func TestStatus_BanExpires(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	p.Ban(pid, time.Millisecond, "spam")
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, 0, len(p.BannedPeers()))
+}
+
+func TestStatus_BannedPeers_BadResponses(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     2,
+				DecayInterval: time.Hour,
+			},
+		},
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	addPeer(t, p, peers.PeerConnected)
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	p.Scorers().BadResponsesScorer().Increment(pid)
+
+	banned := p.BannedPeers()
+	require.Equal(t, 1, len(banned))
+	assert.Equal(t, pid, banned[0].ID)
+	assert.Equal(t, false, banned[0].Manual)
+	assert.Equal(t, 2, banned[0].BadResponses)
+	assert.Equal(t, true, banned[0].Expiry.After(time.Now().Add(time.Hour)))
+
+	// Unbanning clears the bad responses of the peer.
+	assert.Equal(t, true, p.Unban(pid))
+	assert.Equal(t, false, p.IsBad(pid))
+	assert.Equal(t, 0, len(p.BannedPeers()))
+}
+
+func TestStatus_Trusted(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	otherAddress, err := ma.NewMultiaddr("/ip4/213.202.254.181/tcp/13000")
+	require.NoError(t, err)
+
+	p.Ban(pid, 0, "spam")
+	p.AddTrusted(pid, address)
+	assert.Equal(t, true, p.IsTrusted(pid))
+	assert.Equal(t, false, p.IsBanned(pid), "Trusted peer is still banned")
+	assert.Equal(t, true, p.IsTrustedAddress(address))
+	assert.Equal(t, false, p.IsTrustedAddress(otherAddress))
+
+	// Trusted peers are not bad for the scorers.
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	assert.Equal(t, false, p.IsBad(pid))
+	trusted := p.TrustedPeers()
+	require.Equal(t, 1, len(trusted))
+	assert.Equal(t, pid, trusted[0].ID)
+	assert.Equal(t, address.String(), trusted[0].Address.String())
+
+	assert.Equal(t, true, p.RemoveTrusted(pid))
+	assert.Equal(t, false, p.IsTrusted(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+	assert.Equal(t, false, p.RemoveTrusted(pid))
+
+	// Banning a trusted peer stops trusting it.
+	p.AddTrusted(pid, address)
+	p.Ban(pid, 0, "spam")
+	assert.Equal(t, false, p.IsTrusted(pid))
+}
+
+func TestStatus_PeersToPrune_KeepsTrusted(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	for i := 0; i < 15; i++ {
+		createPeer(t, p, nil, network.DirOutbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	for i := 0; i < 18; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		// Only two inbound peers are not trusted.
+		if i >= 2 {
+			p.AddTrusted(pid, address)
+		}
+	}
+
+	peersToPrune := p.PeersToPrune()
+	assert.Equal(t, 2, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, p.IsTrusted(pid), "Trusted peer is pruned")
+	}
+}
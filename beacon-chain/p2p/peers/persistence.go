@@ -0,0 +1,128 @@
+package peers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// peerList is the persisted form of the banned and trusted peers, so that a restart of the node does not
+// forget the misbehaving peers.
+type peerList struct {
+	SavedAt int64             `json:"saved_at"`
+	Banned  []*persistedBan   `json:"banned"`
+	Trusted []*persistedTrust `json:"trusted"`
+}
+
+// persistedBan is a ban by the operator, or the bad responses of a peer the scorer considers bad.
+type persistedBan struct {
+	ID           string `json:"id"`
+	Reason       string `json:"reason,omitempty"`
+	Expiry       int64  `json:"expiry,omitempty"` // unix time, zero if the ban does not expire.
+	Manual       bool   `json:"manual"`
+	BadResponses int    `json:"bad_responses,omitempty"`
+}
+
+type persistedTrust struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+}
+
+// SavePeerList writes the banned and trusted peers to the file at the path.
+func (p *Status) SavePeerList(path string) error {
+	list := &peerList{
+		SavedAt: timeutils.Now().Unix(),
+		Banned:  make([]*persistedBan, 0),
+		Trusted: make([]*persistedTrust, 0),
+	}
+	for _, b := range p.BannedPeers() {
+		ban := &persistedBan{
+			ID:           b.ID.String(),
+			Reason:       b.Reason,
+			Manual:       b.Manual,
+			BadResponses: b.BadResponses,
+		}
+		if !b.Expiry.IsZero() {
+			ban.Expiry = b.Expiry.Unix()
+		}
+		list.Banned = append(list.Banned, ban)
+	}
+	for _, t := range p.TrustedPeers() {
+		trust := &persistedTrust{ID: t.ID.String()}
+		if t.Address != nil {
+			trust.Address = t.Address.String()
+		}
+		list.Trusted = append(list.Trusted, trust)
+	}
+	b, err := json.Marshal(list)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal peer list")
+	}
+	return fileutil.WriteFile(path, b)
+}
+
+// LoadPeerList restores the banned and trusted peers from the file at the path, if it exists. The expired
+// bans are dropped, and the bad responses of the peers are decayed by the time elapsed since the file
+// was saved.
+func (p *Status) LoadPeerList(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read peer list")
+	}
+	list := &peerList{}
+	if err := json.Unmarshal(b, list); err != nil {
+		return errors.Wrap(err, "could not unmarshal peer list")
+	}
+
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := timeutils.Now()
+	decayInterval := p.scorers.BadResponsesScorer().Params().DecayInterval
+	decays := int(now.Sub(time.Unix(list.SavedAt, 0)) / decayInterval)
+	for _, ban := range list.Banned {
+		pid, err := peer.Decode(ban.ID)
+		if err != nil {
+			return errors.Wrapf(err, "invalid banned peer id %s", ban.ID)
+		}
+		if ban.Expiry != 0 && !now.Before(time.Unix(ban.Expiry, 0)) {
+			continue
+		}
+		if ban.Manual {
+			b := &manualBan{reason: ban.Reason}
+			if ban.Expiry != 0 {
+				b.expiry = time.Unix(ban.Expiry, 0)
+			}
+			p.bans[pid] = b
+			continue
+		}
+		if ban.BadResponses > decays {
+			p.store.PeerDataGetOrCreate(pid).BadResponses = ban.BadResponses - decays
+		}
+	}
+	for _, trust := range list.Trusted {
+		pid, err := peer.Decode(trust.ID)
+		if err != nil {
+			return errors.Wrapf(err, "invalid trusted peer id %s", trust.ID)
+		}
+		var address ma.Multiaddr
+		if trust.Address != "" {
+			address, err = ma.NewMultiaddr(trust.Address)
+			if err != nil {
+				return errors.Wrapf(err, "invalid address of trusted peer %s", trust.ID)
+			}
+		}
+		p.trusted[pid] = address
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package peers_test
+
+import (
+	"context"
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_SaveLoadPeerList(t *testing.T) {
+	config := &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     2,
+				DecayInterval: time.Hour,
+			},
+		},
+	}
+	p := peers.NewStatus(context.Background(), config)
+	manual := addPeerWithKey(t, p)
+	expiring := addPeerWithKey(t, p)
+	expired := addPeerWithKey(t, p)
+	bad := addPeerWithKey(t, p)
+	trusted := addPeerWithKey(t, p)
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	p.Ban(manual, 0, "spam")
+	p.Ban(expiring, time.Hour, "invalid blocks")
+	p.Ban(expired, time.Millisecond, "timeouts")
+	for i := 0; i < 3; i++ {
+		p.Scorers().BadResponsesScorer().Increment(bad)
+	}
+	p.AddTrusted(trusted, address)
+	path := filepath.Join(t.TempDir(), "peerList")
+	require.NoError(t, p.SavePeerList(path))
+	time.Sleep(5 * time.Millisecond)
+
+	restored := peers.NewStatus(context.Background(), config)
+	require.NoError(t, restored.LoadPeerList(path))
+	assert.Equal(t, true, restored.IsBanned(manual))
+	assert.Equal(t, true, restored.IsBanned(expiring))
+	assert.Equal(t, false, restored.IsBanned(expired), "Expired ban was restored")
+	assert.Equal(t, true, restored.IsBad(bad), "Bad responses were not restored")
+	assert.Equal(t, true, restored.IsTrusted(trusted))
+	assert.Equal(t, true, restored.IsTrustedAddress(address))
+
+	banned := make(map[string]*peers.BannedPeer)
+	for _, b := range restored.BannedPeers() {
+		banned[b.ID.String()] = b
+	}
+	require.Equal(t, 3, len(banned))
+	assert.Equal(t, "spam", banned[manual.String()].Reason)
+	assert.Equal(t, true, banned[manual.String()].Expiry.IsZero())
+	assert.Equal(t, false, banned[expiring.String()].Expiry.IsZero())
+	assert.Equal(t, 3, banned[bad.String()].BadResponses)
+}
+
+func TestStatus_LoadPeerList_Missing(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	require.NoError(t, p.LoadPeerList(filepath.Join(t.TempDir(), "peerList")))
+	assert.Equal(t, 0, len(p.BannedPeers()))
+	assert.Equal(t, 0, len(p.TrustedPeers()))
+}
+
+// addPeerWithKey adds a connected peer whose id is derived from a key, as the ids of the peer list are
+// decoded from their string form.
+func addPeerWithKey(t *testing.T, p *peers.Status) peer.ID {
+	key, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	p.Add(nil, pid, nil, network.DirInbound)
+	p.SetConnectionState(pid, peers.PeerConnected)
+	return pid
+}
@@ -1,6 +1,8 @@
 package scorers
 
 import (
+	"math"
+
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -8,6 +10,10 @@ import (
 
 var _ Scorer = (*GossipScorer)(nil)
 
+// DefaultGossipScoreScale defines the gossip score at which a peer gets the full gossip contribution
+// to its overall score.
+const DefaultGossipScoreScale = 100.0
+
 // GossipScorer represents scorer that evaluates peers based on their gossip performance.
 // Gossip scoring metrics are periodically calculated in libp2p's internal pubsub module.
 type GossipScorer struct {
@@ -16,20 +22,31 @@ type GossipScorer struct {
 }
 
 // GossipScorerConfig holds configuration parameters for gossip scoring service.
-type GossipScorerConfig struct{}
+type GossipScorerConfig struct {
+	// Threshold defines the gossip score below which a peer is considered bad, any negative score
+	// if zero.
+	Threshold float64
+	// ScoreScale defines the magnitude of the gossip score at which a peer gets the full gossip
+	// contribution, positive or negative, to its overall score.
+	ScoreScale float64
+}
 
 // newGossipScorer creates new gossip scoring service.
 func newGossipScorer(store *peerdata.Store, config *GossipScorerConfig) *GossipScorer {
 	if config == nil {
 		config = &GossipScorerConfig{}
 	}
-	return &GossipScorer{
+	scorer := &GossipScorer{
 		config: config,
 		store:  store,
 	}
+	if scorer.config.ScoreScale == 0 {
+		scorer.config.ScoreScale = DefaultGossipScoreScale
+	}
+	return scorer
 }
 
-// Score returns calculated peer score.
+// Score returns calculated peer score, which is the gossip score computed by gossipsub.
 func (s *GossipScorer) Score(pid peer.ID) float64 {
 	s.store.RLock()
 	defer s.store.RUnlock()
@@ -45,6 +62,12 @@ func (s *GossipScorer) score(pid peer.ID) float64 {
 	return peerData.GossipScore
 }
 
+// normalizedScore returns the gossip score scaled to the [-1, 1] range of the overall peer score.
+// This is a lock-free function.
+func (s *GossipScorer) normalizedScore(pid peer.ID) float64 {
+	return math.Max(-1, math.Min(1, s.score(pid)/s.config.ScoreScale))
+}
+
 // IsBadPeer states if the peer is to be considered bad.
 func (s *GossipScorer) IsBadPeer(pid peer.ID) bool {
 	s.store.RLock()
@@ -58,7 +81,7 @@ func (s *GossipScorer) isBadPeer(pid peer.ID) bool {
 	if !ok {
 		return false
 	}
-	return peerData.GossipScore < 0
+	return peerData.GossipScore < s.config.Threshold
 }
 
 // BadPeers returns the peers that are considered bad.
@@ -75,6 +98,11 @@ func (s *GossipScorer) BadPeers() []peer.ID {
 	return badPeers
 }
 
+// Params exposes scorer's parameters.
+func (s *GossipScorer) Params() *GossipScorerConfig {
+	return s.config
+}
+
 // SetGossipData sets the gossip related data of a peer.
 func (s *GossipScorer) SetGossipData(pid peer.ID, gScore float64,
 	bPenalty float64, topicScores map[string]*pbrpc.TopicScoreSnapshot) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -65,3 +66,26 @@ func TestScorers_Gossip_Score(t *testing.T) {
 		})
 	}
 }
+
+func TestScorers_Gossip_Threshold(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		ScorerParams: &scorers.Config{
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold: -100,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+	assert.Equal(t, scorers.DefaultGossipScoreScale, scorer.Params().ScoreScale, "Unexpected default score scale")
+
+	scorer.SetGossipData("peer1", -10.0, 1, nil)
+	assert.Equal(t, false, scorer.IsBadPeer("peer1"), "Unexpected bad peer above the threshold")
+	assert.Equal(t, false, peerStatuses.IsBad("peer1"))
+	scorer.SetGossipData("peer1", -101.0, 1, nil)
+	assert.Equal(t, true, scorer.IsBadPeer("peer1"), "Unexpected good peer below the threshold")
+	assert.Equal(t, true, peerStatuses.IsBad("peer1"))
+	assert.DeepEqual(t, []peer.ID{"peer1"}, scorer.BadPeers())
+}
@@ -61,7 +61,7 @@ func NewService(ctx context.Context, store *peerdata.Store, config *Config) *Ser
 	s.scorers.peerStatusScorer = newPeerStatusScorer(store, config.PeerStatusScorerConfig)
 	s.setScorerWeight(s.scorers.peerStatusScorer, 0.0)
 	s.scorers.gossipScorer = newGossipScorer(store, config.GossipScorerConfig)
-	s.setScorerWeight(s.scorers.gossipScorer, 0.0)
+	s.setScorerWeight(s.scorers.gossipScorer, 1.0)
 
 	// Start background tasks.
 	go s.loop(ctx)
@@ -112,7 +112,7 @@ func (s *Service) Score(pid peer.ID) float64 {
 	score += s.scorers.badResponsesScorer.score(pid) * s.scorerWeight(s.scorers.badResponsesScorer)
 	score += s.scorers.blockProviderScorer.score(pid) * s.scorerWeight(s.scorers.blockProviderScorer)
 	score += s.scorers.peerStatusScorer.score(pid) * s.scorerWeight(s.scorers.peerStatusScorer)
-	score += s.scorers.gossipScorer.score(pid) * s.scorerWeight(s.scorers.gossipScorer)
+	score += s.scorers.gossipScorer.normalizedScore(pid) * s.scorerWeight(s.scorers.gossipScorer)
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
@@ -131,8 +131,9 @@ func (s *Service) isBadPeer(pid peer.ID) bool {
 	if s.scorers.peerStatusScorer.isBadPeer(pid) {
 		return true
 	}
-	// TODO(#6043): Hook in gossip scorer's relevant
-	// method to check if peer has a bad gossip score.
+	if s.scorers.gossipScorer.isBadPeer(pid) {
+		return true
+	}
 	return false
 }
 
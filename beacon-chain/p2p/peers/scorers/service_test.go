@@ -118,7 +118,7 @@ func TestScorers_Service_Score(t *testing.T) {
 			peerStatuses.Add(nil, pid, nil, network.DirUnknown)
 			// Not yet used peer gets boosted score.
 			startScore := s.BlockProviderScorer().MaxScore()
-			assert.Equal(t, startScore/float64(s.ActiveScorersCount()), s.Score(pid), "Unexpected score for not yet used peer")
+			assert.Equal(t, roundScore(startScore/float64(s.ActiveScorersCount())), s.Score(pid), "Unexpected score for not yet used peer")
 		}
 		return s, pids
 	}
@@ -156,8 +156,8 @@ func TestScorers_Service_Score(t *testing.T) {
 	t.Run("block providers score", func(t *testing.T) {
 		s, pids := setupScorer()
 		s1 := s.BlockProviderScorer()
-		startScore := s.BlockProviderScorer().MaxScore() / 2
-		batchWeight := s1.Params().ProcessedBatchWeight / 2
+		startScore := s.BlockProviderScorer().MaxScore() / float64(s.ActiveScorersCount())
+		batchWeight := s1.Params().ProcessedBatchWeight / float64(s.ActiveScorersCount())
 
 		// Partial batch.
 		s1.IncrementProcessedBlocks("peer1", batchSize/4)
@@ -205,6 +205,25 @@ func TestScorers_Service_Score(t *testing.T) {
 		s2.Increment("peer1")
 		assert.Equal(t, roundScore(batchWeight*5+3*penalty), s.Score("peer1"), "Unexpected overall score")
 	})
+
+	t.Run("gossip score", func(t *testing.T) {
+		s, _ := setupScorer()
+		s1 := s.BlockProviderScorer()
+		s2 := s.GossipScorer()
+		startScore := s1.MaxScore() / float64(s.ActiveScorersCount())
+		maxGossipScore := 1 / float64(s.ActiveScorersCount())
+		scale := s2.Params().ScoreScale
+
+		s2.SetGossipData("peer1", scale/2, 0, nil)
+		assert.Equal(t, roundScore(startScore+maxGossipScore/2), s.Score("peer1"))
+		// Gossip scores beyond the scale are capped.
+		s2.SetGossipData("peer1", scale*10, 0, nil)
+		assert.Equal(t, roundScore(startScore+maxGossipScore), s.Score("peer1"))
+		s2.SetGossipData("peer1", -scale*10, 0, nil)
+		assert.Equal(t, roundScore(startScore-maxGossipScore), s.Score("peer1"))
+		assert.Equal(t, true, s.IsBadPeer("peer1"))
+		assert.DeepEqual(t, []peer.ID{"peer1"}, s.BadPeers())
+	})
 }
 
 func TestScorers_Service_loop(t *testing.T) {
@@ -81,6 +81,10 @@ type Status struct {
 	store     *peerdata.Store
 	ipTracker map[string]uint64
 	rand      *rand.Rand
+	// The bans and trusted peers are kept apart from the peer data, which is pruned. Their access is
+	// guarded by the store mutex.
+	bans    map[peer.ID]*manualBan
+	trusted map[peer.ID]ma.Multiaddr
 }
 
 // StatusConfig represents peer status service params.
@@ -103,7 +107,9 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		ipTracker: map[string]uint64{},
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
-		rand: rand.NewDeterministicGenerator(),
+		rand:    rand.NewDeterministicGenerator(),
+		bans:    make(map[peer.ID]*manualBan),
+		trusted: make(map[peer.ID]ma.Multiaddr),
 	}
 }
 
@@ -323,9 +329,20 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 	return timeutils.Now(), peerdata.ErrPeerUnknown
 }
 
-// IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
+// IsBad states if the peer is to be considered bad (by *any* of the registered scorers), or if it is
+// banned. Trusted peers are never considered bad by the scorers.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
+	p.store.RLock()
+	banned := p.isBanned(pid)
+	_, trusted := p.trusted[pid]
+	p.store.RUnlock()
+	if banned {
+		return true
+	}
+	if trusted {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
 }
 
@@ -704,8 +721,11 @@ func (p *Status) PeersToPrune() []peer.ID {
 		badResp int
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select connected and inbound peers to prune.
+	// Select connected and inbound peers to prune, trusted peers are kept.
 	for pid, peerData := range p.store.Peers() {
+		if _, trusted := p.trusted[pid]; trusted {
+			continue
+		}
 		if peerData.ConnState == PeerConnected &&
 			peerData.Direction == network.DirInbound {
 			peersToPrune = append(peersToPrune, &peerResp{
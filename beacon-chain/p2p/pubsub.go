@@ -115,10 +115,22 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
 	// Iterate through all the connected peers and through any of their
 	// relevant topics.
+	scorer := s.peers.Scorers().GossipScorer()
 	for pid, snap := range peerMap {
-		s.peers.Scorers().GossipScorer().SetGossipData(pid, snap.Score,
+		scorer.SetGossipData(pid, snap.Score,
 			snap.BehaviourPenalty, convertTopicScores(snap.Topics))
 	}
+	// Gossipsub forgets the peers which have been disconnected for long enough, their gossip
+	// score is then reset so that they are no longer considered bad.
+	for _, pid := range s.peers.All() {
+		if _, ok := peerMap[pid]; ok {
+			continue
+		}
+		if score, penalty, _, err := scorer.GossipData(pid); err == nil && (score != 0 || penalty != 0) {
+			scorer.SetGossipData(pid, 0, 0, nil)
+		}
+	}
+	updateGossipScoreMetrics(peerMap)
 }
 
 // Content addressable ID function.
@@ -8,9 +8,15 @@ import (
 	"time"
 
 	"github.com/golang/snappy"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -71,3 +77,46 @@ func TestMessageIDFunction_HashesCorrectly(t *testing.T) {
 	msgID = string(hashedData[:20])
 	assert.Equal(t, msgID, msgIDFunction(nMsg), "Got incorrect msg id")
 }
+
+func TestService_PeerInspector(t *testing.T) {
+	scoreParams, thresholds := peerScoringParams()
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{
+				GossipScorerConfig: &scorers.GossipScorerConfig{
+					Threshold:  thresholds.GossipThreshold,
+					ScoreScale: scoreParams.TopicScoreCap,
+				},
+			},
+		}),
+	}
+	good, bad := peer.ID("good"), peer.ID("bad")
+	s.peers.Add(nil, good, nil, network.DirInbound)
+	s.peers.Add(nil, bad, nil, network.DirInbound)
+	topic := "/eth2/00000000/beacon_block/ssz_snappy"
+
+	s.peerInspector(map[peer.ID]*pubsub.PeerScoreSnapshot{
+		good: {
+			Score: 10,
+			Topics: map[string]*pubsub.TopicScoreSnapshot{
+				topic: {TimeInMesh: 2 * time.Second, FirstMessageDeliveries: 3},
+			},
+		},
+		bad: {Score: thresholds.GossipThreshold - 1, BehaviourPenalty: 20},
+	})
+	assert.Equal(t, false, s.peers.IsBad(good))
+	assert.Equal(t, true, s.peers.IsBad(bad), "Peer below the gossip threshold is not bad")
+	assert.Equal(t, true, s.peers.Scorers().Score(bad) < s.peers.Scorers().Score(good))
+	_, _, topicScores, err := s.peers.Scorers().GossipScorer().GossipData(good)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2000), topicScores[topic].TimeInMesh)
+	assert.Equal(t, float32(3), topicScores[topic].FirstMessageDeliveries)
+	assert.Equal(t, 10.0, promtestutil.ToFloat64(gossipPeerScore.WithLabelValues(good.String())))
+	assert.Equal(t, 20.0, promtestutil.ToFloat64(gossipPeerBehaviourPenalty.WithLabelValues(bad.String())))
+	assert.Equal(t, 3.0, promtestutil.ToFloat64(gossipPeerTopicStats.WithLabelValues(good.String(), topic, "first_message_deliveries")))
+
+	// Peers which gossipsub no longer tracks have their gossip score reset.
+	s.peerInspector(map[peer.ID]*pubsub.PeerScoreSnapshot{})
+	assert.Equal(t, false, s.peers.IsBad(bad))
+	assert.Equal(t, 0.0, s.peers.Scorers().GossipScorer().Score(bad))
+}
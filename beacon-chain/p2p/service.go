@@ -130,6 +130,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	s.host = h
 	s.host.RemoveStreamHandler(identify.IDDelta)
 
+	scoreParams, thresholds := peerScoringParams()
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -141,7 +142,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pubsub.WithSubscriptionFilter(s),
 		pubsub.WithPeerOutboundQueueSize(256),
 		pubsub.WithValidateQueueSize(256),
-		pubsub.WithPeerScore(peerScoringParams()),
+		pubsub.WithPeerScore(scoreParams, thresholds),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 	}
 	// Set the pubsub global parameters that we require.
@@ -161,8 +162,15 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 				Threshold:     maxBadResponses,
 				DecayInterval: time.Hour,
 			},
+			// Peers are bad once gossipsub stops gossiping with them, and the peers with the highest
+			// topic score get the full gossip contribution to their overall score.
+			GossipScorerConfig: &scorers.GossipScorerConfig{
+				Threshold:  thresholds.GossipThreshold,
+				ScoreScale: scoreParams.TopicScoreCap,
+			},
 		},
 	})
+	s.loadPeerList()
 
 	return s, nil
 }
@@ -223,6 +231,10 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	runutil.RunEvery(s.ctx, peerListSaveInterval, s.savePeerList)
+	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
+		s.ensureTrustedPeerConnections(s.ctx)
+	})
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
@@ -259,6 +271,7 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	s.savePeerList()
 	return nil
 }
 
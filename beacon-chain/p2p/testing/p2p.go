@@ -46,6 +46,8 @@ type TestP2P struct {
 func NewTestP2P(t *testing.T) *TestP2P {
 	ctx := context.Background()
 	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	// Floodsub does not score peers, so the gossip scores of the test peers only change when tests set
+	// them through the gossip scorer, which keeps peer scoring deterministic.
 	ps, err := pubsub.NewFloodSub(ctx, h,
 		pubsub.WithMessageSigning(false),
 		pubsub.WithStrictSignatureVerification(false),
@@ -28,6 +28,7 @@ import (
 
 const keyPath = "network-keys"
 const metaDataPath = "metaData"
+const peerListPath = "peerList"
 
 const dialTimeout = 1 * time.Second
 
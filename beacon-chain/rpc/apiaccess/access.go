@@ -0,0 +1,255 @@
+// Package apiaccess controls the access to the gRPC API of the beacon node, and to the HTTP API served
+// through the gRPC gateway, with per-route rate limits and allowlists of clients identified by bearer
+// tokens or mTLS certificates.
+package apiaccess
+
+import (
+	"crypto/subtle"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readOnlyBlockedPrefixes are the prefixes of the names of the methods which submit data to the beacon
+// node, and which clients with the read-only role may not call.
+var readOnlyBlockedPrefixes = []string{"Submit", "Propose", "Subscribe", "Set"}
+
+// readOnlyBlockedServices are the services whose methods all change the state of the beacon node, except
+// for the listed ones, and which clients with the read-only role may not call.
+var readOnlyBlockedServices = map[string][]string{
+	"/ethereum.beacon.rpc.v1.PeerAdmin/": {"ListManagedPeers"},
+}
+
+// Controller decides whether API clients may call gRPC methods.
+type Controller struct {
+	anonymousRole Role
+	clients       []*ClientConfig
+	clientsByCN   map[string]*ClientConfig
+	clientCAs     *x509.CertPool
+	routes        []*route
+}
+
+type route struct {
+	method  string
+	prefix  bool
+	allow   map[string]bool
+	limiter *leakybucket.Collector
+}
+
+// identity of the caller of a method. The client is nil for anonymous callers.
+type identity struct {
+	client  *ClientConfig
+	address string
+}
+
+// rateLimitKey identifies the caller in the rate limiters. Anonymous callers are told apart by their address.
+func (id *identity) rateLimitKey() string {
+	if id.client != nil {
+		return "client:" + id.client.Name
+	}
+	return "address:" + id.address
+}
+
+func (id *identity) name() string {
+	if id.client != nil {
+		return id.client.Name
+	}
+	return "anonymous"
+}
+
+// NewController creates an access controller from its configuration.
+func NewController(cfg *Config) (*Controller, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid API access config")
+	}
+	c := &Controller{
+		anonymousRole: cfg.AnonymousRole,
+		clients:       cfg.Clients,
+		clientsByCN:   make(map[string]*ClientConfig),
+	}
+	if c.anonymousRole == "" {
+		c.anonymousRole = RoleFull
+	}
+	for _, client := range cfg.Clients {
+		if client.CertificateCommonName != "" {
+			c.clientsByCN[client.CertificateCommonName] = client
+		}
+	}
+	if cfg.ClientCACert != "" {
+		pem, err := ioutil.ReadFile(cfg.ClientCACert) // #nosec G304
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read client CA certificate %s", cfg.ClientCACert)
+		}
+		c.clientCAs = x509.NewCertPool()
+		if !c.clientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificate found in client CA certificate %s", cfg.ClientCACert)
+		}
+	}
+	for _, r := range cfg.Routes {
+		rt := &route{
+			method: strings.TrimSuffix(r.Method, "*"),
+			prefix: strings.HasSuffix(r.Method, "*"),
+		}
+		if len(r.Allow) > 0 {
+			rt.allow = make(map[string]bool, len(r.Allow))
+			for _, name := range r.Allow {
+				rt.allow[name] = true
+			}
+		}
+		if r.RequestsPerSecond > 0 {
+			rt.limiter = leakybucket.NewCollector(r.RequestsPerSecond, r.Burst, true /* deleteEmptyBuckets */)
+		}
+		c.routes = append(c.routes, rt)
+	}
+	return c, nil
+}
+
+// ClientCAs returns the certificate authorities of the client certificates, or nil if the clients are
+// not identified by mTLS.
+func (c *Controller) ClientCAs() *x509.CertPool {
+	return c.clientCAs
+}
+
+// Close stops the pruning of the rate limiters.
+func (c *Controller) Close() {
+	for _, r := range c.routes {
+		if r.limiter != nil {
+			r.limiter.Free()
+		}
+	}
+}
+
+// authorize returns a gRPC status error if the caller may not call the method.
+func (c *Controller) authorize(id *identity, fullMethod string) error {
+	role := c.anonymousRole
+	if id.client != nil {
+		role = id.client.Role
+	}
+	switch {
+	case role == RoleNone:
+		return c.reject(id, fullMethod, "role", codes.PermissionDenied, "Access denied")
+	case role == RoleReadOnly && isWriteMethod(fullMethod):
+		return c.reject(id, fullMethod, "read_only", codes.PermissionDenied, "Read-only access")
+	}
+
+	r := c.route(fullMethod)
+	if r == nil {
+		return nil
+	}
+	if r.allow != nil {
+		if id.client == nil {
+			return c.reject(id, fullMethod, "allowlist", codes.Unauthenticated, "Authentication required")
+		}
+		if !r.allow[id.client.Name] {
+			return c.reject(id, fullMethod, "allowlist", codes.PermissionDenied, "Access denied")
+		}
+	}
+	if r.limiter != nil && r.limiter.Add(id.rateLimitKey(), 1) == 0 {
+		return c.reject(id, fullMethod, "rate_limit", codes.ResourceExhausted, "Rate limit exceeded")
+	}
+	return nil
+}
+
+func (c *Controller) reject(id *identity, fullMethod, reason string, code codes.Code, msg string) error {
+	rejectedRequestsCounter.WithLabelValues(fullMethod, reason).Inc()
+	log.WithFields(logrus.Fields{
+		"method":  fullMethod,
+		"client":  id.name(),
+		"address": id.address,
+		"reason":  reason,
+	}).Debug("Rejected API request")
+	return status.Error(code, msg)
+}
+
+// route returns the first route matching the method, if any.
+func (c *Controller) route(fullMethod string) *route {
+	for _, r := range c.routes {
+		if r.method == fullMethod || (r.prefix && strings.HasPrefix(fullMethod, r.method)) {
+			return r
+		}
+	}
+	return nil
+}
+
+// clientByToken returns the client identified by a bearer token, comparing it with the token of every
+// client in constant time.
+func (c *Controller) clientByToken(token string) *ClientConfig {
+	var found *ClientConfig
+	for _, client := range c.clients {
+		if client.Token != "" && subtle.ConstantTimeCompare([]byte(client.Token), []byte(token)) == 1 {
+			found = client
+		}
+	}
+	return found
+}
+
+// identify returns the identity of a caller from its Authorization header, the common name of its
+// verified client certificate, and its address. A caller presenting a token must present a valid one.
+func (c *Controller) identify(authorization, commonName, address string) (*identity, error) {
+	id := &identity{address: address}
+	if authorization != "" {
+		const bearerPrefix = "bearer "
+		if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+			return nil, status.Error(codes.Unauthenticated, "Invalid authorization header, expected a bearer token")
+		}
+		id.client = c.clientByToken(strings.TrimSpace(authorization[len(bearerPrefix):]))
+		if id.client == nil {
+			return nil, status.Error(codes.Unauthenticated, "Invalid bearer token")
+		}
+		return id, nil
+	}
+	if commonName != "" {
+		id.client = c.clientsByCN[commonName]
+	}
+	return id, nil
+}
+
+// forwardedAddress returns the address of the original caller of a request forwarded by a local proxy,
+// such as the gRPC gateway or the API middleware, from the X-Forwarded-For header. The header is only
+// trusted from local proxies, which append the address of their caller to it, so that the rightmost
+// address which is not a loopback one is the address of the original caller.
+func forwardedAddress(remoteAddress, forwardedFor string) string {
+	if forwardedFor == "" || !isLoopback(remoteAddress) {
+		return remoteAddress
+	}
+	addresses := strings.Split(forwardedFor, ",")
+	address := remoteAddress
+	for i := len(addresses) - 1; i >= 0; i-- {
+		address = strings.TrimSpace(addresses[i])
+		if !isLoopback(address) {
+			break
+		}
+	}
+	return address
+}
+
+func isLoopback(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
+
+func isWriteMethod(fullMethod string) bool {
+	i := strings.LastIndex(fullMethod, "/")
+	service, name := fullMethod[:i+1], fullMethod[i+1:]
+	if readMethods, ok := readOnlyBlockedServices[service]; ok {
+		for _, m := range readMethods {
+			if name == m {
+				return false
+			}
+		}
+		return true
+	}
+	for _, prefix := range readOnlyBlockedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
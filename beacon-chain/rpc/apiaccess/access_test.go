@@ -0,0 +1,267 @@
+package apiaccess
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	getStateSSZMethod   = "/ethereum.eth.v1.BeaconDebug/GetBeaconStateSSZ"
+	getGenesisMethod    = "/ethereum.eth.v1.BeaconChain/GetGenesis"
+	submitAttsMethod    = "/ethereum.eth.v1.BeaconChain/SubmitAttestations"
+	streamDutiesMethod  = "/ethereum.eth.v1alpha1.BeaconNodeValidator/StreamDuties"
+	proposeBlockMethod  = "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock"
+	listPeersMethod     = "/ethereum.beacon.rpc.v1.PeerAdmin/ListManagedPeers"
+	banPeerMethod       = "/ethereum.beacon.rpc.v1.PeerAdmin/BanPeer"
+	addTrustedMethod    = "/ethereum.beacon.rpc.v1.PeerAdmin/AddTrustedPeer"
+	partnerToken        = "partner-token"
+	operatorToken       = "operator-token"
+	operatorCommonName  = "operator.example.com"
+	remoteClientAddress = "203.0.113.7"
+)
+
+func testConfig() *Config {
+	return &Config{
+		AnonymousRole: RoleReadOnly,
+		Clients: []*ClientConfig{
+			{Name: "partner", Token: partnerToken, Role: RoleReadOnly},
+			{Name: "operator", Token: operatorToken, CertificateCommonName: operatorCommonName},
+		},
+		Routes: []*RouteConfig{
+			{Method: getStateSSZMethod, Allow: []string{"partner", "operator"}, RequestsPerSecond: 0.001, Burst: 2},
+			{Method: "/ethereum.eth.v1alpha1.BeaconNodeValidator/*", Allow: []string{"operator"}},
+		},
+	}
+}
+
+func incomingContext(address string, md metadata.MD) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 4000}})
+}
+
+func assertCode(t *testing.T, want codes.Code, err error) {
+	t.Helper()
+	assert.Equal(t, want, status.Code(err), "Unexpected status of error %v", err)
+}
+
+func TestController_Roles(t *testing.T) {
+	c, err := NewController(testConfig())
+	require.NoError(t, err)
+	defer c.Close()
+
+	anonymous := incomingContext(remoteClientAddress, metadata.MD{})
+	partner := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Bearer "+partnerToken))
+	operator := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Bearer "+operatorToken))
+
+	assertCode(t, codes.OK, c.checkContext(anonymous, getGenesisMethod))
+	assertCode(t, codes.PermissionDenied, c.checkContext(anonymous, submitAttsMethod))
+	assertCode(t, codes.PermissionDenied, c.checkContext(partner, submitAttsMethod))
+	assertCode(t, codes.OK, c.checkContext(operator, submitAttsMethod))
+
+	// Allowlists.
+	assertCode(t, codes.Unauthenticated, c.checkContext(anonymous, streamDutiesMethod))
+	assertCode(t, codes.PermissionDenied, c.checkContext(partner, streamDutiesMethod))
+	assertCode(t, codes.OK, c.checkContext(operator, streamDutiesMethod))
+	assertCode(t, codes.OK, c.checkContext(operator, proposeBlockMethod))
+
+	// Invalid tokens are rejected rather than treated as anonymous.
+	invalid := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Bearer unknown"))
+	assertCode(t, codes.Unauthenticated, c.checkContext(invalid, getGenesisMethod))
+	basic := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"))
+	assertCode(t, codes.Unauthenticated, c.checkContext(basic, getGenesisMethod))
+}
+
+func TestController_ReadOnlyPeerAdmin(t *testing.T) {
+	c, err := NewController(testConfig())
+	require.NoError(t, err)
+	defer c.Close()
+
+	anonymous := incomingContext(remoteClientAddress, metadata.MD{})
+	partner := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Bearer "+partnerToken))
+	operator := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Bearer "+operatorToken))
+
+	// Only the listing of the managed peers is allowed to read-only clients.
+	for _, ctx := range []context.Context{anonymous, partner} {
+		assertCode(t, codes.OK, c.checkContext(ctx, listPeersMethod))
+		assertCode(t, codes.PermissionDenied, c.checkContext(ctx, banPeerMethod))
+		assertCode(t, codes.PermissionDenied, c.checkContext(ctx, addTrustedMethod))
+	}
+	assertCode(t, codes.OK, c.checkContext(operator, listPeersMethod))
+	assertCode(t, codes.OK, c.checkContext(operator, banPeerMethod))
+	assertCode(t, codes.OK, c.checkContext(operator, addTrustedMethod))
+}
+
+func TestController_RateLimit(t *testing.T) {
+	c, err := NewController(testConfig())
+	require.NoError(t, err)
+	defer c.Close()
+
+	partner := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Bearer "+partnerToken))
+	operator := incomingContext(remoteClientAddress, metadata.Pairs("authorization", "Bearer "+operatorToken))
+	assertCode(t, codes.OK, c.checkContext(partner, getStateSSZMethod))
+	assertCode(t, codes.OK, c.checkContext(partner, getStateSSZMethod))
+	assertCode(t, codes.ResourceExhausted, c.checkContext(partner, getStateSSZMethod))
+	// Each client has its own limit.
+	assertCode(t, codes.OK, c.checkContext(operator, getStateSSZMethod))
+	// Other routes are not limited.
+	assertCode(t, codes.OK, c.checkContext(partner, getGenesisMethod))
+}
+
+func TestController_RateLimitsAnonymousClientsByForwardedAddress(t *testing.T) {
+	cfg := &Config{Routes: []*RouteConfig{{Method: getGenesisMethod, RequestsPerSecond: 0.001, Burst: 1}}}
+	c, err := NewController(cfg)
+	require.NoError(t, err)
+	defer c.Close()
+
+	// Requests of the gRPC gateway come from a loopback address, with the address of the caller appended to
+	// the X-Forwarded-For header. A spoofed address prepended by the caller is ignored.
+	viaGateway := func(forwardedFor string) context.Context {
+		return incomingContext("127.0.0.1", metadata.Pairs("x-forwarded-for", forwardedFor))
+	}
+	assertCode(t, codes.OK, c.checkContext(viaGateway("198.51.100.1"), getGenesisMethod))
+	assertCode(t, codes.ResourceExhausted, c.checkContext(viaGateway("10.0.0.1, 198.51.100.1"), getGenesisMethod))
+	assertCode(t, codes.ResourceExhausted, c.checkContext(viaGateway("198.51.100.1, 127.0.0.1"), getGenesisMethod))
+	assertCode(t, codes.OK, c.checkContext(viaGateway("198.51.100.2"), getGenesisMethod))
+
+	// The header is not trusted from remote callers.
+	remote := incomingContext(remoteClientAddress, metadata.Pairs("x-forwarded-for", "198.51.100.3"))
+	assertCode(t, codes.OK, c.checkContext(remote, getGenesisMethod))
+	remote = incomingContext(remoteClientAddress, metadata.Pairs("x-forwarded-for", "198.51.100.4"))
+	assertCode(t, codes.ResourceExhausted, c.checkContext(remote, getGenesisMethod))
+}
+
+func TestController_Interceptors(t *testing.T) {
+	c, err := NewController(testConfig())
+	require.NoError(t, err)
+	defer c.Close()
+	anonymous := incomingContext(remoteClientAddress, metadata.MD{})
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	_, err = c.UnaryServerInterceptor()(anonymous, nil, &grpc.UnaryServerInfo{FullMethod: submitAttsMethod}, handler)
+	assertCode(t, codes.PermissionDenied, err)
+	assert.Equal(t, false, called)
+	_, err = c.UnaryServerInterceptor()(anonymous, nil, &grpc.UnaryServerInfo{FullMethod: getGenesisMethod}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, true, called)
+
+	streamHandler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+	err = c.StreamServerInterceptor()(nil, &serverStream{ctx: anonymous}, &grpc.StreamServerInfo{FullMethod: streamDutiesMethod}, streamHandler)
+	assertCode(t, codes.Unauthenticated, err)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestController_CheckRequest(t *testing.T) {
+	c, err := NewController(testConfig())
+	require.NoError(t, err)
+	defer c.Close()
+
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/events?topics=head", nil)
+	req.RemoteAddr = remoteClientAddress + ":5000"
+	assertCode(t, codes.Unauthenticated, c.CheckRequest(req, streamDutiesMethod))
+	req.Header.Set("Authorization", "Bearer "+operatorToken)
+	assertCode(t, codes.OK, c.CheckRequest(req, streamDutiesMethod))
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+anonymous_role: read-only
+clients:
+  - name: partner
+    token: partner-token
+    role: read-only
+routes:
+  - method: /ethereum.eth.v1.BeaconDebug/GetBeaconStateSSZ
+    allow: [partner]
+    requests_per_second: 0.5
+    burst: 2
+`), 0600))
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, RoleReadOnly, cfg.AnonymousRole)
+	require.Equal(t, 1, len(cfg.Clients))
+	assert.Equal(t, "partner", cfg.Clients[0].Name)
+	require.Equal(t, 1, len(cfg.Routes))
+	assert.DeepEqual(t, []string{"partner"}, cfg.Routes[0].Allow)
+	assert.Equal(t, 0.5, cfg.Routes[0].RequestsPerSecond)
+	assert.Equal(t, int64(2), cfg.Routes[0].Burst)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("unknown_field: true\n"), 0600))
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, "could not parse API access config", err)
+}
+
+func TestNewController_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		err  string
+	}{
+		{
+			name: "unknown role",
+			cfg:  &Config{AnonymousRole: "admin"},
+			err:  "unknown role",
+		},
+		{
+			name: "client without identity",
+			cfg:  &Config{Clients: []*ClientConfig{{Name: "partner"}}},
+			err:  "neither a token nor a certificate common name",
+		},
+		{
+			name: "duplicate token",
+			cfg: &Config{Clients: []*ClientConfig{
+				{Name: "a", Token: "token"},
+				{Name: "b", Token: "token"},
+			}},
+			err: "has the token of another client",
+		},
+		{
+			name: "unknown client in allowlist",
+			cfg:  &Config{Routes: []*RouteConfig{{Method: getGenesisMethod, Allow: []string{"partner"}}}},
+			err:  "allows unknown client partner",
+		},
+		{
+			name: "wildcard in the middle of a method",
+			cfg:  &Config{Routes: []*RouteConfig{{Method: "/ethereum.eth.v1.*/GetGenesis"}}},
+			err:  "may only end with a wildcard",
+		},
+		{
+			name: "rate limit without burst",
+			cfg:  &Config{Routes: []*RouteConfig{{Method: getGenesisMethod, RequestsPerSecond: 1}}},
+			err:  "burst lower than 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewController(tt.cfg)
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+}
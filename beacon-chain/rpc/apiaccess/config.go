@@ -0,0 +1,149 @@
+package apiaccess
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Role of an API client, which determines the methods it may call.
+type Role string
+
+const (
+	// RoleFull allows calling all methods.
+	RoleFull Role = "full"
+	// RoleReadOnly allows calling all methods except the ones which submit data to the beacon node,
+	// such as the Submit* pool methods, or which change the state of the node, such as the peer admin methods.
+	RoleReadOnly Role = "read-only"
+	// RoleNone denies all methods.
+	RoleNone Role = "none"
+)
+
+// Config of the access control of the beacon node API, as loaded from a YAML file:
+//
+//	anonymous_role: read-only
+//	client_ca_cert: /path/to/partners-ca.pem
+//	clients:
+//	  - name: partner-a
+//	    token: 6f1d...
+//	    role: read-only
+//	  - name: operator
+//	    certificate_common_name: operator.example.com
+//	routes:
+//	  - method: /ethereum.eth.v1.BeaconDebug/GetBeaconStateSSZ
+//	    allow: [operator]
+//	    requests_per_second: 0.1
+//	    burst: 2
+//	  - method: /ethereum.eth.v1alpha1.BeaconNodeValidator/*
+//	    allow: [operator]
+type Config struct {
+	// AnonymousRole is the role of the clients which do not identify themselves. Defaults to full.
+	AnonymousRole Role `yaml:"anonymous_role"`
+	// ClientCACert is the path of the certificate authorities used to verify the certificates of
+	// the gRPC clients identified by mTLS.
+	ClientCACert string          `yaml:"client_ca_cert"`
+	Clients      []*ClientConfig `yaml:"clients"`
+	Routes       []*RouteConfig  `yaml:"routes"`
+}
+
+// ClientConfig identifies an API client by a bearer token, sent in the Authorization header, or by
+// the common name of its TLS client certificate.
+type ClientConfig struct {
+	Name                  string `yaml:"name"`
+	Token                 string `yaml:"token"`
+	CertificateCommonName string `yaml:"certificate_common_name"`
+	// Role of the client. Defaults to full.
+	Role Role `yaml:"role"`
+}
+
+// RouteConfig restricts the access to the gRPC methods matching Method, which is either a full method
+// name or a prefix ending with a wildcard. Only the first route matching a method applies.
+type RouteConfig struct {
+	Method string `yaml:"method"`
+	// Allow lists the names of the only clients allowed to call the methods of the route, if not empty.
+	Allow []string `yaml:"allow"`
+	// RequestsPerSecond limits the rate of the requests of each client to the methods of the route,
+	// with bursts of up to Burst requests. There is no limit if it is zero.
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int64   `yaml:"burst"`
+}
+
+// LoadConfig reads the access control configuration from a YAML file.
+func LoadConfig(path string) (*Config, error) {
+	content, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read API access config %s", path)
+	}
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict(content, cfg); err != nil {
+		return nil, errors.Wrapf(err, "could not parse API access config %s", path)
+	}
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if err := validateRole(c.AnonymousRole); err != nil {
+		return errors.Wrap(err, "invalid anonymous role")
+	}
+	names := make(map[string]bool, len(c.Clients))
+	tokens := make(map[string]bool, len(c.Clients))
+	commonNames := make(map[string]bool, len(c.Clients))
+	for _, client := range c.Clients {
+		if client.Name == "" {
+			return errors.New("client without a name")
+		}
+		if names[client.Name] {
+			return errors.Errorf("duplicate client %s", client.Name)
+		}
+		names[client.Name] = true
+		if client.Token == "" && client.CertificateCommonName == "" {
+			return errors.Errorf("client %s has neither a token nor a certificate common name", client.Name)
+		}
+		if client.Token != "" {
+			if tokens[client.Token] {
+				return errors.Errorf("client %s has the token of another client", client.Name)
+			}
+			tokens[client.Token] = true
+		}
+		if client.CertificateCommonName != "" {
+			if commonNames[client.CertificateCommonName] {
+				return errors.Errorf("client %s has the certificate common name of another client", client.Name)
+			}
+			commonNames[client.CertificateCommonName] = true
+		}
+		if err := validateRole(client.Role); err != nil {
+			return errors.Wrapf(err, "invalid role of client %s", client.Name)
+		}
+	}
+	for _, route := range c.Routes {
+		if !strings.HasPrefix(route.Method, "/") {
+			return errors.Errorf("route method %q is not a full gRPC method name", route.Method)
+		}
+		if strings.Contains(strings.TrimSuffix(route.Method, "*"), "*") {
+			return errors.Errorf("route method %q may only end with a wildcard", route.Method)
+		}
+		for _, name := range route.Allow {
+			if !names[name] {
+				return errors.Errorf("route %s allows unknown client %s", route.Method, name)
+			}
+		}
+		if route.RequestsPerSecond < 0 {
+			return errors.Errorf("route %s has a negative rate limit", route.Method)
+		}
+		if route.RequestsPerSecond > 0 && route.Burst < 1 {
+			return errors.Errorf("route %s has a rate limit with a burst lower than 1", route.Method)
+		}
+	}
+	return nil
+}
+
+func validateRole(role Role) error {
+	switch role {
+	case "", RoleFull, RoleReadOnly, RoleNone:
+		return nil
+	default:
+		return errors.Errorf("unknown role %q", role)
+	}
+}
@@ -0,0 +1,61 @@
+package apiaccess
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// UnaryServerInterceptor rejects the unary calls which the caller may not make.
+func (c *Controller) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := c.checkContext(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the streams which the caller may not open.
+func (c *Controller) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := c.checkContext(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// checkContext identifies the caller of a gRPC method from the context of the call. The bearer tokens of
+// HTTP requests are forwarded by the gRPC gateway in the authorization metadata.
+func (c *Controller) checkContext(ctx context.Context, fullMethod string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var address, commonName string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		address = p.Addr.String()
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			address = host
+		}
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			commonName = tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+		}
+	}
+	address = forwardedAddress(address, firstValue(md, "x-forwarded-for"))
+	id, err := c.identify(firstValue(md, "authorization"), commonName, address)
+	if err != nil {
+		rejectedRequestsCounter.WithLabelValues(fullMethod, "token").Inc()
+		return err
+	}
+	return c.authorize(id, fullMethod)
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package apiaccess
+
+import (
+	"net"
+	"net/http"
+)
+
+// CheckRequest returns a gRPC status error if the caller of an HTTP request which is served without
+// going through the gRPC server, such as the event stream of the API middleware, may not call the gRPC
+// method serving the same data.
+func (c *Controller) CheckRequest(req *http.Request, fullMethod string) error {
+	address := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	address = forwardedAddress(address, req.Header.Get("X-Forwarded-For"))
+	var commonName string
+	if req.TLS != nil && len(req.TLS.VerifiedChains) > 0 {
+		commonName = req.TLS.VerifiedChains[0][0].Subject.CommonName
+	}
+	id, err := c.identify(req.Header.Get("Authorization"), commonName, address)
+	if err != nil {
+		rejectedRequestsCounter.WithLabelValues(fullMethod, "token").Inc()
+		return err
+	}
+	return c.authorize(id, fullMethod)
+}
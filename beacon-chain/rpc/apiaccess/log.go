@@ -0,0 +1,5 @@
+package apiaccess
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "apiaccess")
@@ -0,0 +1,14 @@
+package apiaccess
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var rejectedRequestsCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "api_access_rejected_requests_total",
+		Help: "The number of API requests rejected by the access control, by method and reason.",
+	},
+	[]string{"method", "reason"},
+)
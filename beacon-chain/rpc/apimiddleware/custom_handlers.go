@@ -10,12 +10,16 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
-	"github.com/r3labs/sse"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type sszConfig struct {
@@ -112,6 +116,7 @@ func sszRequested(req *http.Request) bool {
 func prepareSSZRequestForProxying(m *gateway.ApiProxyMiddleware, endpoint gateway.Endpoint, req *http.Request, sszPath string) gateway.ErrorJson {
 	req.URL.Scheme = "http"
 	req.URL.Host = m.GatewayAddress
+	gateway.AppendForwardedFor(req)
 	req.RequestURI = ""
 	req.URL.Path = sszPath
 	return gateway.HandleURLParameters(endpoint.Path, req, []string{})
@@ -162,83 +167,130 @@ func writeSSZResponseHeaderAndBody(grpcResp *http.Response, w http.ResponseWrite
 	return nil
 }
 
-func handleEvents(m *gateway.ApiProxyMiddleware, _ gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
-	sseClient := sse.NewClient("http://" + m.GatewayAddress + req.URL.RequestURI())
-	eventChan := make(chan *sse.Event)
+// eventsHeartbeatInterval is the interval of the comments written to event streams to keep idle connections open.
+var eventsHeartbeatInterval = 10 * time.Second
 
-	// We use grpc-gateway as the server side of events, not the sse library.
-	// Because of this subscribing to streams doesn't work as intended, resulting in each event being handled by all subscriptions.
-	// To handle events properly, we subscribe just once using a placeholder value ('events') and handle all topics inside this subscription.
-	if err := sseClient.SubscribeChan("events", eventChan); err != nil {
-		gateway.WriteError(w, &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}, nil)
-		sseClient.Unsubscribe(eventChan)
-		return
+// streamEventsMethod is the gRPC method serving the event stream, whose access control applies to the event
+// stream of the API middleware.
+const streamEventsMethod = "/ethereum.eth.v1.Events/StreamEvents"
+
+// eventJsonMarshaler marshals event messages the same way as the grpc-gateway marshals API responses.
+var eventJsonMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// handleEvents serves the event stream of the beacon node from the event streamer. Each event is sent with its ID,
+// so that a client which reconnects with the Last-Event-ID header receives the events it missed.
+func (f *BeaconEndpointFactory) handleEvents(_ *gateway.ApiProxyMiddleware, _ gateway.Endpoint, w http.ResponseWriter, req *http.Request) (handled bool) {
+	if f.EventStreamer == nil {
+		gateway.WriteError(w, &gateway.DefaultErrorJson{Message: "Event stream is not available", Code: http.StatusServiceUnavailable}, nil)
+		return true
+	}
+	if f.AccessController != nil {
+		if err := f.AccessController.CheckRequest(req, streamEventsMethod); err != nil {
+			st := status.Convert(err)
+			gateway.WriteError(w, &gateway.DefaultErrorJson{Message: st.Message(), Code: gwruntime.HTTPStatusFromCode(st.Code())}, nil)
+			return true
+		}
 	}
 
-	errJson := receiveEvents(eventChan, w, req)
-	if errJson != nil {
-		gateway.WriteError(w, errJson, nil)
+	var topics []string
+	for _, topicsParam := range req.URL.Query()["topics"] {
+		topics = append(topics, strings.Split(topicsParam, ",")...)
+	}
+	var lastEventID uint64
+	if lastEventIDHeader := req.Header.Get("Last-Event-ID"); lastEventIDHeader != "" {
+		id, err := strconv.ParseUint(lastEventIDHeader, 10, 64)
+		if err != nil {
+			e := errors.Wrapf(err, "invalid Last-Event-ID header %s", lastEventIDHeader)
+			gateway.WriteError(w, &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusBadRequest}, nil)
+			return true
+		}
+		lastEventID = id
 	}
+	sub, err := f.EventStreamer.Subscribe(topics, lastEventID)
+	if err != nil {
+		e := errors.Wrap(err, "could not subscribe to events")
+		gateway.WriteError(w, &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusBadRequest}, nil)
+		return true
+	}
+	defer sub.Unsubscribe()
 
-	sseClient.Unsubscribe(eventChan)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if errJson := flushEvent(w); errJson != nil {
+		return true
+	}
+
+	if errJson := receiveEvents(sub, w, req); errJson != nil {
+		// The response status has already been sent, so the error is reported as an event.
+		// Any failure to write it means that the client is gone anyway.
+		if writeErrorEvent(w, errJson) == nil {
+			flushEvent(w)
+		}
+	}
 	return true
 }
 
-func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+func receiveEvents(sub *eventsv1.Subscription, w http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	heartbeat := time.NewTicker(eventsHeartbeatInterval)
+	defer heartbeat.Stop()
 	for {
 		select {
-		case msg := <-eventChan:
-			var data interface{}
-
-			switch strings.TrimSpace(string(msg.Event)) {
-			case eventsv1.HeadTopic:
-				data = &eventHeadJson{}
-			case eventsv1.BlockTopic:
-				data = &receivedBlockDataJson{}
-			case eventsv1.AttestationTopic:
-				data = &attestationJson{}
-
-				// Data received in the event does not fit the expected event stream output.
-				// We extract the underlying attestation from event data
-				// and assign the attestation back to event data for further processing.
-				eventData := &aggregatedAttReceivedDataJson{}
-				if err := json.Unmarshal(msg.Data, eventData); err != nil {
-					return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
-				}
-				attData, err := json.Marshal(eventData.Aggregate)
-				if err != nil {
-					return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
-				}
-				msg.Data = attData
-			case eventsv1.VoluntaryExitTopic:
-				data = &signedVoluntaryExitJson{}
-			case eventsv1.FinalizedCheckpointTopic:
-				data = &eventFinalizedCheckpointJson{}
-			case eventsv1.ChainReorgTopic:
-				data = &eventChainReorgJson{}
-			case "error":
-				data = &eventErrorJson{}
-			default:
-				return &gateway.DefaultErrorJson{
-					Message: fmt.Sprintf("Event type '%s' not supported", strings.TrimSpace(string(msg.Event))),
-					Code:    http.StatusInternalServerError,
-				}
+		case event := <-sub.Events():
+			if errJson := writeEvent(event, w); errJson != nil {
+				return errJson
 			}
-
-			if errJson := writeEvent(msg, w, data); errJson != nil {
+			if errJson := flushEvent(w); errJson != nil {
 				return errJson
 			}
+		case <-heartbeat.C:
+			if _, err := w.Write([]byte(": heartbeat\n\n")); err != nil {
+				return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
+			}
 			if errJson := flushEvent(w); errJson != nil {
 				return errJson
 			}
+		case <-sub.Done():
+			return &gateway.DefaultErrorJson{Message: sub.Err().Error(), Code: http.StatusServiceUnavailable}
 		case <-req.Context().Done():
 			return nil
 		}
 	}
 }
 
-func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) gateway.ErrorJson {
-	if err := json.Unmarshal(msg.Data, data); err != nil {
+func writeEvent(event *eventsv1.StreamedEvent, w http.ResponseWriter) gateway.ErrorJson {
+	var data interface{}
+	msg := event.Data
+	switch event.Topic {
+	case eventsv1.HeadTopic:
+		data = &eventHeadJson{}
+	case eventsv1.BlockTopic:
+		data = &receivedBlockDataJson{}
+	case eventsv1.AttestationTopic:
+		data = &attestationJson{}
+		// Aggregated attestations are streamed without the aggregation proof.
+		if aggregate, ok := msg.(*ethpb.AggregateAttestationAndProof); ok {
+			msg = aggregate.Aggregate
+		}
+	case eventsv1.VoluntaryExitTopic:
+		data = &signedVoluntaryExitJson{}
+	case eventsv1.FinalizedCheckpointTopic:
+		data = &eventFinalizedCheckpointJson{}
+	case eventsv1.ChainReorgTopic:
+		data = &eventChainReorgJson{}
+	default:
+		return &gateway.DefaultErrorJson{
+			Message: fmt.Sprintf("Event type '%s' not supported", event.Topic),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	encoded, err := eventJsonMarshaler.Marshal(msg)
+	if err != nil {
+		return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
+	}
+	if err := json.Unmarshal(encoded, data); err != nil {
 		return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
 	}
 	if errJson := gateway.ProcessMiddlewareResponseFields(data); errJson != nil {
@@ -248,25 +300,21 @@ func writeEvent(msg *sse.Event, w http.ResponseWriter, data interface{}) gateway
 	if errJson != nil {
 		return errJson
 	}
-
-	w.Header().Set("Content-Type", "text/event-stream")
-
-	if _, err := w.Write([]byte("event: ")); err != nil {
-		return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
-	}
-	if _, err := w.Write(msg.Event); err != nil {
-		return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
-	}
-	if _, err := w.Write([]byte("\ndata: ")); err != nil {
+	if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Topic, dataJson); err != nil {
 		return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
 	}
-	if _, err := w.Write(dataJson); err != nil {
+	return nil
+}
+
+// writeErrorEvent writes an error event. Error events have no ID, so that they do not affect resuming the stream.
+func writeErrorEvent(w http.ResponseWriter, errJson gateway.ErrorJson) gateway.ErrorJson {
+	dataJson, err := json.Marshal(&eventErrorJson{StatusCode: errJson.StatusCode(), Message: errJson.Msg()})
+	if err != nil {
 		return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
 	}
-	if _, err := w.Write([]byte("\n\n")); err != nil {
+	if _, err := fmt.Fprintf(w, "event: error\ndata: %s\n\n", dataJson); err != nil {
 		return &gateway.DefaultErrorJson{Message: err.Error(), Code: http.StatusInternalServerError}
 	}
-
 	return nil
 }
 
@@ -1,21 +1,27 @@
 package apimiddleware
 
 import (
+	"bufio"
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
-	"github.com/r3labs/sse"
 )
 
 func TestSSZRequested(t *testing.T) {
@@ -142,73 +148,181 @@ func TestWriteSSZResponseHeaderAndBody(t *testing.T) {
 	})
 }
 
-func TestReceiveEvents(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	ch := make(chan *sse.Event)
-	w := httptest.NewRecorder()
-	w.Body = &bytes.Buffer{}
-	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
-	req = req.WithContext(ctx)
-
-	go func() {
-		base64Val := "Zm9v"
-		data := &eventFinalizedCheckpointJson{
-			Block: base64Val,
-			State: base64Val,
-			Epoch: "1",
-		}
-		bData, err := json.Marshal(data)
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+// readSSEEvent reads the next event of a stream, skipping comments.
+func readSSEEvent(t *testing.T, r *bufio.Reader) *sseEvent {
+	event := &sseEvent{}
+	for {
+		line, err := r.ReadString('\n')
 		require.NoError(t, err)
-		msg := &sse.Event{
-			Data:  bData,
-			Event: []byte(eventsv1.FinalizedCheckpointTopic),
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			if event.event != "" {
+				return event
+			}
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
 		}
-		ch <- msg
-		time.Sleep(time.Second)
-		cancel()
-	}()
+	}
+}
 
-	errJson := receiveEvents(ch, w, req)
-	assert.Equal(t, true, errJson == nil)
+func setupEventsServer(t *testing.T) (*eventsv1.Streamer, *mockChain.MockStateNotifier, *httptest.Server) {
+	stateNotifier := &mockChain.MockStateNotifier{}
+	streamer := eventsv1.NewStreamer(context.Background(), &eventsv1.StreamerConfig{
+		StateNotifier:     stateNotifier,
+		BlockNotifier:     &mockChain.MockBlockNotifier{},
+		OperationNotifier: &mockChain.MockOperationNotifier{},
+	})
+	streamer.Start()
+	f := &BeaconEndpointFactory{EventStreamer: streamer}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		f.handleEvents(nil, gateway.Endpoint{}, w, req)
+	}))
+	t.Cleanup(func() {
+		srv.Close()
+		require.NoError(t, streamer.Stop())
+	})
+	return streamer, stateNotifier, srv
 }
 
-func TestReceiveEvents_EventNotSupported(t *testing.T) {
-	ch := make(chan *sse.Event)
-	w := httptest.NewRecorder()
-	w.Body = &bytes.Buffer{}
-	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+func connectEvents(t *testing.T, ctx context.Context, url, lastEventID string) *bufio.Reader {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	t.Cleanup(func() {
+		require.NoError(t, resp.Body.Close())
+	})
+	return bufio.NewReader(resp.Body)
+}
 
-	go func() {
-		msg := &sse.Event{
-			Data:  []byte("foo"),
-			Event: []byte("not_supported"),
-		}
-		ch <- msg
+func sendHead(stateNotifier *mockChain.MockStateNotifier, slot types.Slot) {
+	stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpb.EventHead{Slot: slot, Block: []byte("foo")},
+	})
+}
+
+func TestHandleEvents_ResumesFromLastEventID(t *testing.T) {
+	streamer, stateNotifier, srv := setupEventsServer(t)
+	url := srv.URL + "/eth/v1/events?topics=head,finalized_checkpoint"
+	observer, err := streamer.Subscribe([]string{eventsv1.HeadTopic}, 0)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := connectEvents(t, ctx, url, "")
+	for slot := types.Slot(1); slot <= 3; slot++ {
+		sendHead(stateNotifier, slot)
+	}
+	for i := 1; i <= 2; i++ {
+		event := readSSEEvent(t, r)
+		assert.Equal(t, strconv.Itoa(i), event.id)
+		assert.Equal(t, eventsv1.HeadTopic, event.event)
+		assert.Equal(t, true, strings.Contains(event.data, fmt.Sprintf("\"slot\":\"%d\"", i)))
+	}
+	// The client disconnects after having received the second event and misses events while reconnecting.
+	cancel()
+	for slot := types.Slot(4); slot <= 5; slot++ {
+		sendHead(stateNotifier, slot)
+	}
+	for i := 0; i < 5; i++ {
+		<-observer.Events()
+	}
+
+	r = connectEvents(t, context.Background(), url, "2")
+	sendHead(stateNotifier, 6)
+	for i := 3; i <= 6; i++ {
+		event := readSSEEvent(t, r)
+		assert.Equal(t, strconv.Itoa(i), event.id)
+		assert.Equal(t, true, strings.Contains(event.data, fmt.Sprintf("\"slot\":\"%d\"", i)))
+	}
+}
+
+func TestHandleEvents_Heartbeat(t *testing.T) {
+	heartbeatInterval := eventsHeartbeatInterval
+	eventsHeartbeatInterval = 10 * time.Millisecond
+	defer func() {
+		eventsHeartbeatInterval = heartbeatInterval
 	}()
+	_, _, srv := setupEventsServer(t)
 
-	errJson := receiveEvents(ch, w, req)
-	require.NotNil(t, errJson)
-	assert.Equal(t, "Event type 'not_supported' not supported", errJson.Msg())
+	r := connectEvents(t, context.Background(), srv.URL+"/eth/v1/events?topics=head", "")
+	line, err := r.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, ": heartbeat\n", line)
 }
 
-func TestWriteEvent(t *testing.T) {
-	base64Val := "Zm9v"
-	data := &eventFinalizedCheckpointJson{
-		Block: base64Val,
-		State: base64Val,
-		Epoch: "1",
-	}
-	bData, err := json.Marshal(data)
+func TestHandleEvents_InvalidRequest(t *testing.T) {
+	_, _, srv := setupEventsServer(t)
+
+	resp, err := http.Get(srv.URL + "/eth/v1/events?topics=foo")
 	require.NoError(t, err)
-	msg := &sse.Event{
-		Data:  bData,
-		Event: []byte("test_event"),
-	}
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/eth/v1/events?topics=head", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "foo")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
 	w := httptest.NewRecorder()
 	w.Body = &bytes.Buffer{}
+	handled := (&BeaconEndpointFactory{}).handleEvents(nil, gateway.Endpoint{}, w, httptest.NewRequest("GET", "http://foo.example", nil))
+	assert.Equal(t, true, handled)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
 
-	errJson := writeEvent(msg, w, &eventFinalizedCheckpointJson{})
-	require.Equal(t, true, errJson == nil)
-	written := w.Body.String()
-	assert.Equal(t, "event: test_event\ndata: {\"block\":\"0x666f6f\",\"state\":\"0x666f6f\",\"epoch\":\"1\"}\n\n", written)
+func TestWriteEvent(t *testing.T) {
+	t.Run("finalized_checkpoint", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		errJson := writeEvent(&eventsv1.StreamedEvent{
+			ID:    7,
+			Topic: eventsv1.FinalizedCheckpointTopic,
+			Data:  &ethpb.EventFinalizedCheckpoint{Block: []byte("foo"), State: []byte("foo"), Epoch: 1},
+		}, w)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, "id: 7\nevent: finalized_checkpoint\ndata: {\"block\":\"0x666f6f\",\"state\":\"0x666f6f\",\"epoch\":\"1\"}\n\n", w.Body.String())
+	})
+	t.Run("aggregated_attestation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		errJson := writeEvent(&eventsv1.StreamedEvent{
+			ID:    1,
+			Topic: eventsv1.AttestationTopic,
+			Data: &ethpb.AggregateAttestationAndProof{
+				AggregatorIndex: 3,
+				Aggregate:       &ethpb.Attestation{AggregationBits: []byte{1}, Data: &ethpb.AttestationData{Slot: 2}},
+			},
+		}, w)
+		require.Equal(t, true, errJson == nil)
+		written := w.Body.String()
+		assert.Equal(t, true, strings.HasPrefix(written, "id: 1\nevent: attestation\ndata: {\"aggregation_bits\":\"0x01\""))
+		assert.Equal(t, false, strings.Contains(written, "aggregator_index"))
+	})
+	t.Run("not_supported", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		errJson := writeEvent(&eventsv1.StreamedEvent{Topic: "not_supported"}, w)
+		require.NotNil(t, errJson)
+		assert.Equal(t, "Event type 'not_supported' not supported", errJson.Msg())
+	})
 }
@@ -32,6 +32,66 @@ func wrapAttestationsArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req
 	return nil
 }
 
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/publishAggregateAndProofs expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapSignedAggregateAndProofArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*submitAggregateAndProofsRequestJson); ok {
+		data := make([]*signedAggregateAttestationAndProofJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			e := errors.Wrapf(err, "could not decode aggregate and proofs array")
+			return &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusInternalServerError}
+		}
+		j := &submitAggregateAndProofsRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			e := errors.Wrapf(err, "could not marshal wrapped aggregate and proofs array")
+			return &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusInternalServerError}
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return nil
+}
+
+// https://ethereum.github.io/eth2.0-APIs/#/Validator/prepareBeaconCommitteeSubnet expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapBeaconCommitteeSubscriptionsArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*submitBeaconCommitteeSubscriptionsRequestJson); ok {
+		data := make([]*beaconCommitteeSubscribeJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			e := errors.Wrapf(err, "could not decode beacon committee subscriptions array")
+			return &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusInternalServerError}
+		}
+		j := &submitBeaconCommitteeSubscriptionsRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			e := errors.Wrapf(err, "could not marshal wrapped beacon committee subscriptions array")
+			return &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusInternalServerError}
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return nil
+}
+
+// https://ethereum.github.io/beacon-APIs/#/Validator/getLiveness expects posting a top-level array of validator indices.
+// We make it more proto-friendly by wrapping it in a struct with an 'index' field.
+func wrapValidatorIndicesArray(endpoint gateway.Endpoint, _ http.ResponseWriter, req *http.Request) gateway.ErrorJson {
+	if _, ok := endpoint.PostRequest.(*livenessRequestJson); ok {
+		indices := make([]string, 0)
+		if err := json.NewDecoder(req.Body).Decode(&indices); err != nil {
+			e := errors.Wrapf(err, "could not decode validator indices array")
+			return &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusInternalServerError}
+		}
+		j := &livenessRequestJson{Index: indices}
+		b, err := json.Marshal(j)
+		if err != nil {
+			e := errors.Wrapf(err, "could not marshal wrapped validator indices array")
+			return &gateway.DefaultErrorJson{Message: e.Error(), Code: http.StatusInternalServerError}
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return nil
+}
+
 // Posted graffiti needs to have length of 32 bytes, but client is allowed to send data of any length.
 func prepareGraffiti(endpoint gateway.Endpoint, _ http.ResponseWriter, _ *http.Request) gateway.ErrorJson {
 	if block, ok := endpoint.PostRequest.(*beaconBlockContainerJson); ok {
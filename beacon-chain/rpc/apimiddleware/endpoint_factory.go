@@ -2,11 +2,18 @@ package apimiddleware
 
 import (
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apiaccess"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 )
 
 // BeaconEndpointFactory creates endpoints used for running beacon chain API calls through the API Middleware.
 type BeaconEndpointFactory struct {
+	// EventStreamer serves the event stream. Events are not available if it is nil.
+	EventStreamer *eventsv1.Streamer
+	// AccessController controls the access to the event stream, which is served without going through the
+	// gRPC server. The access is not controlled if it is nil.
+	AccessController *apiaccess.Controller
 }
 
 func (f *BeaconEndpointFactory) IsNil() bool {
@@ -29,6 +36,8 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/beacon/blocks/{block_id}",
 		"/eth/v1/beacon/blocks/{block_id}/root",
 		"/eth/v1/beacon/blocks/{block_id}/attestations",
+		"/eth/v1/beacon/rewards/blocks/{block_id}",
+		"/eth/v1/beacon/rewards/attestations/{epoch}",
 		"/eth/v1/beacon/pool/attestations",
 		"/eth/v1/beacon/pool/attester_slashings",
 		"/eth/v1/beacon/pool/proposer_slashings",
@@ -46,6 +55,14 @@ func (f *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/config/deposit_contract",
 		"/eth/v1/config/spec",
 		"/eth/v1/events",
+		"/eth/v1/validator/duties/attester/{epoch}",
+		"/eth/v1/validator/duties/proposer/{epoch}",
+		"/eth/v1/validator/blocks/{slot}",
+		"/eth/v1/validator/attestation_data",
+		"/eth/v1/validator/aggregate_attestation",
+		"/eth/v1/validator/aggregate_and_proofs",
+		"/eth/v1/validator/beacon_committee_subscriptions",
+		"/eth/v1/validator/liveness/{epoch}",
 	}
 }
 
@@ -75,8 +92,9 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 		}
 	case "/eth/v1/beacon/states/{state_id}/validators":
 		endpoint = gateway.Endpoint{
-			GetResponse: &stateValidatorResponseJson{},
-			Err:         &gateway.DefaultErrorJson{},
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "id", Hex: true}, {Name: "status", Enum: true}},
+			GetResponse:           &stateValidatorsResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
 		}
 	case "/eth/v1/beacon/states/{state_id}/validator_balances":
 		endpoint = gateway.Endpoint{
@@ -127,6 +145,17 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 			GetResponse: &blockAttestationsResponseJson{},
 			Err:         &gateway.DefaultErrorJson{},
 		}
+	case "/eth/v1/beacon/rewards/blocks/{block_id}":
+		endpoint = gateway.Endpoint{
+			GetResponse: &blockRewardsResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/beacon/rewards/attestations/{epoch}":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "id", Hex: true}},
+			GetResponse:           &attestationRewardsResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
 	case "/eth/v1/beacon/pool/attestations":
 		endpoint = gateway.Endpoint{
 			GetRequestQueryParams: []gateway.QueryParam{{Name: "slot"}, {Name: "committee_index"}},
@@ -222,7 +251,61 @@ func (f *BeaconEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
 		endpoint = gateway.Endpoint{
 			Err: &gateway.DefaultErrorJson{},
 			Hooks: gateway.HookCollection{
-				CustomHandlers: []gateway.CustomHandler{handleEvents},
+				CustomHandlers: []gateway.CustomHandler{f.handleEvents},
+			},
+		}
+	case "/eth/v1/validator/duties/attester/{epoch}":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "index"}},
+			GetResponse:           &attesterDutiesResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/duties/proposer/{epoch}":
+		endpoint = gateway.Endpoint{
+			GetResponse: &proposerDutiesResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/blocks/{slot}":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "randao_reveal", Hex: true}, {Name: "graffiti", Hex: true}},
+			GetResponse:           &produceBlockResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/attestation_data":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "slot"}, {Name: "committee_index"}},
+			GetResponse:           &produceAttestationDataResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/aggregate_attestation":
+		endpoint = gateway.Endpoint{
+			GetRequestQueryParams: []gateway.QueryParam{{Name: "attestation_data_root", Hex: true}, {Name: "slot"}},
+			GetResponse:           &aggregateAttestationResponseJson{},
+			Err:                   &gateway.DefaultErrorJson{},
+		}
+	case "/eth/v1/validator/aggregate_and_proofs":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitAggregateAndProofsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapSignedAggregateAndProofArray},
+			},
+		}
+	case "/eth/v1/validator/beacon_committee_subscriptions":
+		endpoint = gateway.Endpoint{
+			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapBeaconCommitteeSubscriptionsArray},
+			},
+		}
+	case "/eth/v1/validator/liveness/{epoch}":
+		endpoint = gateway.Endpoint{
+			PostRequest: &livenessRequestJson{},
+			GetResponse: &livenessResponseJson{},
+			Err:         &gateway.DefaultErrorJson{},
+			Hooks: gateway.HookCollection{
+				OnPostStart: []gateway.Hook{wrapValidatorIndicesArray},
 			},
 		}
 	default:
@@ -41,6 +41,11 @@ type stateFinalityCheckpointResponse_StateFinalityCheckpointJson struct {
 	Finalized         *checkpointJson `json:"finalized"`
 }
 
+// stateValidatorsResponseJson is used in /beacon/states/{state_id}/validators API endpoint.
+type stateValidatorsResponseJson struct {
+	Data []*validatorContainerJson `json:"data"`
+}
+
 // stateValidatorResponseJson is used in /beacon/states/{state_id}/validators/{validator_id} API endpoint.
 type stateValidatorResponseJson struct {
 	Data *validatorContainerJson `json:"data"`
@@ -81,6 +86,16 @@ type blockAttestationsResponseJson struct {
 	Data []*attestationJson `json:"data"`
 }
 
+// blockRewardsResponseJson is used in /beacon/rewards/blocks/{block_id} API endpoint.
+type blockRewardsResponseJson struct {
+	Data *blockRewardsJson `json:"data"`
+}
+
+// attestationRewardsResponseJson is used in /beacon/rewards/attestations/{epoch} API endpoint.
+type attestationRewardsResponseJson struct {
+	Data []*attestationRewardJson `json:"data"`
+}
+
 // attestationsPoolResponseJson is used in /beacon/pool/attestations GET API endpoint.
 type attestationsPoolResponseJson struct {
 	Data []*attestationJson `json:"data"`
@@ -169,6 +184,53 @@ type specResponseJson struct {
 	Data interface{} `json:"data"`
 }
 
+// attesterDutiesResponseJson is used in /validator/duties/attester/{epoch} API endpoint.
+type attesterDutiesResponseJson struct {
+	DependentRoot string              `json:"dependent_root" hex:"true"`
+	Data          []*attesterDutyJson `json:"data"`
+}
+
+// proposerDutiesResponseJson is used in /validator/duties/proposer/{epoch} API endpoint.
+type proposerDutiesResponseJson struct {
+	DependentRoot string              `json:"dependent_root" hex:"true"`
+	Data          []*proposerDutyJson `json:"data"`
+}
+
+// produceBlockResponseJson is used in /validator/blocks/{slot} API endpoint.
+type produceBlockResponseJson struct {
+	Data *beaconBlockJson `json:"data"`
+}
+
+// produceAttestationDataResponseJson is used in /validator/attestation_data API endpoint.
+type produceAttestationDataResponseJson struct {
+	Data *attestationDataJson `json:"data"`
+}
+
+// aggregateAttestationResponseJson is used in /validator/aggregate_attestation API endpoint.
+type aggregateAttestationResponseJson struct {
+	Data *attestationJson `json:"data"`
+}
+
+// submitAggregateAndProofsRequestJson is used in /validator/aggregate_and_proofs API endpoint.
+type submitAggregateAndProofsRequestJson struct {
+	Data []*signedAggregateAttestationAndProofJson `json:"data"`
+}
+
+// submitBeaconCommitteeSubscriptionsRequestJson is used in /validator/beacon_committee_subscriptions API endpoint.
+type submitBeaconCommitteeSubscriptionsRequestJson struct {
+	Data []*beaconCommitteeSubscribeJson `json:"data"`
+}
+
+// livenessRequestJson is used in /validator/liveness/{epoch} API endpoint.
+type livenessRequestJson struct {
+	Index []string `json:"index"`
+}
+
+// livenessResponseJson is used in /validator/liveness/{epoch} API endpoint.
+type livenessResponseJson struct {
+	Data []*validatorLivenessJson `json:"data"`
+}
+
 //----------------
 // Reusable types.
 //----------------
@@ -324,11 +386,29 @@ type metadataJson struct {
 
 // peerJson is a JSON representation of a peer.
 type peerJson struct {
-	PeerId    string `json:"peer_id"`
-	Enr       string `json:"enr"`
-	Address   string `json:"last_seen_p2p_address"`
-	State     string `json:"state" enum:"true"`
-	Direction string `json:"direction" enum:"true"`
+	PeerId    string         `json:"peer_id"`
+	Enr       string         `json:"enr"`
+	Address   string         `json:"last_seen_p2p_address"`
+	State     string         `json:"state" enum:"true"`
+	Direction string         `json:"direction" enum:"true"`
+	Score     *peerScoreJson `json:"score,omitempty"`
+}
+
+// peerScoreJson is a JSON representation of the scores of a peer.
+type peerScoreJson struct {
+	Overall          float64                    `json:"overall"`
+	Gossip           float64                    `json:"gossip"`
+	BehaviourPenalty float64                    `json:"behaviour_penalty"`
+	Bad              bool                       `json:"bad"`
+	TopicScores      map[string]*topicScoreJson `json:"topic_scores"`
+}
+
+// topicScoreJson is a JSON representation of the gossip score statistics of a peer in a topic.
+type topicScoreJson struct {
+	TimeInMesh               string  `json:"time_in_mesh"`
+	FirstMessageDeliveries   float64 `json:"first_message_deliveries"`
+	MeshMessageDeliveries    float64 `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries float64 `json:"invalid_message_deliveries"`
 }
 
 // versionJson is a JSON representation of the system's version.
@@ -394,6 +474,25 @@ type validatorBalanceJson struct {
 	Balance string `json:"balance"`
 }
 
+// blockRewardsJson is a JSON representation of the rewards of a block's proposer.
+type blockRewardsJson struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+}
+
+// attestationRewardJson is a JSON representation of a validator's attestation rewards.
+type attestationRewardJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Source         string `json:"source"`
+	Target         string `json:"target"`
+	Head           string `json:"head"`
+	InclusionDelay string `json:"inclusion_delay"`
+	Inactivity     string `json:"inactivity"`
+}
+
 // committeeJson is a JSON representation of a committee
 type committeeJson struct {
 	Index      string   `json:"index"`
@@ -428,6 +527,52 @@ type syncInfoJson struct {
 	IsSyncing    bool   `json:"is_syncing"`
 }
 
+// attesterDutyJson is a JSON representation of an attester duty.
+type attesterDutyJson struct {
+	Pubkey                  string `json:"pubkey" hex:"true"`
+	ValidatorIndex          string `json:"validator_index"`
+	CommitteeIndex          string `json:"committee_index"`
+	CommitteeLength         string `json:"committee_length"`
+	CommitteesAtSlot        string `json:"committees_at_slot"`
+	ValidatorCommitteeIndex string `json:"validator_committee_index"`
+	Slot                    string `json:"slot"`
+}
+
+// proposerDutyJson is a JSON representation of a proposer duty.
+type proposerDutyJson struct {
+	Pubkey         string `json:"pubkey" hex:"true"`
+	ValidatorIndex string `json:"validator_index"`
+	Slot           string `json:"slot"`
+}
+
+// signedAggregateAttestationAndProofJson is a JSON representation of a signed aggregate attestation and proof.
+type signedAggregateAttestationAndProofJson struct {
+	Message   *aggregateAttestationAndProofJson `json:"message"`
+	Signature string                            `json:"signature" hex:"true"`
+}
+
+// aggregateAttestationAndProofJson is a JSON representation of an aggregate attestation and proof.
+type aggregateAttestationAndProofJson struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJson `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof" hex:"true"`
+}
+
+// beaconCommitteeSubscribeJson is a JSON representation of a beacon committee subscription.
+type beaconCommitteeSubscribeJson struct {
+	ValidatorIndex   string `json:"validator_index"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+	Slot             string `json:"slot"`
+	IsAggregator     bool   `json:"is_aggregator"`
+}
+
+// validatorLivenessJson is a JSON representation of a validator's liveness in an epoch.
+type validatorLivenessJson struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
 //----------------
 // SSZ
 // ---------------
@@ -0,0 +1,299 @@
+package beaconv1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockRewards returns the rewards the proposer of the requested block receives for the
+// attestations and slashings included in the block.
+func (bs *Server) GetBlockRewards(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetBlockRewards")
+	defer span.End()
+
+	rBlk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	if invalidBlockIdErr, ok := err.(*blockIdParseError); ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid block ID: %v", invalidBlockIdErr)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
+	}
+	if rBlk == nil || rBlk.IsNil() {
+		return nil, status.Errorf(codes.NotFound, "Could not find requested block")
+	}
+	blk, err := rBlk.PbPhase0Block()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get raw block: %v", err)
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash block: %v", err)
+	}
+	if rewards := bs.RewardsCache.BlockRewards(root); rewards != nil {
+		return &ethpb.BlockRewardsResponse{Data: rewards}, nil
+	}
+
+	rewards, err := bs.blockRewards(ctx, blk.Block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute block rewards: %v", err)
+	}
+	finalizedSlot, err := helpers.StartSlot(bs.ChainInfoFetcher.FinalizedCheckpt().Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized slot: %v", err)
+	}
+	if blk.Block.Slot <= finalizedSlot {
+		bs.RewardsCache.AddBlockRewards(root, rewards)
+	}
+	return &ethpb.BlockRewardsResponse{Data: rewards}, nil
+}
+
+// ListAttestationRewards returns the rewards and penalties validators receive for their attestations
+// in the requested epoch. These are applied at the end of the following epoch, so they are computed by
+// replaying the chain up to the last slot of the following epoch.
+func (bs *Server) ListAttestationRewards(ctx context.Context, req *ethpb.AttestationRewardsRequest) (*ethpb.AttestationRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListAttestationRewards")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch+1 >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Attestation rewards of epoch %d are applied at the end of epoch %d, current epoch is %d",
+			req.Epoch,
+			req.Epoch+1,
+			currentEpoch,
+		)
+	}
+
+	var st iface.BeaconState
+	rewards := bs.RewardsCache.AttestationRewards(req.Epoch)
+	if rewards == nil {
+		endSlot, err := helpers.EndSlot(req.Epoch + 1)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch: %v", err)
+		}
+		st, err = bs.StateGenService.StateBySlot(ctx, endSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve state for slot %d: %v", endSlot, err)
+		}
+		rewards, err = attestationRewards(ctx, st.Copy())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute attestation rewards: %v", err)
+		}
+		if req.Epoch+1 < bs.ChainInfoFetcher.FinalizedCheckpt().Epoch {
+			bs.RewardsCache.AddAttestationRewards(req.Epoch, rewards)
+		}
+	}
+	if len(req.Id) == 0 {
+		return &ethpb.AttestationRewardsResponse{Data: rewards}, nil
+	}
+
+	if st == nil {
+		// Validator indices never change, so any later state can be used to look up public keys.
+		var err error
+		st, err = bs.ChainInfoFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		}
+	}
+	valIndices, err := validatorIndicesByRequestIds(st, req.Id)
+	if err != nil {
+		return nil, handleValContainerErr(err)
+	}
+	filteredRewards := make([]*ethpb.AttestationReward, 0, len(valIndices))
+	for _, index := range valIndices {
+		if uint64(index) < uint64(len(rewards)) {
+			filteredRewards = append(filteredRewards, rewards[index])
+		}
+	}
+	return &ethpb.AttestationRewardsResponse{Data: filteredRewards}, nil
+}
+
+// attestationRewards computes the attestation rewards of all validators from the state at the last slot
+// before the epoch transition which applies them. The state is modified.
+func attestationRewards(ctx context.Context, st iface.BeaconState) ([]*ethpb.AttestationReward, error) {
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not set up pre compute instance")
+	}
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not pre compute attestations")
+	}
+	// Rewards depend on the finalized checkpoint, which is updated before rewards are applied.
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process justification")
+	}
+	deltas, err := precompute.AttestationsDeltaBreakdown(st, bp, vp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation deltas")
+	}
+	rewards := make([]*ethpb.AttestationReward, len(deltas))
+	for i, d := range deltas {
+		rewards[i] = &ethpb.AttestationReward{
+			ValidatorIndex: types.ValidatorIndex(i),
+			Source:         int64(d.SourceReward) - int64(d.SourcePenalty),
+			Target:         int64(d.TargetReward) - int64(d.TargetPenalty),
+			Head:           int64(d.HeadReward) - int64(d.HeadPenalty),
+			InclusionDelay: d.InclusionDelayReward,
+			Inactivity:     -int64(d.InactivityPenalty),
+		}
+	}
+	return rewards, nil
+}
+
+// blockRewards computes the rewards of the proposer of the block by applying the slashings of the
+// block to its pre state, and by looking up which attesters are included in a block for the first time.
+func (bs *Server) blockRewards(ctx context.Context, blk *eth.BeaconBlock) (*ethpb.BlockRewards, error) {
+	rewards := &ethpb.BlockRewards{ProposerIndex: blk.ProposerIndex}
+	if blk.Slot == params.BeaconConfig().GenesisSlot {
+		return rewards, nil
+	}
+
+	st, err := bs.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(blk.ParentRoot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get parent state")
+	}
+	if st == nil || st.IsNil() {
+		return nil, errors.New("parent state does not exist")
+	}
+	st, err = state.ProcessSlots(ctx, st.Copy(), blk.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slots")
+	}
+
+	balance, err := st.BalanceAtIndex(blk.ProposerIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer balance")
+	}
+	st, err = blocks.ProcessProposerSlashings(ctx, st, blk.Body.ProposerSlashings, validators.SlashValidator)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process proposer slashings")
+	}
+	balanceAfterProposerSlashings, err := st.BalanceAtIndex(blk.ProposerIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer balance")
+	}
+	st, err = blocks.ProcessAttesterSlashings(ctx, st, blk.Body.AttesterSlashings, validators.SlashValidator)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process attester slashings")
+	}
+	balanceAfterAttesterSlashings, err := st.BalanceAtIndex(blk.ProposerIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer balance")
+	}
+	if balanceAfterProposerSlashings > balance {
+		rewards.ProposerSlashings = balanceAfterProposerSlashings - balance
+	}
+	if balanceAfterAttesterSlashings > balanceAfterProposerSlashings {
+		rewards.AttesterSlashings = balanceAfterAttesterSlashings - balanceAfterProposerSlashings
+	}
+
+	rewards.Attestations, err = attestationsInclusionReward(st, blk.Body.Attestations)
+	if err != nil {
+		return nil, err
+	}
+	rewards.Total = rewards.Attestations + rewards.ProposerSlashings + rewards.AttesterSlashings
+	return rewards, nil
+}
+
+// attestationsInclusionReward computes the reward the proposer receives at the end of the epoch for the
+// attestations, whose attesters are not slashed and were not included in an earlier block of the epoch.
+func attestationsInclusionReward(st iface.ReadOnlyBeaconState, atts []*eth.Attestation) (uint64, error) {
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get total active balance")
+	}
+	sqrtTotalBalance := mathutil.IntegerSquareRoot(totalBalance)
+	if sqrtTotalBalance == 0 {
+		return 0, nil
+	}
+
+	// Attesters which were already included, by target epoch.
+	included := make(map[types.Epoch]map[types.ValidatorIndex]bool)
+	prevAtts, err := st.PreviousEpochAttestations()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get previous epoch attestations")
+	}
+	currentAtts, err := st.CurrentEpochAttestations()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get current epoch attestations")
+	}
+	for _, att := range append(prevAtts, currentAtts...) {
+		if _, err := newlyIncludedAttesters(st, included, att.Data, att.AggregationBits); err != nil {
+			return 0, err
+		}
+	}
+
+	cfg := params.BeaconConfig()
+	var reward uint64
+	for _, att := range atts {
+		indices, err := newlyIncludedAttesters(st, included, att.Data, att.AggregationBits)
+		if err != nil {
+			return 0, err
+		}
+		for _, index := range indices {
+			val, err := st.ValidatorAtIndexReadOnly(index)
+			if err != nil {
+				return 0, errors.Wrap(err, "could not get validator")
+			}
+			if val.Slashed() {
+				continue
+			}
+			baseReward := val.EffectiveBalance() * cfg.BaseRewardFactor / sqrtTotalBalance / cfg.BaseRewardsPerEpoch
+			reward += baseReward / cfg.ProposerRewardQuotient
+		}
+	}
+	return reward, nil
+}
+
+// newlyIncludedAttesters returns the attesters of the attestation which are not included yet,
+// and marks them as included.
+func newlyIncludedAttesters(
+	st iface.ReadOnlyBeaconState,
+	included map[types.Epoch]map[types.ValidatorIndex]bool,
+	data *eth.AttestationData,
+	bits bitfield.Bitlist,
+) ([]types.ValidatorIndex, error) {
+	committee, err := helpers.BeaconCommitteeFromState(st, data.Slot, data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get committee")
+	}
+	indices, err := attestationutil.AttestingIndices(bits, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attesting indices")
+	}
+	epochIncluded, ok := included[data.Target.Epoch]
+	if !ok {
+		epochIncluded = make(map[types.ValidatorIndex]bool)
+		included[data.Target.Epoch] = epochIncluded
+	}
+	newlyIncluded := make([]types.ValidatorIndex, 0, len(indices))
+	for _, index := range indices {
+		valIndex := types.ValidatorIndex(index)
+		if !epochIncluded[valIndex] {
+			epochIncluded[valIndex] = true
+			newlyIncluded = append(newlyIncluded, valIndex)
+		}
+	}
+	return newlyIncluded, nil
+}
@@ -0,0 +1,221 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	sharedtestutil "github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetBlockRewards(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	genesisState, privKeys := sharedtestutil.DeterministicGenesisState(t, 64)
+	b, err := sharedtestutil.GenerateFullBlock(genesisState, privKeys, &sharedtestutil.BlockGenConfig{NumAttestations: 1}, 1)
+	require.NoError(t, err)
+	preState, err := state.ProcessSlots(ctx, genesisState.Copy(), 1)
+	require.NoError(t, err)
+
+	// Slash two validators which are not the proposer.
+	var slashedIndices []types.ValidatorIndex
+	for i := types.ValidatorIndex(0); len(slashedIndices) < 2; i++ {
+		if i != b.Block.ProposerIndex {
+			slashedIndices = append(slashedIndices, i)
+		}
+	}
+	proposerSlashing, err := sharedtestutil.GenerateProposerSlashingForValidator(preState, privKeys[slashedIndices[0]], slashedIndices[0])
+	require.NoError(t, err)
+	attesterSlashing, err := sharedtestutil.GenerateAttesterSlashingForValidator(preState, privKeys[slashedIndices[1]], slashedIndices[1])
+	require.NoError(t, err)
+	b.Block.Body.ProposerSlashings = []*ethpb_alpha.ProposerSlashing{proposerSlashing}
+	b.Block.Body.AttesterSlashings = []*ethpb_alpha.AttesterSlashing{attesterSlashing}
+	require.Equal(t, 1, len(b.Block.Body.Attestations))
+	att := b.Block.Body.Attestations[0]
+	// Attesters are only rewarded for their first inclusion.
+	b.Block.Body.Attestations = append(b.Block.Body.Attestations, att)
+	require.NoError(t, beaconDB.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(b)))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	attestingIndices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(attestingIndices))
+	var attestationsReward uint64
+	for _, index := range attestingIndices {
+		if types.ValidatorIndex(index) == slashedIndices[0] || types.ValidatorIndex(index) == slashedIndices[1] {
+			continue
+		}
+		baseReward, err := epoch.BaseReward(preState, types.ValidatorIndex(index))
+		require.NoError(t, err)
+		attestationsReward += baseReward / params.BeaconConfig().ProposerRewardQuotient
+	}
+	whistleblowerReward := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().WhistleBlowerRewardQuotient
+	wanted := &ethpb.BlockRewards{
+		ProposerIndex:     b.Block.ProposerIndex,
+		Total:             attestationsReward + 2*whistleblowerReward,
+		Attestations:      attestationsReward,
+		ProposerSlashings: whistleblowerReward,
+		AttesterSlashings: whistleblowerReward,
+	}
+
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForRoot(genesisState, bytesutil.ToBytes32(b.Block.ParentRoot))
+	chainService := &chainMock.ChainService{FinalizedCheckPoint: &ethpb_alpha.Checkpoint{Epoch: 0}}
+	s := &Server{
+		BeaconDB:         beaconDB,
+		ChainInfoFetcher: chainService,
+		StateGenService:  stateGen,
+		RewardsCache:     cache.NewRewardsCache(),
+	}
+
+	t.Run("Rewards of block", func(t *testing.T) {
+		resp, err := s.GetBlockRewards(ctx, &ethpb.BlockRequest{BlockId: root[:]})
+		require.NoError(t, err)
+		assert.DeepEqual(t, wanted, resp.Data)
+		// The block is not finalized.
+		assert.Equal(t, true, s.RewardsCache.BlockRewards(root) == nil)
+	})
+	t.Run("Rewards of finalized block are cached", func(t *testing.T) {
+		chainService.FinalizedCheckPoint = &ethpb_alpha.Checkpoint{Epoch: 1}
+		_, err := s.GetBlockRewards(ctx, &ethpb.BlockRequest{BlockId: root[:]})
+		require.NoError(t, err)
+		s.StateGenService = stategen.NewMockService()
+		resp, err := s.GetBlockRewards(ctx, &ethpb.BlockRequest{BlockId: root[:]})
+		require.NoError(t, err)
+		assert.DeepEqual(t, wanted, resp.Data)
+	})
+	t.Run("Block not found", func(t *testing.T) {
+		_, err := s.GetBlockRewards(ctx, &ethpb.BlockRequest{BlockId: bytesutil.PadTo([]byte("foo"), 32)})
+		assert.ErrorContains(t, "Could not find requested block", err)
+	})
+	t.Run("Invalid block ID", func(t *testing.T) {
+		_, err := s.GetBlockRewards(ctx, &ethpb.BlockRequest{BlockId: []byte("foo")})
+		assert.ErrorContains(t, "Invalid block ID", err)
+	})
+}
+
+func TestListAttestationRewards(t *testing.T) {
+	ctx := context.Background()
+	st, _ := sharedtestutil.DeterministicGenesisState(t, 64)
+	endSlot, err := helpers.EndSlot(1)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(endSlot))
+	// The first committee of epoch 0 attests correctly.
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+	zeroRoot := make([]byte, 32)
+	require.NoError(t, st.AppendPreviousEpochAttestations(&p2ppb.PendingAttestation{
+		Data: &ethpb_alpha.AttestationData{
+			BeaconBlockRoot: zeroRoot,
+			Source:          &ethpb_alpha.Checkpoint{Root: zeroRoot},
+			Target:          &ethpb_alpha.Checkpoint{Root: zeroRoot},
+		},
+		AggregationBits: bits,
+		InclusionDelay:  1,
+	}))
+	attesters := make(map[types.ValidatorIndex]bool)
+	for _, index := range committee {
+		attesters[index] = true
+	}
+
+	vp, bp, err := precompute.New(ctx, st)
+	require.NoError(t, err)
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	require.NoError(t, err)
+	wantedRewards, wantedPenalties, err := precompute.AttestationsDelta(st, bp, vp)
+	require.NoError(t, err)
+
+	stateGen := stategen.NewMockService()
+	stateGen.AddStateForSlot(st, endSlot)
+	currentSlot := endSlot + 1
+	chainService := &chainMock.ChainService{
+		FinalizedCheckPoint: &ethpb_alpha.Checkpoint{Epoch: 0},
+		State:               st,
+		Slot:                &currentSlot,
+	}
+	s := &Server{
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		StateGenService:    stateGen,
+		RewardsCache:       cache.NewRewardsCache(),
+	}
+
+	t.Run("Rewards of all validators", func(t *testing.T) {
+		resp, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0})
+		require.NoError(t, err)
+		require.Equal(t, st.NumValidators(), len(resp.Data))
+		for i, reward := range resp.Data {
+			assert.Equal(t, types.ValidatorIndex(i), reward.ValidatorIndex)
+			total := reward.Source + reward.Target + reward.Head + int64(reward.InclusionDelay) + reward.Inactivity
+			assert.Equal(t, int64(wantedRewards[i])-int64(wantedPenalties[i]), total, "Unexpected total reward of validator %d", i)
+			if attesters[reward.ValidatorIndex] {
+				assert.Equal(t, true, reward.Source > 0 && reward.Target > 0 && reward.Head > 0)
+				assert.NotEqual(t, uint64(0), reward.InclusionDelay)
+			} else {
+				assert.Equal(t, true, reward.Source < 0 && reward.Target < 0 && reward.Head < 0)
+				assert.Equal(t, uint64(0), reward.InclusionDelay)
+			}
+			assert.Equal(t, int64(0), reward.Inactivity)
+		}
+		// The epoch is not finalized.
+		assert.Equal(t, 0, len(s.RewardsCache.AttestationRewards(0)))
+	})
+	t.Run("Rewards of requested validators", func(t *testing.T) {
+		pubKey := st.PubkeyAtIndex(2)
+		resp, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{
+			Epoch: 0,
+			Id:    [][]byte{[]byte("1"), pubKey[:], []byte("1000")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, types.ValidatorIndex(1), resp.Data[0].ValidatorIndex)
+		assert.Equal(t, types.ValidatorIndex(2), resp.Data[1].ValidatorIndex)
+	})
+	t.Run("Rewards of finalized epoch are cached", func(t *testing.T) {
+		chainService.FinalizedCheckPoint = &ethpb_alpha.Checkpoint{Epoch: 2}
+		want, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0})
+		require.NoError(t, err)
+		s.StateGenService = stategen.NewMockService()
+		resp, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0})
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, resp)
+		pubKey := st.PubkeyAtIndex(2)
+		resp, err = s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0, Id: [][]byte{pubKey[:]}})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, want.Data[2], resp.Data[0])
+	})
+	t.Run("Invalid validator ID", func(t *testing.T) {
+		_, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 0, Id: [][]byte{[]byte("foo")}})
+		assert.ErrorContains(t, "Invalid validator ID", err)
+	})
+	t.Run("Rewards not applied yet", func(t *testing.T) {
+		_, err := s.ListAttestationRewards(ctx, &ethpb.AttestationRewardsRequest{Epoch: 1})
+		assert.ErrorContains(t, "Attestation rewards of epoch 1 are applied at the end of epoch 2, current epoch is 2", err)
+	})
+}
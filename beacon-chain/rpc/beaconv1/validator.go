@@ -211,29 +211,13 @@ func valContainersByRequestIds(state iface.BeaconState, validatorIds [][]byte) (
 			}
 		}
 	} else {
-		valContainers = make([]*ethpb.ValidatorContainer, 0, len(validatorIds))
-		for _, validatorId := range validatorIds {
-			var valIndex types.ValidatorIndex
-			if len(validatorId) == params.BeaconConfig().BLSPubkeyLength {
-				var ok bool
-				valIndex, ok = state.ValidatorIndexByPubkey(bytesutil.ToBytes48(validatorId))
-				if !ok {
-					// Ignore well-formed yet unknown public keys.
-					continue
-				}
-			} else {
-				index, err := strconv.ParseUint(string(validatorId), 10, 64)
-				if err != nil {
-					e := newInvalidValidatorIdError(validatorId, err)
-					return nil, &e
-				}
-				valIndex = types.ValidatorIndex(index)
-			}
+		valIndices, err := validatorIndicesByRequestIds(state, validatorIds)
+		if err != nil {
+			return nil, err
+		}
+		valContainers = make([]*ethpb.ValidatorContainer, 0, len(valIndices))
+		for _, valIndex := range valIndices {
 			validator, err := state.ValidatorAtIndex(valIndex)
-			if _, ok := err.(*stateV0.ValidatorIndexOutOfRangeError); ok {
-				// Ignore well-formed yet unknown indexes.
-				continue
-			}
 			if err != nil {
 				return nil, errors.Wrap(err, "could not get validator")
 			}
@@ -254,6 +238,36 @@ func valContainersByRequestIds(state iface.BeaconState, validatorIds [][]byte) (
 	return valContainers, nil
 }
 
+// This function returns the indices of the validators identified by the passed in IDs. The validator ID could be
+// its public key, or its index. Well-formed yet unknown public keys and indices are ignored.
+func validatorIndicesByRequestIds(state iface.ReadOnlyBeaconState, validatorIds [][]byte) ([]types.ValidatorIndex, error) {
+	valIndices := make([]types.ValidatorIndex, 0, len(validatorIds))
+	for _, validatorId := range validatorIds {
+		var valIndex types.ValidatorIndex
+		if len(validatorId) == params.BeaconConfig().BLSPubkeyLength {
+			var ok bool
+			valIndex, ok = state.ValidatorIndexByPubkey(bytesutil.ToBytes48(validatorId))
+			if !ok {
+				// Ignore well-formed yet unknown public keys.
+				continue
+			}
+		} else {
+			index, err := strconv.ParseUint(string(validatorId), 10, 64)
+			if err != nil {
+				e := newInvalidValidatorIdError(validatorId, err)
+				return nil, &e
+			}
+			valIndex = types.ValidatorIndex(index)
+		}
+		if uint64(valIndex) >= uint64(state.NumValidators()) {
+			// Ignore well-formed yet unknown indexes.
+			continue
+		}
+		valIndices = append(valIndices, valIndex)
+	}
+	return valIndices, nil
+}
+
 func validatorStatus(validator *ethpb.Validator, epoch types.Epoch) (ethpb.ValidatorStatus, error) {
 	valStatus, err := validatorSubStatus(validator, epoch)
 	if err != nil {
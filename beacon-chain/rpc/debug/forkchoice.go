@@ -5,7 +5,10 @@ import (
 	"encoding/hex"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetProtoArrayForkChoice returns proto array fork choice store.
@@ -42,3 +45,51 @@ func (ds *Server) GetProtoArrayForkChoice(_ context.Context, _ *empty.Empty) (*p
 		Indices:         indices,
 	}, nil
 }
+
+// GetForkChoiceTree returns the nodes of the fork choice tree matching the request, or the graph of these
+// nodes in the DOT language.
+func (ds *Server) GetForkChoiceTree(ctx context.Context, req *pbrpc.ForkChoiceTreeRequest) (*pbrpc.ForkChoiceTreeResponse, error) {
+	if req.EndSlot != 0 && req.EndSlot < req.StartSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "End slot %d is lower than start slot %d", req.EndSlot, req.StartSlot)
+	}
+	tree, err := ds.ForkChoiceTreeFetcher.ForkChoiceTree(ctx, &blockchain.ForkChoiceTreeFilter{
+		StartSlot:        req.StartSlot,
+		EndSlot:          req.EndSlot,
+		MinWeight:        req.MinWeight,
+		NonCanonicalOnly: req.NonCanonicalOnly,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get fork choice tree: %v", err)
+	}
+
+	res := &pbrpc.ForkChoiceTreeResponse{
+		JustifiedEpoch: tree.JustifiedEpoch,
+		FinalizedEpoch: tree.FinalizedEpoch,
+		HeadRoot:       tree.HeadRoot[:],
+	}
+	switch req.Format {
+	case pbrpc.ForkChoiceTreeRequest_DOT:
+		res.Dot = tree.DOT()
+	case pbrpc.ForkChoiceTreeRequest_JSON:
+		res.Nodes = make([]*pbrpc.ForkChoiceTreeNode, len(tree.Nodes))
+		for i, n := range tree.Nodes {
+			res.Nodes[i] = &pbrpc.ForkChoiceTreeNode{
+				Slot:               n.Slot,
+				Root:               n.Root[:],
+				ParentRoot:         n.ParentRoot[:],
+				JustifiedEpoch:     n.JustifiedEpoch,
+				FinalizedEpoch:     n.FinalizedEpoch,
+				Weight:             n.Weight,
+				WeightDelta:        n.WeightDelta,
+				BestChildRoot:      n.BestChildRoot[:],
+				BestDescendantRoot: n.BestDescendantRoot[:],
+				Viable:             n.Viable,
+				Canonical:          n.Canonical,
+				Graffiti:           n.Graffiti[:],
+			}
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown format %v", req.Format)
+	}
+	return res, nil
+}
@@ -5,8 +5,12 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -20,3 +24,54 @@ func TestServer_GetForkChoice(t *testing.T) {
 	assert.Equal(t, store.JustifiedEpoch(), res.JustifiedEpoch, "Did not get wanted justified epoch")
 	assert.Equal(t, store.FinalizedEpoch(), res.FinalizedEpoch, "Did not get wanted finalized epoch")
 }
+
+type mockForkChoiceTreeFetcher struct {
+	tree   *blockchain.ForkChoiceTree
+	filter *blockchain.ForkChoiceTreeFilter
+}
+
+func (m *mockForkChoiceTreeFetcher) ForkChoiceTree(_ context.Context, filter *blockchain.ForkChoiceTreeFilter) (*blockchain.ForkChoiceTree, error) {
+	m.filter = filter
+	return m.tree, nil
+}
+
+func TestServer_GetForkChoiceTree(t *testing.T) {
+	fetcher := &mockForkChoiceTreeFetcher{
+		tree: &blockchain.ForkChoiceTree{
+			JustifiedEpoch: 2,
+			FinalizedEpoch: 1,
+			HeadRoot:       [32]byte{'b'},
+			Nodes: []*blockchain.ForkChoiceTreeNode{
+				{Slot: 64, Root: [32]byte{'a'}, Weight: 20, BestChildRoot: [32]byte{'b'}, BestDescendantRoot: [32]byte{'b'}, Viable: true, Canonical: true},
+				{Slot: 65, Root: [32]byte{'b'}, ParentRoot: [32]byte{'a'}, Weight: 10, WeightDelta: -5, Graffiti: [32]byte{'g'}, Viable: true, Canonical: true},
+			},
+		},
+	}
+	ds := &Server{ForkChoiceTreeFetcher: fetcher}
+	req := &pbrpc.ForkChoiceTreeRequest{StartSlot: 64, EndSlot: 65, MinWeight: 10, NonCanonicalOnly: true}
+	res, err := ds.GetForkChoiceTree(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &blockchain.ForkChoiceTreeFilter{StartSlot: 64, EndSlot: 65, MinWeight: 10, NonCanonicalOnly: true}, fetcher.filter)
+	assert.Equal(t, types.Epoch(2), res.JustifiedEpoch)
+	assert.Equal(t, types.Epoch(1), res.FinalizedEpoch)
+	assert.DeepEqual(t, []byte{'b'}, res.HeadRoot[:1])
+	require.Equal(t, 2, len(res.Nodes))
+	assert.Equal(t, types.Slot(65), res.Nodes[1].Slot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 32), res.Nodes[1].ParentRoot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'b'}, 32), res.Nodes[0].BestDescendantRoot)
+	assert.Equal(t, int64(-5), res.Nodes[1].WeightDelta)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'g'}, 32), res.Nodes[1].Graffiti)
+	assert.Equal(t, "", res.Dot)
+
+	req.Format = pbrpc.ForkChoiceTreeRequest_DOT
+	res, err = ds.GetForkChoiceTree(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Nodes))
+	assert.Equal(t, fetcher.tree.DOT(), res.Dot)
+}
+
+func TestServer_GetForkChoiceTree_InvalidRange(t *testing.T) {
+	ds := &Server{ForkChoiceTreeFetcher: &mockForkChoiceTreeFetcher{}}
+	_, err := ds.GetForkChoiceTree(context.Background(), &pbrpc.ForkChoiceTreeRequest{StartSlot: 65, EndSlot: 64})
+	assert.ErrorContains(t, "End slot 64 is lower than start slot 65", err)
+}
@@ -97,105 +97,117 @@ func (s *Server) StreamEvents(
 func (s *Server) handleBlockEvents(
 	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
 ) error {
+	return s.handleEvent(stream, requestedTopics, event, blockEventMessage)
+}
+
+func (s *Server) handleBlockOperationEvents(
+	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
+) error {
+	return s.handleEvent(stream, requestedTopics, event, operationEventMessage)
+}
+
+func (s *Server) handleStateEvents(
+	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
+) error {
+	return s.handleEvent(stream, requestedTopics, event, stateEventMessage)
+}
+
+func (s *Server) handleEvent(
+	stream ethpb.Events_StreamEventsServer,
+	requestedTopics map[string]bool,
+	event *feed.Event,
+	toMessage func(event *feed.Event) (string, proto.Message, error),
+) error {
+	topic, msg, err := toMessage(event)
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		return nil
+	}
+	if _, ok := requestedTopics[topic]; !ok {
+		return nil
+	}
+	return s.streamData(stream, topic, msg)
+}
+
+// blockEventMessage converts an event of the block feed into the topic and the v1 message of an API event.
+// A nil message is returned for events which are not exposed by the API.
+func blockEventMessage(event *feed.Event) (string, proto.Message, error) {
 	switch event.Type {
 	case blockfeed.ReceivedBlock:
-		if _, ok := requestedTopics[BlockTopic]; !ok {
-			return nil
-		}
 		blkData, ok := event.Data.(*blockfeed.ReceivedBlockData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
 		v1Data, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		item, err := v1Data.HashTreeRoot()
 		if err != nil {
-			return errors.Wrap(err, "could not hash tree root block")
+			return "", nil, errors.Wrap(err, "could not hash tree root block")
 		}
-		eventBlock := &ethpb.EventBlock{
+		return BlockTopic, &ethpb.EventBlock{
 			Slot:  v1Data.Message.Slot,
 			Block: item[:],
-		}
-		return s.streamData(stream, BlockTopic, eventBlock)
+		}, nil
 	default:
-		return nil
+		return "", nil, nil
 	}
 }
 
-func (s *Server) handleBlockOperationEvents(
-	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+// operationEventMessage converts an event of the operation feed into the topic and the v1 message of an API event.
+// A nil message is returned for events which are not exposed by the API.
+func operationEventMessage(event *feed.Event) (string, proto.Message, error) {
 	switch event.Type {
 	case operation.AggregatedAttReceived:
-		if _, ok := requestedTopics[AttestationTopic]; !ok {
-			return nil
-		}
 		attData, ok := event.Data.(*operation.AggregatedAttReceivedData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		v1Data := migration.V1Alpha1AggregateAttAndProofToV1(attData.Attestation)
-		return s.streamData(stream, AttestationTopic, v1Data)
+		return AttestationTopic, migration.V1Alpha1AggregateAttAndProofToV1(attData.Attestation), nil
 	case operation.UnaggregatedAttReceived:
-		if _, ok := requestedTopics[AttestationTopic]; !ok {
-			return nil
-		}
 		attData, ok := event.Data.(*operation.UnAggregatedAttReceivedData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		v1Data := migration.V1Alpha1AttestationToV1(attData.Attestation)
-		return s.streamData(stream, AttestationTopic, v1Data)
+		return AttestationTopic, migration.V1Alpha1AttestationToV1(attData.Attestation), nil
 	case operation.ExitReceived:
-		if _, ok := requestedTopics[VoluntaryExitTopic]; !ok {
-			return nil
-		}
 		exitData, ok := event.Data.(*operation.ExitReceivedData)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		v1Data := migration.V1Alpha1ExitToV1(exitData.Exit)
-		return s.streamData(stream, VoluntaryExitTopic, v1Data)
+		return VoluntaryExitTopic, migration.V1Alpha1ExitToV1(exitData.Exit), nil
 	default:
-		return nil
+		return "", nil, nil
 	}
 }
 
-func (s *Server) handleStateEvents(
-	stream ethpb.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+// stateEventMessage converts an event of the state feed into the topic and the v1 message of an API event.
+// A nil message is returned for events which are not exposed by the API.
+func stateEventMessage(event *feed.Event) (string, proto.Message, error) {
 	switch event.Type {
 	case statefeed.NewHead:
-		if _, ok := requestedTopics[HeadTopic]; !ok {
-			return nil
-		}
 		head, ok := event.Data.(*ethpb.EventHead)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		return s.streamData(stream, HeadTopic, head)
+		return HeadTopic, head, nil
 	case statefeed.FinalizedCheckpoint:
-		if _, ok := requestedTopics[FinalizedCheckpointTopic]; !ok {
-			return nil
-		}
 		finalizedCheckpoint, ok := event.Data.(*ethpb.EventFinalizedCheckpoint)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		return s.streamData(stream, FinalizedCheckpointTopic, finalizedCheckpoint)
+		return FinalizedCheckpointTopic, finalizedCheckpoint, nil
 	case statefeed.Reorg:
-		if _, ok := requestedTopics[ChainReorgTopic]; !ok {
-			return nil
-		}
 		reorg, ok := event.Data.(*ethpb.EventChainReorg)
 		if !ok {
-			return nil
+			return "", nil, nil
 		}
-		return s.streamData(stream, ChainReorgTopic, reorg)
+		return ChainReorgTopic, reorg, nil
 	default:
-		return nil
+		return "", nil, nil
 	}
 }
 
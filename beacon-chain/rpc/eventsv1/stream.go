@@ -0,0 +1,255 @@
+package eventsv1
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultReplayBufferSize is the default number of past events kept for resuming clients.
+	DefaultReplayBufferSize = 2048
+	// DefaultSubscriberBufferSize is the default number of events which may be pending for
+	// a single subscriber before it is considered a slow consumer and disconnected.
+	DefaultSubscriberBufferSize = 256
+)
+
+var (
+	// ErrSlowConsumer is the error of a subscription which was closed because its
+	// subscriber did not keep up with the published events.
+	ErrSlowConsumer = errors.New("subscriber did not keep up with the event stream")
+	// ErrStreamerStopped is the error of a subscription which was closed because
+	// the streamer was stopped.
+	ErrStreamerStopped = errors.New("event streamer stopped")
+)
+
+// StreamedEvent is an event published by the streamer. Events are numbered
+// with strictly increasing IDs, starting from 1.
+type StreamedEvent struct {
+	ID    uint64
+	Topic string
+	Data  proto.Message
+}
+
+// StreamerConfig defines the feeds and the buffer sizes of a streamer.
+type StreamerConfig struct {
+	StateNotifier        statefeed.Notifier
+	BlockNotifier        blockfeed.Notifier
+	OperationNotifier    opfeed.Notifier
+	ReplayBufferSize     int
+	SubscriberBufferSize int
+}
+
+// Streamer subscribes once to the block, state and operation feeds of the beacon node
+// and publishes the API events derived from them to any number of subscribers.
+// The most recent events are kept in a bounded replay buffer, so that subscribers
+// which reconnect can resume from the last event they received. A subscriber whose
+// buffer fills up is disconnected instead of blocking the other subscribers.
+type Streamer struct {
+	cfg         *StreamerConfig
+	ctx         context.Context
+	cancel      context.CancelFunc
+	lock        sync.Mutex
+	lastID      uint64
+	replay      []*StreamedEvent
+	subscribers map[*Subscription]bool
+	stopped     bool
+}
+
+// Subscription receives the events of the requested topics from a streamer.
+type Subscription struct {
+	streamer *Streamer
+	topics   map[string]bool
+	events   chan *StreamedEvent
+	done     chan struct{}
+	err      error
+}
+
+// NewStreamer creates a streamer of the events of the configured feeds.
+func NewStreamer(ctx context.Context, cfg *StreamerConfig) *Streamer {
+	if cfg.ReplayBufferSize <= 0 {
+		cfg.ReplayBufferSize = DefaultReplayBufferSize
+	}
+	if cfg.SubscriberBufferSize <= 0 {
+		cfg.SubscriberBufferSize = DefaultSubscriberBufferSize
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Streamer{
+		cfg:         cfg,
+		ctx:         ctx,
+		cancel:      cancel,
+		replay:      make([]*StreamedEvent, 0, cfg.ReplayBufferSize),
+		subscribers: make(map[*Subscription]bool),
+	}
+}
+
+// Start subscribes to the feeds and publishes their events until the streamer is stopped.
+func (s *Streamer) Start() {
+	blockChan := make(chan *feed.Event, 1)
+	blockSub := s.cfg.BlockNotifier.BlockFeed().Subscribe(blockChan)
+	opsChan := make(chan *feed.Event, 1)
+	opsSub := s.cfg.OperationNotifier.OperationFeed().Subscribe(opsChan)
+	stateChan := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChan)
+
+	go func() {
+		defer blockSub.Unsubscribe()
+		defer opsSub.Unsubscribe()
+		defer stateSub.Unsubscribe()
+		for {
+			select {
+			case event := <-blockChan:
+				s.publishEvent(event, blockEventMessage)
+			case event := <-opsChan:
+				s.publishEvent(event, operationEventMessage)
+			case event := <-stateChan:
+				s.publishEvent(event, stateEventMessage)
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop the streamer and close all subscriptions.
+func (s *Streamer) Stop() error {
+	s.cancel()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.stopped = true
+	for sub := range s.subscribers {
+		s.closeSubscription(sub, ErrStreamerStopped)
+	}
+	return nil
+}
+
+// Status always returns nil.
+func (s *Streamer) Status() error {
+	return nil
+}
+
+// Subscribe to the events of the requested topics. Buffered events with an ID greater than
+// lastEventID are delivered before any new event, so that a subscriber which passes the ID of
+// the last event it received misses no event and receives no event twice, as long as the
+// missed events are still in the replay buffer. A lastEventID of 0 skips the replay.
+func (s *Streamer) Subscribe(topics []string, lastEventID uint64) (*Subscription, error) {
+	if len(topics) == 0 {
+		return nil, errors.New("no topics specified to subscribe to")
+	}
+	requestedTopics := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		if _, ok := casesHandled[topic]; !ok {
+			return nil, errors.Errorf("topic %s not allowed for event subscriptions", topic)
+		}
+		requestedTopics[topic] = true
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.stopped {
+		return nil, ErrStreamerStopped
+	}
+	var missed []*StreamedEvent
+	if lastEventID > 0 {
+		start := sort.Search(len(s.replay), func(i int) bool {
+			return s.replay[i].ID > lastEventID
+		})
+		for _, event := range s.replay[start:] {
+			if requestedTopics[event.Topic] {
+				missed = append(missed, event)
+			}
+		}
+	}
+	sub := &Subscription{
+		streamer: s,
+		topics:   requestedTopics,
+		events:   make(chan *StreamedEvent, len(missed)+s.cfg.SubscriberBufferSize),
+		done:     make(chan struct{}),
+	}
+	for _, event := range missed {
+		sub.events <- event
+	}
+	s.subscribers[sub] = true
+	return sub, nil
+}
+
+func (s *Streamer) publishEvent(event *feed.Event, toMessage func(event *feed.Event) (string, proto.Message, error)) {
+	topic, msg, err := toMessage(event)
+	if err != nil {
+		log.WithError(err).Error("Could not convert event")
+		return
+	}
+	if msg == nil {
+		return
+	}
+	s.publish(topic, msg)
+}
+
+// publish numbers the event, adds it to the replay buffer and hands it to the subscribers.
+// This happens under the same lock as subscribing, so that each event is either replayed
+// to a new subscriber or published to it, but never both.
+func (s *Streamer) publish(topic string, msg proto.Message) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lastID++
+	event := &StreamedEvent{ID: s.lastID, Topic: topic, Data: msg}
+	if len(s.replay) == s.cfg.ReplayBufferSize {
+		copy(s.replay, s.replay[1:])
+		s.replay = s.replay[:len(s.replay)-1]
+	}
+	s.replay = append(s.replay, event)
+
+	for sub := range s.subscribers {
+		if !sub.topics[topic] {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			s.closeSubscription(sub, ErrSlowConsumer)
+		}
+	}
+}
+
+// closeSubscription must be called with the lock held.
+func (s *Streamer) closeSubscription(sub *Subscription, err error) {
+	if !s.subscribers[sub] {
+		return
+	}
+	delete(s.subscribers, sub)
+	sub.err = err
+	close(sub.done)
+}
+
+// Events returns the channel of the subscribed events.
+func (sub *Subscription) Events() <-chan *StreamedEvent {
+	return sub.events
+}
+
+// Done returns a channel which is closed when the streamer closes the subscription.
+// Events which are still buffered in the events channel at that point are not part
+// of the subscription anymore and should be resumed with a new subscription.
+func (sub *Subscription) Done() <-chan struct{} {
+	return sub.done
+}
+
+// Err returns the reason why the streamer closed the subscription.
+func (sub *Subscription) Err() error {
+	sub.streamer.lock.Lock()
+	defer sub.streamer.lock.Unlock()
+	return sub.err
+}
+
+// Unsubscribe stops the delivery of events to the subscription.
+func (sub *Subscription) Unsubscribe() {
+	sub.streamer.lock.Lock()
+	defer sub.streamer.lock.Unlock()
+	delete(sub.streamer.subscribers, sub)
+}
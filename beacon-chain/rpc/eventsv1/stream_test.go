@@ -0,0 +1,172 @@
+package eventsv1
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupStreamer(t testing.TB, cfg *StreamerConfig) *Streamer {
+	cfg.StateNotifier = &mockChain.MockStateNotifier{}
+	cfg.BlockNotifier = &mockChain.MockBlockNotifier{}
+	cfg.OperationNotifier = &mockChain.MockOperationNotifier{}
+	s := NewStreamer(context.Background(), cfg)
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	return s
+}
+
+func receiveEvent(t *testing.T, sub *Subscription) *StreamedEvent {
+	select {
+	case event := <-sub.Events():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive event")
+		return nil
+	}
+}
+
+func TestStreamer_Subscribe_Preconditions(t *testing.T) {
+	s := setupStreamer(t, &StreamerConfig{})
+	_, err := s.Subscribe(nil, 0)
+	assert.ErrorContains(t, "no topics specified", err)
+	_, err = s.Subscribe([]string{HeadTopic, "foobar"}, 0)
+	assert.ErrorContains(t, "topic foobar not allowed", err)
+
+	require.NoError(t, s.Stop())
+	_, err = s.Subscribe([]string{HeadTopic}, 0)
+	assert.ErrorContains(t, ErrStreamerStopped.Error(), err)
+}
+
+func TestStreamer_PublishesFeedEvents(t *testing.T) {
+	s := setupStreamer(t, &StreamerConfig{})
+	s.Start()
+	sub, err := s.Subscribe([]string{HeadTopic}, 0)
+	require.NoError(t, err)
+
+	head := &ethpb.EventHead{Slot: 8}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{Type: statefeed.NewHead, Data: head})
+	// Events of the state feed which are not exposed by the API are skipped.
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{Type: statefeed.Initialized})
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{Type: statefeed.NewHead, Data: head})
+
+	event := receiveEvent(t, sub)
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Equal(t, HeadTopic, event.Topic)
+	assert.DeepEqual(t, head, event.Data)
+	assert.Equal(t, uint64(2), receiveEvent(t, sub).ID)
+}
+
+func TestStreamer_ReplaysMissedEvents(t *testing.T) {
+	s := setupStreamer(t, &StreamerConfig{ReplayBufferSize: 4})
+	for i := 1; i <= 6; i++ {
+		s.publish(HeadTopic, &ethpb.EventHead{Slot: types.Slot(i)})
+		s.publish(FinalizedCheckpointTopic, &ethpb.EventFinalizedCheckpoint{Epoch: types.Epoch(i)})
+	}
+
+	// Only the last two head events are still in the replay buffer.
+	sub, err := s.Subscribe([]string{HeadTopic}, 4)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), receiveEvent(t, sub).Data.(*ethpb.EventHead).Slot)
+	assert.Equal(t, types.Slot(6), receiveEvent(t, sub).Data.(*ethpb.EventHead).Slot)
+
+	// A new subscriber does not receive past events.
+	newSub, err := s.Subscribe([]string{HeadTopic}, 0)
+	require.NoError(t, err)
+	s.publish(HeadTopic, &ethpb.EventHead{Slot: 7})
+	assert.Equal(t, uint64(13), receiveEvent(t, sub).ID)
+	assert.Equal(t, uint64(13), receiveEvent(t, newSub).ID)
+}
+
+func TestStreamer_ReconnectingSubscriberMissesNoEvents(t *testing.T) {
+	const numEvents = 2000
+	s := setupStreamer(t, &StreamerConfig{ReplayBufferSize: numEvents, SubscriberBufferSize: numEvents})
+	sub, err := s.Subscribe([]string{HeadTopic}, 0)
+	require.NoError(t, err)
+
+	go func() {
+		for i := 1; i <= numEvents; i++ {
+			s.publish(HeadTopic, &ethpb.EventHead{Slot: types.Slot(i)})
+		}
+	}()
+
+	// Reconnect after a random number of events, while events are being published concurrently.
+	var received []types.Slot
+	var lastID uint64
+	for len(received) < numEvents {
+		for i := rand.Intn(50); i >= 0 && len(received) < numEvents; i-- {
+			event := receiveEvent(t, sub)
+			require.Equal(t, lastID+1, event.ID)
+			lastID = event.ID
+			received = append(received, event.Data.(*ethpb.EventHead).Slot)
+		}
+		sub.Unsubscribe()
+		sub, err = s.Subscribe([]string{HeadTopic}, lastID)
+		require.NoError(t, err)
+	}
+	for i, slot := range received {
+		require.Equal(t, types.Slot(i+1), slot)
+	}
+	select {
+	case event := <-sub.Events():
+		t.Fatalf("Received unexpected event %d", event.ID)
+	default:
+	}
+}
+
+func TestStreamer_DisconnectsSlowConsumer(t *testing.T) {
+	s := setupStreamer(t, &StreamerConfig{SubscriberBufferSize: 2})
+	slowSub, err := s.Subscribe([]string{HeadTopic}, 0)
+	require.NoError(t, err)
+	otherSub, err := s.Subscribe([]string{HeadTopic}, 0)
+	require.NoError(t, err)
+
+	s.publish(HeadTopic, &ethpb.EventHead{Slot: 1})
+	s.publish(HeadTopic, &ethpb.EventHead{Slot: 2})
+	assert.Equal(t, uint64(1), receiveEvent(t, otherSub).ID)
+	assert.Equal(t, uint64(2), receiveEvent(t, otherSub).ID)
+	s.publish(HeadTopic, &ethpb.EventHead{Slot: 3})
+
+	select {
+	case <-slowSub.Done():
+	default:
+		t.Fatal("Slow consumer was not disconnected")
+	}
+	assert.ErrorContains(t, ErrSlowConsumer.Error(), slowSub.Err())
+	select {
+	case <-otherSub.Done():
+		t.Fatal("Consumer which kept up was disconnected")
+	default:
+	}
+	assert.Equal(t, uint64(3), receiveEvent(t, otherSub).ID)
+
+	// The slow consumer resumes from the last event it received.
+	assert.Equal(t, uint64(1), receiveEvent(t, slowSub).ID)
+	resumedSub, err := s.Subscribe([]string{HeadTopic}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), receiveEvent(t, resumedSub).ID)
+	assert.Equal(t, uint64(3), receiveEvent(t, resumedSub).ID)
+}
+
+func TestStreamer_Stop(t *testing.T) {
+	s := setupStreamer(t, &StreamerConfig{})
+	sub, err := s.Subscribe([]string{HeadTopic}, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.Stop())
+	select {
+	case <-sub.Done():
+	default:
+		t.Fatal("Subscription was not closed")
+	}
+	assert.ErrorContains(t, ErrStreamerStopped.Error(), sub.Err())
+}
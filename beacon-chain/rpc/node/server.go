@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
@@ -34,6 +35,7 @@ type Server struct {
 	LogsStreamer         logutil.Streamer
 	StreamLogsBufferSize int
 	SyncChecker          sync.Checker
+	BackfillFetcher      backfill.StatusFetcher
 	Server               *grpc.Server
 	BeaconDB             db.ReadOnlyDatabase
 	PeersFetcher         p2p.PeersProvider
@@ -47,7 +49,9 @@ type Server struct {
 // GetSyncStatus checks the current network sync status of the node.
 func (ns *Server) GetSyncStatus(_ context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
 	return &ethpb.SyncStatus{
-		Syncing: ns.SyncChecker.Syncing(),
+		Syncing:              ns.SyncChecker.Syncing(),
+		Backfilling:          ns.BackfillFetcher.Backfilling(),
+		LowestBackfilledSlot: ns.BackfillFetcher.LowestBackfilledSlot(),
 	}, nil
 }
 
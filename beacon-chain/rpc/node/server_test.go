@@ -8,10 +8,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockBackfill "github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -28,7 +30,8 @@ import (
 func TestNodeServer_GetSyncStatus(t *testing.T) {
 	mSync := &mockSync.Sync{IsSyncing: false}
 	ns := &Server{
-		SyncChecker: mSync,
+		SyncChecker:     mSync,
+		BackfillFetcher: &mockBackfill.Backfill{},
 	}
 	res, err := ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
@@ -37,6 +40,12 @@ func TestNodeServer_GetSyncStatus(t *testing.T) {
 	res, err = ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, true, res.Syncing)
+	assert.Equal(t, false, res.Backfilling)
+	ns.BackfillFetcher = &mockBackfill.Backfill{IsBackfilling: true, LowestSlot: 96}
+	res, err = ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Backfilling)
+	assert.Equal(t, types.Slot(96), res.LowestBackfilledSlot)
 }
 
 func TestNodeServer_GetGenesis(t *testing.T) {
@@ -128,10 +128,35 @@ func (ns *Server) GetPeer(ctx context.Context, req *ethpb.PeerRequest) (*ethpb.P
 			LastSeenP2PAddress: p2pAddress.String(),
 			State:              v1ConnState,
 			Direction:          v1PeerDirection,
+			Score:              peerScore(peerStatus, id),
 		},
 	}, nil
 }
 
+// peerScore returns the scores of the peer, with the breakdown of its gossip score by topic.
+func peerScore(peerStatus *peers.Status, id peer.ID) *ethpb.PeerScore {
+	score := &ethpb.PeerScore{
+		Overall: peerStatus.Scorers().Score(id),
+		Bad:     peerStatus.IsBad(id),
+	}
+	gossipScore, behaviourPenalty, topicScores, err := peerStatus.Scorers().GossipScorer().GossipData(id)
+	if err != nil {
+		return score
+	}
+	score.Gossip = gossipScore
+	score.BehaviourPenalty = behaviourPenalty
+	score.TopicScores = make(map[string]*ethpb.TopicScore, len(topicScores))
+	for topic, s := range topicScores {
+		score.TopicScores[topic] = &ethpb.TopicScore{
+			TimeInMesh:               s.TimeInMesh,
+			FirstMessageDeliveries:   float64(s.FirstMessageDeliveries),
+			MeshMessageDeliveries:    float64(s.MeshMessageDeliveries),
+			InvalidMessageDeliveries: float64(s.InvalidMessageDeliveries),
+		}
+	}
+	return score
+}
+
 // ListPeers retrieves data about the node's network peers.
 func (ns *Server) ListPeers(ctx context.Context, req *ethpb.PeersRequest) (*ethpb.PeersResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "nodev1.ListPeers")
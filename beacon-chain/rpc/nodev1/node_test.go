@@ -24,6 +24,7 @@ import (
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	syncmock "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/interfaces"
@@ -209,6 +210,28 @@ func TestGetPeer(t *testing.T) {
 		assert.Equal(t, "enr:yoABgmlwhAcHBwc=", resp.Data.Enr)
 		assert.Equal(t, ethpb.ConnectionState_DISCONNECTED, resp.Data.State)
 		assert.Equal(t, ethpb.PeerDirection_INBOUND, resp.Data.Direction)
+		require.NotNil(t, resp.Data.Score)
+		assert.Equal(t, false, resp.Data.Score.Bad)
+		assert.Equal(t, 0, len(resp.Data.Score.TopicScores))
+	})
+
+	t.Run("Gossip scores", func(t *testing.T) {
+		topic := "/eth2/00000000/beacon_block/ssz_snappy"
+		peerFetcher.Peers().Scorers().GossipScorer().SetGossipData(decodedId, -5, 2, map[string]*pbrpc.TopicScoreSnapshot{
+			topic: {TimeInMesh: 1000, FirstMessageDeliveries: 3, InvalidMessageDeliveries: 1},
+		})
+		resp, err := s.GetPeer(ctx, &ethpb.PeerRequest{PeerId: rawId})
+		require.NoError(t, err)
+		require.NotNil(t, resp.Data.Score)
+		assert.Equal(t, -5.0, resp.Data.Score.Gossip)
+		assert.Equal(t, 2.0, resp.Data.Score.BehaviourPenalty)
+		assert.Equal(t, true, resp.Data.Score.Bad, "Peer with a negative gossip score is not bad")
+		assert.Equal(t, peerFetcher.Peers().Scorers().Score(decodedId), resp.Data.Score.Overall)
+		require.NotNil(t, resp.Data.Score.TopicScores[topic])
+		assert.Equal(t, uint64(1000), resp.Data.Score.TopicScores[topic].TimeInMesh)
+		assert.Equal(t, 3.0, resp.Data.Score.TopicScores[topic].FirstMessageDeliveries)
+		assert.Equal(t, 1.0, resp.Data.Score.TopicScores[topic].InvalidMessageDeliveries)
+		peerFetcher.Peers().Scorers().GossipScorer().SetGossipData(decodedId, 0, 0, nil)
 	})
 
 	t.Run("Invalid ID", func(t *testing.T) {
@@ -0,0 +1,5 @@
+package peeradmin
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rpc/peeradmin")
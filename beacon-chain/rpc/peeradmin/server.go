@@ -0,0 +1,128 @@
+// Package peeradmin defines a gRPC server implementation of the peer admin service,
+// which allows operators to ban, unban, trust and disconnect the peers of a beacon node.
+package peeradmin
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC PeerAdmin service,
+// providing RPC endpoints to manage the peers of a beacon node by hand.
+type Server struct {
+	PeersFetcher    p2p.PeersProvider
+	PeerManager     p2p.PeerManager
+	PeerListManager p2p.PeerListManager
+}
+
+// ListManagedPeers returns the banned and trusted peers of the node, sorted by peer id.
+func (s *Server) ListManagedPeers(_ context.Context, _ *empty.Empty) (*pbrpc.ManagedPeersResponse, error) {
+	peers := s.PeersFetcher.Peers()
+	resp := &pbrpc.ManagedPeersResponse{
+		Banned:  make([]*pbrpc.BannedPeer, 0),
+		Trusted: make([]*pbrpc.TrustedPeer, 0),
+	}
+	for _, b := range peers.BannedPeers() {
+		banned := &pbrpc.BannedPeer{
+			PeerId:       b.ID.String(),
+			Reason:       b.Reason,
+			Manual:       b.Manual,
+			BadResponses: uint64(b.BadResponses),
+		}
+		if !b.Expiry.IsZero() {
+			banned.Expiry = uint64(b.Expiry.Unix())
+		}
+		resp.Banned = append(resp.Banned, banned)
+	}
+	for _, t := range peers.TrustedPeers() {
+		trusted := &pbrpc.TrustedPeer{PeerId: t.ID.String()}
+		if t.Address != nil {
+			trusted.Address = t.Address.String()
+		}
+		resp.Trusted = append(resp.Trusted, trusted)
+	}
+	sort.Slice(resp.Banned, func(i, j int) bool {
+		return resp.Banned[i].PeerId < resp.Banned[j].PeerId
+	})
+	sort.Slice(resp.Trusted, func(i, j int) bool {
+		return resp.Trusted[i].PeerId < resp.Trusted[j].PeerId
+	})
+	return resp, nil
+}
+
+// BanPeer bans the requested peer and disconnects from it.
+func (s *Server) BanPeer(_ context.Context, req *pbrpc.BanPeerRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if pid == s.PeerManager.PeerID() {
+		return nil, status.Error(codes.InvalidArgument, "Cannot ban the node itself")
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if err := s.PeerListManager.BanPeer(pid, duration, req.Reason); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from banned peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// UnbanPeer lifts the ban of the requested peer.
+func (s *Server) UnbanPeer(_ context.Context, req *ethpb.PeerRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if !s.PeerListManager.UnbanPeer(pid) {
+		return nil, status.Error(codes.NotFound, "Requested peer is not banned")
+	}
+	return &empty.Empty{}, nil
+}
+
+// AddTrustedPeer trusts the peer of the requested multiaddress and connects to it.
+func (s *Server) AddTrustedPeer(_ context.Context, req *pbrpc.TrustedPeerRequest) (*empty.Empty, error) {
+	info, err := p2p.MakePeer(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer address: %v", err)
+	}
+	if info.ID == s.PeerManager.PeerID() {
+		return nil, status.Error(codes.InvalidArgument, "Cannot trust the node itself")
+	}
+	// The peer stays trusted when it cannot be reached, the node keeps trying to connect to it.
+	if err := s.PeerListManager.AddTrustedPeer(*info); err != nil {
+		log.WithError(err).WithField("peer", info.ID).Debug("Could not connect to trusted peer")
+	}
+	return &empty.Empty{}, nil
+}
+
+// RemoveTrustedPeer stops trusting the requested peer.
+func (s *Server) RemoveTrustedPeer(_ context.Context, req *ethpb.PeerRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if !s.PeerListManager.RemoveTrustedPeer(pid) {
+		return nil, status.Error(codes.NotFound, "Requested peer is not trusted")
+	}
+	return &empty.Empty{}, nil
+}
+
+// DisconnectPeer disconnects from the requested peer.
+func (s *Server) DisconnectPeer(_ context.Context, req *ethpb.PeerRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := s.PeerManager.Disconnect(pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,120 @@
+package peeradmin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// mockPeerListManager bans and trusts peers in the peer status, without any network activity.
+type mockPeerListManager struct {
+	peers    *peers.Status
+	duration time.Duration
+}
+
+func (m *mockPeerListManager) BanPeer(pid peer.ID, duration time.Duration, reason string) error {
+	m.duration = duration
+	m.peers.Ban(pid, duration, reason)
+	return nil
+}
+
+func (m *mockPeerListManager) UnbanPeer(pid peer.ID) bool {
+	return m.peers.Unban(pid)
+}
+
+func (m *mockPeerListManager) AddTrustedPeer(info peer.AddrInfo) error {
+	m.peers.AddTrusted(info.ID, info.Addrs[0])
+	return nil
+}
+
+func (m *mockPeerListManager) RemoveTrustedPeer(pid peer.ID) bool {
+	return m.peers.RemoveTrusted(pid)
+}
+
+func newServer(t *testing.T) (*Server, *mockPeerListManager) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	manager := &mockPeerListManager{peers: peersProvider.Peers()}
+	host := mockP2p.NewTestP2P(t).BHost
+	return &Server{
+		PeersFetcher:    peersProvider,
+		PeerManager:     &mockP2p.MockPeerManager{BHost: host, PID: host.ID()},
+		PeerListManager: manager,
+	}, manager
+}
+
+func TestServer_BanPeer(t *testing.T) {
+	s, manager := newServer(t)
+	pid := s.PeersFetcher.Peers().All()[0]
+
+	_, err := s.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: pid.String(), DurationSeconds: 60, Reason: "spam"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, manager.duration)
+	assert.Equal(t, true, s.PeersFetcher.Peers().IsBanned(pid))
+
+	res, err := s.ListManagedPeers(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Banned))
+	assert.Equal(t, pid.String(), res.Banned[0].PeerId)
+	assert.Equal(t, "spam", res.Banned[0].Reason)
+	assert.Equal(t, true, res.Banned[0].Manual)
+	assert.NotEqual(t, uint64(0), res.Banned[0].Expiry)
+
+	_, err = s.UnbanPeer(context.Background(), &ethpb.PeerRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, false, s.PeersFetcher.Peers().IsBanned(pid))
+	_, err = s.UnbanPeer(context.Background(), &ethpb.PeerRequest{PeerId: pid.String()})
+	assert.ErrorContains(t, "Requested peer is not banned", err)
+}
+
+func TestServer_BanPeer_InvalidRequest(t *testing.T) {
+	s, _ := newServer(t)
+
+	_, err := s.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: "invalid"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+	_, err = s.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: s.PeerManager.PeerID().String()})
+	assert.ErrorContains(t, "Cannot ban the node itself", err)
+}
+
+func TestServer_TrustedPeer(t *testing.T) {
+	s, _ := newServer(t)
+	pid := s.PeersFetcher.Peers().All()[1]
+	address := "/ip4/213.202.254.180/tcp/13000/p2p/" + pid.String()
+
+	_, err := s.AddTrustedPeer(context.Background(), &pbrpc.TrustedPeerRequest{Address: address})
+	require.NoError(t, err)
+	assert.Equal(t, true, s.PeersFetcher.Peers().IsTrusted(pid))
+
+	res, err := s.ListManagedPeers(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Trusted))
+	assert.Equal(t, pid.String(), res.Trusted[0].PeerId)
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", res.Trusted[0].Address)
+
+	_, err = s.RemoveTrustedPeer(context.Background(), &ethpb.PeerRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, false, s.PeersFetcher.Peers().IsTrusted(pid))
+	_, err = s.RemoveTrustedPeer(context.Background(), &ethpb.PeerRequest{PeerId: pid.String()})
+	assert.ErrorContains(t, "Requested peer is not trusted", err)
+
+	_, err = s.AddTrustedPeer(context.Background(), &pbrpc.TrustedPeerRequest{Address: "/ip4/213.202.254.180/tcp/13000"})
+	assert.ErrorContains(t, "Unable to parse provided peer address", err)
+}
+
+func TestServer_DisconnectPeer(t *testing.T) {
+	s, _ := newServer(t)
+	pid := s.PeersFetcher.Peers().All()[0]
+
+	_, err := s.DisconnectPeer(context.Background(), &ethpb.PeerRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	_, err = s.DisconnectPeer(context.Background(), &ethpb.PeerRequest{PeerId: "invalid"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+}
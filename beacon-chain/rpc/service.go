@@ -4,10 +4,12 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -25,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apiaccess"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beaconv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
@@ -32,10 +35,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/nodev1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/peeradmin"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator/bodyprovider"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validatorv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
@@ -70,41 +77,49 @@ type Service struct {
 
 // Config options for the beacon node RPC server.
 type Config struct {
-	Host                    string
-	Port                    string
-	CertFlag                string
-	KeyFlag                 string
-	BeaconMonitoringHost    string
-	BeaconMonitoringPort    int
-	BeaconDB                db.HeadAccessDatabase
-	ChainInfoFetcher        blockchain.ChainInfoFetcher
-	HeadFetcher             blockchain.HeadFetcher
-	CanonicalFetcher        blockchain.CanonicalFetcher
-	ForkFetcher             blockchain.ForkFetcher
-	FinalizationFetcher     blockchain.FinalizationFetcher
-	AttestationReceiver     blockchain.AttestationReceiver
-	BlockReceiver           blockchain.BlockReceiver
-	POWChainService         powchain.Chain
-	ChainStartFetcher       powchain.ChainStartFetcher
-	GenesisTimeFetcher      blockchain.TimeFetcher
-	GenesisFetcher          blockchain.GenesisFetcher
-	EnableDebugRPCEndpoints bool
-	MockEth1Votes           bool
-	AttestationsPool        attestations.Pool
-	ExitPool                voluntaryexits.PoolManager
-	SlashingsPool           slashings.PoolManager
-	SyncService             chainSync.Checker
-	Broadcaster             p2p.Broadcaster
-	PeersFetcher            p2p.PeersProvider
-	PeerManager             p2p.PeerManager
-	MetadataProvider        p2p.MetadataProvider
-	DepositFetcher          depositcache.DepositFetcher
-	PendingDepositFetcher   depositcache.PendingDepositsFetcher
-	StateNotifier           statefeed.Notifier
-	BlockNotifier           blockfeed.Notifier
-	OperationNotifier       opfeed.Notifier
-	StateGen                *stategen.State
-	MaxMsgSize              int
+	Host                     string
+	Port                     string
+	CertFlag                 string
+	KeyFlag                  string
+	BeaconMonitoringHost     string
+	BeaconMonitoringPort     int
+	BeaconDB                 db.HeadAccessDatabase
+	ChainInfoFetcher         blockchain.ChainInfoFetcher
+	HeadFetcher              blockchain.HeadFetcher
+	CanonicalFetcher         blockchain.CanonicalFetcher
+	ForkFetcher              blockchain.ForkFetcher
+	FinalizationFetcher      blockchain.FinalizationFetcher
+	ForkChoiceTreeFetcher    blockchain.ForkChoiceTreeFetcher
+	AttestationReceiver      blockchain.AttestationReceiver
+	BlockReceiver            blockchain.BlockReceiver
+	POWChainService          powchain.Chain
+	ChainStartFetcher        powchain.ChainStartFetcher
+	GenesisTimeFetcher       blockchain.TimeFetcher
+	GenesisFetcher           blockchain.GenesisFetcher
+	EnableDebugRPCEndpoints  bool
+	EnablePeerAdminEndpoints bool
+	MockEth1Votes            bool
+	AttestationsPool         attestations.Pool
+	ExitPool                 voluntaryexits.PoolManager
+	SlashingsPool            slashings.PoolManager
+	SyncService              chainSync.Checker
+	BackfillStatusFetcher    backfill.StatusFetcher
+	LivenessCache            *cache.LivenessCache
+	Broadcaster              p2p.Broadcaster
+	PeersFetcher             p2p.PeersProvider
+	PeerManager              p2p.PeerManager
+	PeerListManager          p2p.PeerListManager
+	MetadataProvider         p2p.MetadataProvider
+	DepositFetcher           depositcache.DepositFetcher
+	PendingDepositFetcher    depositcache.PendingDepositsFetcher
+	StateNotifier            statefeed.Notifier
+	BlockNotifier            blockfeed.Notifier
+	OperationNotifier        opfeed.Notifier
+	StateGen                 *stategen.State
+	MaxMsgSize               int
+	AccessController         *apiaccess.Controller
+	BlockBodyProvider        bodyprovider.Provider
+	BlockBodyProviderTimeout time.Duration
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -131,29 +146,35 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.WithField("address", address).Info("gRPC server listening on port")
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_opentracing.StreamServerInterceptor(),
+		s.validatorStreamConnectionInterceptor,
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(
+			recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+		),
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_opentracing.UnaryServerInterceptor(),
+		s.validatorUnaryConnectionInterceptor,
+	}
+	if s.cfg.AccessController != nil {
+		streamInterceptors = append(streamInterceptors, s.cfg.AccessController.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, s.cfg.AccessController.UnaryServerInterceptor())
+	}
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.StreamServerInterceptor,
-			grpc_opentracing.StreamServerInterceptor(),
-			s.validatorStreamConnectionInterceptor,
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(
-				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
-			),
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_opentracing.UnaryServerInterceptor(),
-			s.validatorUnaryConnectionInterceptor,
-		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryInterceptors...)),
 		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	if s.cfg.CertFlag != "" && s.cfg.KeyFlag != "" {
-		creds, err := credentials.NewServerTLSFromFile(s.cfg.CertFlag, s.cfg.KeyFlag)
+		creds, err := s.serverCredentials()
 		if err != nil {
 			log.WithError(err).Fatal("Could not load TLS keys")
 		}
@@ -162,35 +183,50 @@ func (s *Service) Start() {
 		log.Warn("You are using an insecure gRPC server. If you are running your beacon node and " +
 			"validator on the same machines, you can ignore this message. If you want to know " +
 			"how to enable secure connections, see: https://docs.prylabs.network/docs/prysm-usage/secure-grpc")
+		if s.cfg.AccessController != nil && s.cfg.AccessController.ClientCAs() != nil {
+			log.Warn("API clients cannot be identified by their certificates without a TLS certificate and key")
+		}
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
 	validatorServer := &validator.Server{
-		Ctx:                    s.ctx,
-		BeaconDB:               s.cfg.BeaconDB,
-		AttestationCache:       cache.NewAttestationCache(),
-		AttPool:                s.cfg.AttestationsPool,
-		ExitPool:               s.cfg.ExitPool,
-		HeadFetcher:            s.cfg.HeadFetcher,
-		ForkFetcher:            s.cfg.ForkFetcher,
-		FinalizationFetcher:    s.cfg.FinalizationFetcher,
-		TimeFetcher:            s.cfg.GenesisTimeFetcher,
-		CanonicalStateChan:     s.canonicalStateChan,
-		BlockFetcher:           s.cfg.POWChainService,
-		DepositFetcher:         s.cfg.DepositFetcher,
-		ChainStartFetcher:      s.cfg.ChainStartFetcher,
-		Eth1InfoFetcher:        s.cfg.POWChainService,
-		SyncChecker:            s.cfg.SyncService,
-		StateNotifier:          s.cfg.StateNotifier,
-		BlockNotifier:          s.cfg.BlockNotifier,
-		OperationNotifier:      s.cfg.OperationNotifier,
-		P2P:                    s.cfg.Broadcaster,
-		BlockReceiver:          s.cfg.BlockReceiver,
-		MockEth1Votes:          s.cfg.MockEth1Votes,
-		Eth1BlockFetcher:       s.cfg.POWChainService,
-		PendingDepositsFetcher: s.cfg.PendingDepositFetcher,
-		SlashingsPool:          s.cfg.SlashingsPool,
-		StateGen:               s.cfg.StateGen,
+		Ctx:                      s.ctx,
+		BeaconDB:                 s.cfg.BeaconDB,
+		AttestationCache:         cache.NewAttestationCache(),
+		AttPool:                  s.cfg.AttestationsPool,
+		ExitPool:                 s.cfg.ExitPool,
+		HeadFetcher:              s.cfg.HeadFetcher,
+		ForkFetcher:              s.cfg.ForkFetcher,
+		FinalizationFetcher:      s.cfg.FinalizationFetcher,
+		TimeFetcher:              s.cfg.GenesisTimeFetcher,
+		CanonicalStateChan:       s.canonicalStateChan,
+		BlockFetcher:             s.cfg.POWChainService,
+		DepositFetcher:           s.cfg.DepositFetcher,
+		ChainStartFetcher:        s.cfg.ChainStartFetcher,
+		Eth1InfoFetcher:          s.cfg.POWChainService,
+		SyncChecker:              s.cfg.SyncService,
+		StateNotifier:            s.cfg.StateNotifier,
+		BlockNotifier:            s.cfg.BlockNotifier,
+		OperationNotifier:        s.cfg.OperationNotifier,
+		P2P:                      s.cfg.Broadcaster,
+		BlockReceiver:            s.cfg.BlockReceiver,
+		MockEth1Votes:            s.cfg.MockEth1Votes,
+		Eth1BlockFetcher:         s.cfg.POWChainService,
+		PendingDepositsFetcher:   s.cfg.PendingDepositFetcher,
+		SlashingsPool:            s.cfg.SlashingsPool,
+		StateGen:                 s.cfg.StateGen,
+		LivenessCache:            s.cfg.LivenessCache,
+		BlockBodyProvider:        s.cfg.BlockBodyProvider,
+		BlockBodyProviderTimeout: s.cfg.BlockBodyProviderTimeout,
+	}
+	validatorServerV1 := &validatorv1.Server{
+		BeaconDB:         s.cfg.BeaconDB,
+		HeadFetcher:      s.cfg.HeadFetcher,
+		TimeFetcher:      s.cfg.GenesisTimeFetcher,
+		SyncChecker:      s.cfg.SyncService,
+		AttestationsPool: s.cfg.AttestationsPool,
+		V1Alpha1Server:   validatorServer,
+		LivenessCache:    s.cfg.LivenessCache,
 	}
 	nodeServer := &node.Server{
 		LogsStreamer:         logutil.NewStreamServer(),
@@ -198,6 +234,7 @@ func (s *Service) Start() {
 		BeaconDB:             s.cfg.BeaconDB,
 		Server:               s.grpcServer,
 		SyncChecker:          s.cfg.SyncService,
+		BackfillFetcher:      s.cfg.BackfillStatusFetcher,
 		GenesisTimeFetcher:   s.cfg.GenesisTimeFetcher,
 		PeersFetcher:         s.cfg.PeersFetcher,
 		PeerManager:          s.cfg.PeerManager,
@@ -255,6 +292,7 @@ func (s *Service) Start() {
 			StateGenService:    s.cfg.StateGen,
 		},
 		VoluntaryExitsPool: s.cfg.ExitPool,
+		RewardsCache:       cache.NewRewardsCache(),
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
@@ -270,12 +308,13 @@ func (s *Service) Start() {
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debug.Server{
-			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			BeaconDB:           s.cfg.BeaconDB,
-			StateGen:           s.cfg.StateGen,
-			HeadFetcher:        s.cfg.HeadFetcher,
-			PeerManager:        s.cfg.PeerManager,
-			PeersFetcher:       s.cfg.PeersFetcher,
+			GenesisTimeFetcher:    s.cfg.GenesisTimeFetcher,
+			BeaconDB:              s.cfg.BeaconDB,
+			StateGen:              s.cfg.StateGen,
+			HeadFetcher:           s.cfg.HeadFetcher,
+			ForkChoiceTreeFetcher: s.cfg.ForkChoiceTreeFetcher,
+			PeerManager:           s.cfg.PeerManager,
+			PeersFetcher:          s.cfg.PeersFetcher,
 		}
 		debugServerV1 := &debugv1.Server{
 			BeaconDB:    s.cfg.BeaconDB,
@@ -290,7 +329,16 @@ func (s *Service) Start() {
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 		ethpbv1.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
 	}
+	if s.cfg.EnablePeerAdminEndpoints {
+		log.Info("Enabled peer admin gRPC endpoints")
+		pbrpc.RegisterPeerAdminServer(s.grpcServer, &peeradmin.Server{
+			PeersFetcher:    s.cfg.PeersFetcher,
+			PeerManager:     s.cfg.PeerManager,
+			PeerListManager: s.cfg.PeerListManager,
+		})
+	}
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	ethpbv1.RegisterBeaconValidatorServer(s.grpcServer, validatorServerV1)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
@@ -304,6 +352,24 @@ func (s *Service) Start() {
 	}()
 }
 
+// serverCredentials returns the TLS credentials of the server. Clients may present certificates signed by the
+// client certificate authorities of the access controller, which identify them.
+func (s *Service) serverCredentials() (credentials.TransportCredentials, error) {
+	if s.cfg.AccessController == nil || s.cfg.AccessController.ClientCAs() == nil {
+		return credentials.NewServerTLSFromFile(s.cfg.CertFlag, s.cfg.KeyFlag)
+	}
+	cert, err := tls.LoadX509KeyPair(s.cfg.CertFlag, s.cfg.KeyFlag)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    s.cfg.AccessController.ClientCAs(),
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
 // Stop the service.
 func (s *Service) Stop() error {
 	s.cancel()
@@ -311,6 +377,9 @@ func (s *Service) Stop() error {
 		s.grpcServer.GracefulStop()
 		log.Debug("Initiated graceful stop of gRPC server")
 	}
+	if s.cfg.AccessController != nil {
+		s.cfg.AccessController.Close()
+	}
 	return nil
 }
 
@@ -0,0 +1,120 @@
+package bodyprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxResponseSize bounds the size of the responses read from the HTTP service. Larger responses are truncated,
+// and fail to be decoded.
+const maxResponseSize = 10 << 20
+
+// HTTPProvider asks an HTTP service for the contents of the proposed blocks. The locally produced block is
+// posted to the service, encoded in JSON as the BeaconBlock protobuf message:
+//
+//	{"block": {"slot": "1", "proposer_index": "12", "parent_root": "...", "body": {...}}}
+//
+// The service responds with either a body replacing the local one, or extra operations encoded as a
+// BeaconBlockBody message with only operations:
+//
+//	{"body": {...}}
+//	{"extra_operations": {"attestations": [...], "voluntary_exits": [...]}}
+//
+// A 204 No Content response means that the service has nothing to provide.
+type HTTPProvider struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPProvider creates a provider asking the HTTP service at the endpoint URL.
+func NewHTTPProvider(endpoint string) *HTTPProvider {
+	return &HTTPProvider{
+		endpoint: endpoint,
+		client:   &http.Client{},
+	}
+}
+
+var protoMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+var protoUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+type httpRequest struct {
+	Block json.RawMessage `json:"block"`
+}
+
+type httpResponse struct {
+	Body            json.RawMessage `json:"body"`
+	ExtraOperations json.RawMessage `json:"extra_operations"`
+}
+
+// BlockBody posts the locally produced block to the HTTP service, and decodes its response.
+func (p *HTTPProvider) BlockBody(ctx context.Context, req *Request) (*Response, error) {
+	block, err := protoMarshaler.Marshal(req.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	reqBody, err := json.Marshal(&httpRequest{Block: block})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request")
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send request")
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if httpResp.StatusCode == http.StatusNoContent {
+		return &Response{}, nil
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected response status %s", httpResp.Status)
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response")
+	}
+
+	resp := &httpResponse{}
+	if err := json.Unmarshal(content, resp); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal response")
+	}
+	if len(resp.Body) > 0 && len(resp.ExtraOperations) > 0 {
+		return nil, errors.New("response has both a body and extra operations")
+	}
+	if len(resp.Body) > 0 {
+		body := &ethpb.BeaconBlockBody{}
+		if err := protoUnmarshaler.Unmarshal(resp.Body, body); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal body")
+		}
+		return &Response{Body: body}, nil
+	}
+	if len(resp.ExtraOperations) > 0 {
+		ops := &ethpb.BeaconBlockBody{}
+		if err := protoUnmarshaler.Unmarshal(resp.ExtraOperations, ops); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal extra operations")
+		}
+		return &Response{ExtraOperations: &Operations{
+			Attestations:      ops.Attestations,
+			ProposerSlashings: ops.ProposerSlashings,
+			AttesterSlashings: ops.AttesterSlashings,
+			VoluntaryExits:    ops.VoluntaryExits,
+		}}, nil
+	}
+	return &Response{}, nil
+}
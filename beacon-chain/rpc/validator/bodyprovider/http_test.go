@@ -0,0 +1,108 @@
+package bodyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHTTPProvider_BlockBody(t *testing.T) {
+	block := testutil.NewBeaconBlock().Block
+	block.Slot = 12
+	block.Body.Graffiti = []byte("local")
+	att := testutil.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 11}})
+
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		want     *Response
+		err      string
+	}{
+		{
+			name:     "body",
+			status:   http.StatusOK,
+			response: `{"body": {"graffiti": "ZXh0ZXJuYWw="}}`,
+			want:     &Response{Body: &ethpb.BeaconBlockBody{Graffiti: []byte("external")}},
+		},
+		{
+			name:     "extra operations",
+			status:   http.StatusOK,
+			response: `{"extra_operations": {"attestations": [` + marshal(t, att) + `]}}`,
+			want:     &Response{ExtraOperations: &Operations{Attestations: []*ethpb.Attestation{att}}},
+		},
+		{
+			name:   "no content",
+			status: http.StatusNoContent,
+			want:   &Response{},
+		},
+		{
+			name:     "body and extra operations",
+			status:   http.StatusOK,
+			response: `{"body": {}, "extra_operations": {}}`,
+			err:      "response has both a body and extra operations",
+		},
+		{
+			name:   "error status",
+			status: http.StatusInternalServerError,
+			err:    "unexpected response status 500 Internal Server Error",
+		},
+		{
+			name:     "invalid body",
+			status:   http.StatusOK,
+			response: `{"body": {"graffiti": 1}}`,
+			err:      "could not unmarshal body",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				req := &httpRequest{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+				received := &ethpb.BeaconBlock{}
+				require.NoError(t, protoUnmarshaler.Unmarshal(req.Block, received))
+				assert.Equal(t, true, proto.Equal(block, received), "Unexpected block %v", received)
+
+				w.WriteHeader(tt.status)
+				_, err := w.Write([]byte(tt.response))
+				require.NoError(t, err)
+			}))
+			defer srv.Close()
+
+			resp, err := NewHTTPProvider(srv.URL).BlockBody(context.Background(), &Request{Block: block})
+			if tt.err != "" {
+				assert.ErrorContains(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assertResponse(t, tt.want, resp)
+		})
+	}
+}
+
+func assertResponse(t *testing.T, want, got *Response) {
+	assert.Equal(t, true, proto.Equal(want.Body, got.Body), "Unexpected body %v", got.Body)
+	require.Equal(t, want.ExtraOperations == nil, got.ExtraOperations == nil)
+	if want.ExtraOperations == nil {
+		return
+	}
+	require.Equal(t, len(want.ExtraOperations.Attestations), len(got.ExtraOperations.Attestations))
+	for i, att := range want.ExtraOperations.Attestations {
+		assert.Equal(t, true, proto.Equal(att, got.ExtraOperations.Attestations[i]), "Unexpected attestation %v", got.ExtraOperations.Attestations[i])
+	}
+}
+
+func marshal(t *testing.T, att *ethpb.Attestation) string {
+	enc, err := protoMarshaler.Marshal(att)
+	require.NoError(t, err)
+	return string(enc)
+}
@@ -0,0 +1,5 @@
+package bodyprovider
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "bodyprovider")
@@ -0,0 +1,40 @@
+// Package bodyprovider defines the providers of the contents of the blocks proposed by the beacon node from
+// outside of the node, such as an external block production service, and implements one over HTTP.
+package bodyprovider
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// Provider provides the contents of the blocks proposed by the beacon node. The beacon node validates the
+// contents against its state, and falls back to the locally produced block if they are invalid.
+type Provider interface {
+	// BlockBody returns either a body replacing the body of the locally produced block, or operations to add
+	// to it.
+	BlockBody(ctx context.Context, req *Request) (*Response, error)
+}
+
+// Request for the contents of a proposed block.
+type Request struct {
+	// Block is the block produced locally from the operation pools, without its state root.
+	Block *ethpb.BeaconBlock
+}
+
+// Response of a provider, with either a body or extra operations.
+type Response struct {
+	// Body replaces the body of the locally produced block. Its RANDAO reveal and graffiti are always
+	// replaced by the ones of the proposer.
+	Body *ethpb.BeaconBlockBody
+	// ExtraOperations are added to the operations of the locally produced block.
+	ExtraOperations *Operations
+}
+
+// Operations which may be added to a block.
+type Operations struct {
+	Attestations      []*ethpb.Attestation
+	ProposerSlashings []*ethpb.ProposerSlashing
+	AttesterSlashings []*ethpb.AttesterSlashing
+	VoluntaryExits    []*ethpb.SignedVoluntaryExit
+}
@@ -0,0 +1,134 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckDoppelGanger checks whether the requested validators were seen attesting or proposing in the requested epoch.
+// Attestations are taken from the attestation pool and from the attestations processed into the head state,
+// and proposals from the blocks saved in the database. Validators observed on gossip are taken from the
+// liveness cache.
+func (vs *Server) CheckDoppelGanger(ctx context.Context, req *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelGanger")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch || req.Epoch+1 < currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested epoch %d must be the current epoch %d or the previous one",
+			req.Epoch,
+			currentEpoch,
+		)
+	}
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	live, err := vs.liveValidators(ctx, headState, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not determine live validators: %v", err)
+	}
+
+	resp := &ethpb.DoppelGangerResponse{
+		Responses: make([]*ethpb.DoppelGangerResponse_ValidatorResponse, len(req.PublicKeys)),
+	}
+	for i, pubKey := range req.PublicKeys {
+		idx, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		seen := live[idx] || (vs.LivenessCache != nil && vs.LivenessCache.IsLive(req.Epoch, idx))
+		resp.Responses[i] = &ethpb.DoppelGangerResponse_ValidatorResponse{
+			PublicKey:       pubKey,
+			DuplicateExists: ok && seen,
+		}
+	}
+	return resp, nil
+}
+
+// liveValidators returns the indices of the validators which attested to a target in the epoch,
+// or proposed a block in it.
+func (vs *Server) liveValidators(ctx context.Context, headState iface.ReadOnlyBeaconState, epoch types.Epoch) (map[types.ValidatorIndex]bool, error) {
+	live := make(map[types.ValidatorIndex]bool)
+
+	unaggregated, err := vs.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	atts := append(unaggregated, vs.AttPool.AggregatedAttestations()...)
+	atts = append(atts, vs.AttPool.BlockAttestations()...)
+	atts = append(atts, vs.AttPool.ForkchoiceAttestations()...)
+	for _, att := range atts {
+		if err := markAttesters(headState, epoch, att, live); err != nil {
+			return nil, err
+		}
+	}
+
+	processed, err := headState.CurrentEpochAttestations()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current epoch attestations")
+	}
+	previous, err := headState.PreviousEpochAttestations()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get previous epoch attestations")
+	}
+	for _, pending := range append(processed, previous...) {
+		att := &ethpb.Attestation{AggregationBits: pending.AggregationBits, Data: pending.Data}
+		if err := markAttesters(headState, epoch, att, live); err != nil {
+			return nil, err
+		}
+	}
+
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	endSlot, err := helpers.EndSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	blks, _, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get blocks")
+	}
+	for _, blk := range blks {
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		live[blk.Block().ProposerIndex()] = true
+	}
+	return live, nil
+}
+
+// markAttesters marks the attesters of an attestation targeting the epoch as live.
+func markAttesters(headState iface.ReadOnlyBeaconState, epoch types.Epoch, att *ethpb.Attestation, live map[types.ValidatorIndex]bool) error {
+	if att == nil || att.Data == nil || att.Data.Target == nil || att.Data.Target.Epoch != epoch {
+		return nil
+	}
+	committee, err := helpers.BeaconCommitteeFromState(headState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestation committee")
+	}
+	indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return errors.Wrap(err, "could not get attesting indices")
+	}
+	for _, idx := range indices {
+		live[types.ValidatorIndex(idx)] = true
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// doppelGangerServer returns a server whose clock is in the second slot of epoch 1.
+func doppelGangerServer(t *testing.T, beaconState iface.BeaconState) *Server {
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	require.NoError(t, beaconState.SetSlot(slot))
+	genesis := time.Now().Add(-time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	chainService := &mockChain.ChainService{State: beaconState, Genesis: genesis}
+	return &Server{
+		BeaconDB:    dbutil.SetupDB(t),
+		HeadFetcher: chainService,
+		TimeFetcher: chainService,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		AttPool:     attestations.NewPool(),
+	}
+}
+
+// firstAttester returns an attestation of the first member of the slot's first committee, and the member's index.
+func firstAttester(t *testing.T, beaconState iface.ReadOnlyBeaconState, slot types.Slot) (*ethpb.Attestation, types.ValidatorIndex) {
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, slot, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	att := testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:   slot,
+			Target: &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(slot)},
+		},
+	})
+	return att, committee[0]
+}
+
+func pubKeysOf(t *testing.T, beaconState iface.ReadOnlyBeaconState, indices ...types.ValidatorIndex) [][]byte {
+	keys := make([][]byte, len(indices))
+	for i, idx := range indices {
+		pk := beaconState.PubkeyAtIndex(idx)
+		keys[i] = pk[:]
+	}
+	return keys
+}
+
+func TestCheckDoppelGanger_PoolAttestation(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	server := doppelGangerServer(t, beaconState)
+	att, attester := firstAttester(t, beaconState, 1)
+	require.NoError(t, server.AttPool.SaveUnaggregatedAttestation(att))
+	other := (attester + 1) % 64
+
+	resp, err := server.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		Epoch:      0,
+		PublicKeys: pubKeysOf(t, beaconState, attester, other),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Responses))
+	assert.Equal(t, true, resp.Responses[0].DuplicateExists)
+	assert.Equal(t, false, resp.Responses[1].DuplicateExists)
+
+	// The attestation targets epoch 0, so nobody was live in epoch 1.
+	resp, err = server.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		Epoch:      1,
+		PublicKeys: pubKeysOf(t, beaconState, attester),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Responses[0].DuplicateExists)
+}
+
+func TestCheckDoppelGanger_ProcessedAttestation(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	server := doppelGangerServer(t, beaconState)
+	att, attester := firstAttester(t, beaconState, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, beaconState.AppendCurrentEpochAttestations(&pbp2p.PendingAttestation{
+		AggregationBits: att.AggregationBits,
+		Data:            att.Data,
+		InclusionDelay:  1,
+	}))
+
+	resp, err := server.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		Epoch:      1,
+		PublicKeys: pubKeysOf(t, beaconState, attester),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Responses[0].DuplicateExists)
+}
+
+func TestCheckDoppelGanger_Proposal(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	server := doppelGangerServer(t, beaconState)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	blk.Block.ProposerIndex = 7
+	require.NoError(t, server.BeaconDB.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(blk)))
+
+	resp, err := server.CheckDoppelGanger(ctx, &ethpb.DoppelGangerRequest{
+		Epoch:      1,
+		PublicKeys: pubKeysOf(t, beaconState, 7, 8),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Responses[0].DuplicateExists)
+	assert.Equal(t, false, resp.Responses[1].DuplicateExists)
+
+	resp, err = server.CheckDoppelGanger(ctx, &ethpb.DoppelGangerRequest{
+		Epoch:      0,
+		PublicKeys: pubKeysOf(t, beaconState, 7),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Responses[0].DuplicateExists)
+}
+
+func TestCheckDoppelGanger_LivenessCache(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	server := doppelGangerServer(t, beaconState)
+	server.LivenessCache = cache.NewLivenessCache()
+	server.LivenessCache.MarkLive(1, 9)
+
+	resp, err := server.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		Epoch:      1,
+		PublicKeys: pubKeysOf(t, beaconState, 9, 10),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Responses[0].DuplicateExists)
+	assert.Equal(t, false, resp.Responses[1].DuplicateExists)
+}
+
+func TestCheckDoppelGanger_UnknownKey(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	server := doppelGangerServer(t, beaconState)
+
+	unknown := make([]byte, 48)
+	unknown[0] = 1
+	resp, err := server.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		Epoch:      1,
+		PublicKeys: [][]byte{unknown},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Responses[0].DuplicateExists)
+}
+
+func TestCheckDoppelGanger_InvalidEpoch(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	server := doppelGangerServer(t, beaconState)
+
+	_, err := server.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{Epoch: 2})
+	assert.ErrorContains(t, "must be the current epoch 1 or the previous one", err)
+}
+
+func TestCheckDoppelGanger_Syncing(t *testing.T) {
+	server := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+
+	_, err := server.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
@@ -129,6 +129,11 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		},
 	}
 
+	// Propose the contents from the block body provider instead, if any and valid.
+	if externalBlk := vs.maybeExternalBlock(ctx, blk); externalBlk != nil {
+		return externalBlk, nil
+	}
+
 	// Compute state root with the newly constructed block.
 	stateRoot, err = vs.computeStateRoot(ctx, interfaces.WrappedPhase0SignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)}))
 	if err != nil {
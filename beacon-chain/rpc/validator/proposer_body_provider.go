@@ -0,0 +1,160 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator/bodyprovider"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/copyutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// Sources of the bodies of the blocks produced by the proposer.
+const (
+	bodySourceLocal              = "local"
+	bodySourceExternal           = "external"
+	bodySourceExternalOperations = "external_operations"
+	bodySourceFallbackTimeout    = "local_fallback_timeout"
+	bodySourceFallbackError      = "local_fallback_error"
+	bodySourceFallbackInvalid    = "local_fallback_invalid"
+)
+
+var blockBodySourceCount = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "proposer_block_body_source_total",
+		Help: "The number of blocks produced for proposers, by source of their body: local, external, local with " +
+			"external operations, or local after a timeout, an error or an invalid body of the block body provider.",
+	},
+	[]string{"source"},
+)
+
+// maybeExternalBlock asks the block body provider for the contents of a block produced locally from the
+// operation pools. It returns the block with these contents and its state root if they are valid, or nil
+// if the local block should be proposed instead.
+func (vs *Server) maybeExternalBlock(ctx context.Context, local *ethpb.BeaconBlock) *ethpb.BeaconBlock {
+	if vs.BlockBodyProvider == nil {
+		blockBodySourceCount.WithLabelValues(bodySourceLocal).Inc()
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.maybeExternalBlock")
+	defer span.End()
+
+	blk, source, err := vs.externalBlock(ctx, local)
+	blockBodySourceCount.WithLabelValues(source).Inc()
+	span.AddAttributes(trace.StringAttribute("source", source))
+	fields := logrus.Fields{
+		"slot":   local.Slot,
+		"source": source,
+	}
+	if err != nil {
+		log.WithFields(fields).WithError(err).Warn("Could not use the block body provider, proposing a local block")
+		return nil
+	}
+	if blk == nil {
+		log.WithFields(fields).Debug("Block body provider had no content, proposing a local block")
+		return nil
+	}
+	log.WithFields(fields).Info("Produced block with the block body provider")
+	return blk
+}
+
+// externalBlock returns the block with the contents from the block body provider, and the source of its
+// body. The source is a local fallback if an error is returned. No block is returned, with a local source,
+// if the provider has no content for the block.
+func (vs *Server) externalBlock(ctx context.Context, local *ethpb.BeaconBlock) (*ethpb.BeaconBlock, string, error) {
+	providerCtx, cancel := context.WithTimeout(ctx, vs.BlockBodyProviderTimeout)
+	defer cancel()
+	resp, err := vs.BlockBodyProvider.BlockBody(providerCtx, &bodyprovider.Request{Block: copyutil.CopyBeaconBlock(local)})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(providerCtx.Err(), context.DeadlineExceeded) {
+			return nil, bodySourceFallbackTimeout, errors.Wrapf(err, "block body provider timed out after %s", vs.BlockBodyProviderTimeout)
+		}
+		return nil, bodySourceFallbackError, errors.Wrap(err, "could not get block body from provider")
+	}
+
+	blk := copyutil.CopyBeaconBlock(local)
+	var source string
+	switch {
+	case resp.Body != nil:
+		// The RANDAO reveal is signed by the proposer, and the graffiti is chosen by the proposer.
+		blk.Body = resp.Body
+		blk.Body.RandaoReveal = local.Body.RandaoReveal
+		blk.Body.Graffiti = local.Body.Graffiti
+		source = bodySourceExternal
+	case resp.ExtraOperations != nil:
+		ops := resp.ExtraOperations
+		blk.Body.Attestations = append(blk.Body.Attestations, ops.Attestations...)
+		blk.Body.ProposerSlashings = append(blk.Body.ProposerSlashings, ops.ProposerSlashings...)
+		blk.Body.AttesterSlashings = append(blk.Body.AttesterSlashings, ops.AttesterSlashings...)
+		blk.Body.VoluntaryExits = append(blk.Body.VoluntaryExits, ops.VoluntaryExits...)
+		source = bodySourceExternalOperations
+	default:
+		return nil, bodySourceLocal, nil
+	}
+
+	stateRoot, err := vs.validateBlock(ctx, blk)
+	if err != nil {
+		return nil, bodySourceFallbackInvalid, errors.Wrap(err, "invalid block from block body provider")
+	}
+	blk.StateRoot = stateRoot
+	return blk, source, nil
+}
+
+// validateBlock processes an unsigned block on top of the state of its parent, verifying all the signatures
+// of its operations, and returns the resulting state root. The signatures of the block and of its RANDAO
+// reveal are not verified, as the former does not exist yet and the latter comes from the proposer.
+func (vs *Server) validateBlock(ctx context.Context, blk *ethpb.BeaconBlock) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.validateBlock")
+	defer span.End()
+
+	parentState, err := vs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(blk.ParentRoot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve parent state")
+	}
+	st := parentState.Copy()
+	if featureconfig.Get().EnableNextSlotStateCache {
+		st, err = state.ProcessSlotsUsingNextSlotCache(ctx, st, blk.ParentRoot, blk.Slot)
+	} else {
+		st, err = state.ProcessSlots(ctx, st, blk.Slot)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slots")
+	}
+
+	signed := interfaces.WrappedPhase0SignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: blk, Signature: make([]byte, 96)})
+	// Slashings and exits are verified with their signatures, unlike attestations.
+	st, err = state.ProcessBlockForStateRoot(ctx, st, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block")
+	}
+	if len(blk.Body.Attestations) > 0 {
+		set, err := blocks.AttestationSignatureSet(ctx, st, blk.Body.Attestations)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve attestation signature set")
+		}
+		valid, err := set.Verify()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not verify attestation signatures")
+		}
+		if !valid {
+			return nil, errors.New("invalid attestation signature")
+		}
+	}
+
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+	log.WithField("beaconStateRoot", fmt.Sprintf("%#x", root)).Debugf("Computed state root")
+	return root[:], nil
+}
@@ -0,0 +1,163 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator/bodyprovider"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+type mockBodyProvider struct {
+	resp *bodyprovider.Response
+	err  error
+	wait bool
+	req  *bodyprovider.Request
+}
+
+func (p *mockBodyProvider) BlockBody(ctx context.Context, req *bodyprovider.Request) (*bodyprovider.Response, error) {
+	p.req = req
+	if p.wait {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return p.resp, p.err
+}
+
+func TestProposer_GetBlock_BlockBodyProvider(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(genesis)))
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot))
+
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	graffiti := bytesutil.ToBytes32([]byte("eth2"))
+	req := &ethpb.BlockRequest{Slot: 1, RandaoReveal: randaoReveal, Graffiti: graffiti[:]}
+
+	slashing, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[5], 5)
+	require.NoError(t, err)
+	invalidSlashing, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[6], 6)
+	require.NoError(t, err)
+	invalidSlashing.Header_1.Signature = invalidSlashing.Header_2.Signature
+
+	tests := []struct {
+		name              string
+		provider          *mockBodyProvider
+		proposerSlashings []*ethpb.ProposerSlashing
+		source            string
+	}{
+		{
+			name: "extra operations",
+			provider: &mockBodyProvider{resp: &bodyprovider.Response{
+				ExtraOperations: &bodyprovider.Operations{ProposerSlashings: []*ethpb.ProposerSlashing{slashing}},
+			}},
+			proposerSlashings: []*ethpb.ProposerSlashing{slashing},
+			source:            bodySourceExternalOperations,
+		},
+		{
+			name: "body",
+			provider: &mockBodyProvider{resp: &bodyprovider.Response{Body: &ethpb.BeaconBlockBody{
+				RandaoReveal:      make([]byte, 96),
+				Eth1Data:          beaconState.Eth1Data(),
+				Graffiti:          make([]byte, 32),
+				ProposerSlashings: []*ethpb.ProposerSlashing{slashing},
+			}}},
+			proposerSlashings: []*ethpb.ProposerSlashing{slashing},
+			source:            bodySourceExternal,
+		},
+		{
+			name: "invalid operations",
+			provider: &mockBodyProvider{resp: &bodyprovider.Response{
+				ExtraOperations: &bodyprovider.Operations{ProposerSlashings: []*ethpb.ProposerSlashing{invalidSlashing}},
+			}},
+			source: bodySourceFallbackInvalid,
+		},
+		{
+			name:     "no content",
+			provider: &mockBodyProvider{resp: &bodyprovider.Response{}},
+			source:   bodySourceLocal,
+		},
+		{
+			name:     "error",
+			provider: &mockBodyProvider{err: errors.New("unavailable")},
+			source:   bodySourceFallbackError,
+		},
+		{
+			name:     "timeout",
+			provider: &mockBodyProvider{wait: true},
+			source:   bodySourceFallbackTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			proposerServer := &Server{
+				BeaconDB:                 db,
+				HeadFetcher:              &mock.ChainService{State: beaconState.Copy(), Root: parentRoot[:]},
+				SyncChecker:              &mockSync.Sync{IsSyncing: false},
+				BlockReceiver:            &mock.ChainService{},
+				ChainStartFetcher:        &mockPOW.POWChain{},
+				Eth1InfoFetcher:          &mockPOW.POWChain{},
+				Eth1BlockFetcher:         &mockPOW.POWChain{},
+				MockEth1Votes:            true,
+				AttPool:                  attestations.NewPool(),
+				SlashingsPool:            slashings.NewPool(),
+				ExitPool:                 voluntaryexits.NewPool(),
+				StateGen:                 stategen.New(db),
+				BlockBodyProvider:        tt.provider,
+				BlockBodyProviderTimeout: 100 * time.Millisecond,
+			}
+			before := promtestutil.ToFloat64(blockBodySourceCount.WithLabelValues(tt.source))
+
+			block, err := proposerServer.GetBlock(ctx, req)
+			require.NoError(t, err)
+			require.NotNil(t, tt.provider.req)
+			assert.Equal(t, req.Slot, tt.provider.req.Block.Slot)
+			assert.DeepEqual(t, randaoReveal, block.Body.RandaoReveal)
+			assert.DeepEqual(t, req.Graffiti, block.Body.Graffiti)
+			assert.Equal(t, len(tt.proposerSlashings), len(block.Body.ProposerSlashings))
+			if len(tt.proposerSlashings) > 0 {
+				assert.DeepEqual(t, tt.proposerSlashings, block.Body.ProposerSlashings)
+			}
+			assert.Equal(t, before+1, promtestutil.ToFloat64(blockBodySourceCount.WithLabelValues(tt.source)))
+			if tt.source == bodySourceLocal {
+				assert.LogsDoNotContain(t, hook, "Could not use the block body provider")
+			}
+
+			// The state root of the proposed block is the one of the state transition of the block.
+			wanted, err := proposerServer.computeStateRoot(ctx, interfaces.WrappedPhase0SignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: block, Signature: make([]byte, 96)}))
+			require.NoError(t, err)
+			assert.DeepEqual(t, wanted, block.StateRoot)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator/bodyprovider"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -63,6 +64,10 @@ type Server struct {
 	PendingDepositsFetcher depositcache.PendingDepositsFetcher
 	OperationNotifier      opfeed.Notifier
 	StateGen               *stategen.State
+	LivenessCache          *cache.LivenessCache
+	// BlockBodyProvider provides the contents of the proposed blocks from outside of the node, if not nil.
+	BlockBodyProvider        bodyprovider.Provider
+	BlockBodyProviderTimeout time.Duration
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -0,0 +1,53 @@
+package validatorv1
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLiveness requests the beacon node to indicate whether the requested validators were observed
+// attesting or proposing on the network in the requested epoch.
+func (vs *Server) GetLiveness(ctx context.Context, req *ethpb.LivenessRequest) (*ethpb.LivenessResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetLiveness")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be greater than current epoch %d", req.Epoch, currentEpoch)
+	}
+	if req.Epoch+cache.LivenessEpochs <= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Request epoch %d is too old, only the last %d epochs up to current epoch %d are tracked",
+			req.Epoch,
+			cache.LivenessEpochs,
+			currentEpoch,
+		)
+	}
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	liveness := make([]*ethpb.ValidatorLiveness, len(req.Index))
+	for i, index := range req.Index {
+		if uint64(index) >= uint64(headState.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid index %d", index)
+		}
+		liveness[i] = &ethpb.ValidatorLiveness{
+			Index:  index,
+			IsLive: vs.LivenessCache.IsLive(req.Epoch, index),
+		}
+	}
+	return &ethpb.LivenessResponse{Data: liveness}, nil
+}
@@ -0,0 +1,71 @@
+package validatorv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetLiveness(t *testing.T) {
+	ctx := context.Background()
+	bs, _ := testutil.DeterministicGenesisState(t, 64)
+	// The chain is in epoch 5.
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	genesis := time.Now().Add(-time.Duration(5*secondsPerEpoch) * time.Second)
+	chain := &mockChain.ChainService{State: bs, Genesis: genesis}
+	livenessCache := cache.NewLivenessCache()
+	livenessCache.MarkLive(5, 1)
+	livenessCache.MarkLive(4, 2, 3)
+	vs := &Server{
+		HeadFetcher:   chain,
+		TimeFetcher:   chain,
+		SyncChecker:   &mockSync.Sync{IsSyncing: false},
+		LivenessCache: livenessCache,
+	}
+
+	t.Run("Current epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 5, Index: []types.ValidatorIndex{1, 2}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, types.ValidatorIndex(1), resp.Data[0].Index)
+		assert.Equal(t, true, resp.Data[0].IsLive)
+		assert.Equal(t, types.ValidatorIndex(2), resp.Data[1].Index)
+		assert.Equal(t, false, resp.Data[1].IsLive)
+	})
+	t.Run("Previous epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 4, Index: []types.ValidatorIndex{1, 2, 3}})
+		require.NoError(t, err)
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, false, resp.Data[0].IsLive)
+		assert.Equal(t, true, resp.Data[1].IsLive)
+		assert.Equal(t, true, resp.Data[2].IsLive)
+	})
+	t.Run("Future epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 6, Index: []types.ValidatorIndex{1}})
+		assert.ErrorContains(t, "can not be greater than current epoch", err)
+	})
+	t.Run("Untracked epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 5 - cache.LivenessEpochs, Index: []types.ValidatorIndex{1}})
+		assert.ErrorContains(t, "is too old", err)
+	})
+	t.Run("Invalid index", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 5, Index: []types.ValidatorIndex{64}})
+		assert.ErrorContains(t, "Invalid index 64", err)
+	})
+}
+
+func TestGetLiveness_Syncing(t *testing.T) {
+	vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+	_, err := vs.GetLiveness(context.Background(), &ethpb.LivenessRequest{})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
@@ -0,0 +1,25 @@
+// Package validatorv1 defines a gRPC validator service implementation,
+// following the official API standards https://ethereum.github.io/eth2.0-APIs/#/.
+// This package includes the validator duty, block production and aggregation endpoints.
+package validatorv1
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+)
+
+// Server defines a server implementation of the gRPC Validator service,
+// providing RPC endpoints intended for validator clients.
+type Server struct {
+	BeaconDB         db.ReadOnlyDatabase
+	HeadFetcher      blockchain.HeadFetcher
+	TimeFetcher      blockchain.TimeFetcher
+	SyncChecker      sync.Checker
+	AttestationsPool attestations.Pool
+	V1Alpha1Server   *validator.Server
+	LivenessCache    *cache.LivenessCache
+}
@@ -0,0 +1,329 @@
+package validatorv1
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetAttesterDuties requests the beacon node to provide a set of attestation duties,
+// which should be performed by validators, for a particular epoch.
+func (vs *Server) GetAttesterDuties(ctx context.Context, req *ethpb.AttesterDutiesRequest) (*ethpb.AttesterDutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetAttesterDuties")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch+1 {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be greater than next epoch %d", req.Epoch, currentEpoch+1)
+	}
+
+	s, err := vs.stateAtEpochStart(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	committeeAssignments, _, err := helpers.CommitteeAssignments(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	activeValidatorCount, err := helpers.ActiveValidatorCount(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	committeesAtSlot := helpers.SlotCommitteeCount(activeValidatorCount)
+
+	duties := make([]*ethpb.AttesterDuty, 0, len(req.Index))
+	for _, index := range req.Index {
+		if uint64(index) >= uint64(s.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid index %d", index)
+		}
+		committee, ok := committeeAssignments[index]
+		if !ok {
+			// The validator is not active in the requested epoch.
+			continue
+		}
+		var valIndexInCommittee types.CommitteeIndex
+		for i, vIndex := range committee.Committee {
+			if vIndex == index {
+				valIndexInCommittee = types.CommitteeIndex(uint64(i))
+				break
+			}
+		}
+		pubkey := s.PubkeyAtIndex(index)
+		duties = append(duties, &ethpb.AttesterDuty{
+			Pubkey:                  pubkey[:],
+			ValidatorIndex:          index,
+			CommitteeIndex:          committee.CommitteeIndex,
+			CommitteeLength:         uint64(len(committee.Committee)),
+			CommitteesAtSlot:        committeesAtSlot,
+			ValidatorCommitteeIndex: valIndexInCommittee,
+			Slot:                    committee.AttesterSlot,
+		})
+	}
+
+	var root []byte
+	if req.Epoch <= 1 {
+		root, err = vs.genesisBlockRoot(ctx)
+	} else {
+		var startSlot types.Slot
+		startSlot, err = helpers.StartSlot(req.Epoch - 1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", req.Epoch-1, err)
+		}
+		root, err = helpers.BlockRootAtSlot(s, startSlot-1)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get dependent root: %v", err)
+	}
+
+	return &ethpb.AttesterDutiesResponse{
+		DependentRoot: root,
+		Data:          duties,
+	}, nil
+}
+
+// GetProposerDuties requests beacon node to provide all validators that are scheduled to propose a block in the given epoch.
+func (vs *Server) GetProposerDuties(ctx context.Context, req *ethpb.ProposerDutiesRequest) (*ethpb.ProposerDutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetProposerDuties")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
+	if req.Epoch != currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d must be equal to current epoch %d", req.Epoch, currentEpoch)
+	}
+
+	s, err := vs.stateAtEpochStart(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	_, proposals, err := helpers.CommitteeAssignments(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+
+	duties := make([]*ethpb.ProposerDuty, 0)
+	for index, slots := range proposals {
+		pubkey := s.PubkeyAtIndex(index)
+		for _, slot := range slots {
+			duties = append(duties, &ethpb.ProposerDuty{
+				Pubkey:         pubkey[:],
+				ValidatorIndex: index,
+				Slot:           slot,
+			})
+		}
+	}
+	sort.Slice(duties, func(i, j int) bool {
+		return duties[i].Slot < duties[j].Slot
+	})
+
+	var root []byte
+	if req.Epoch == 0 {
+		root, err = vs.genesisBlockRoot(ctx)
+	} else {
+		var startSlot types.Slot
+		startSlot, err = helpers.StartSlot(req.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", req.Epoch, err)
+		}
+		root, err = helpers.BlockRootAtSlot(s, startSlot-1)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get dependent root: %v", err)
+	}
+
+	return &ethpb.ProposerDutiesResponse{
+		DependentRoot: root,
+		Data:          duties,
+	}, nil
+}
+
+// GetBlock requests the beacon node to produce a valid unsigned beacon block, which can then be signed by a proposer and submitted.
+func (vs *Server) GetBlock(ctx context.Context, req *ethpb.ProposerBlockRequest) (*ethpb.ProposerBlockResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetBlock")
+	defer span.End()
+
+	v1alpha1Req := &ethpb_alpha.BlockRequest{
+		Slot:         req.Slot,
+		RandaoReveal: req.RandaoReveal,
+		Graffiti:     req.Graffiti,
+	}
+	v1alpha1Block, err := vs.V1Alpha1Server.GetBlock(ctx, v1alpha1Req)
+	if err != nil {
+		// Errors returned by the v1alpha1 server are already gRPC status errors.
+		return nil, err
+	}
+	block, err := migration.V1Alpha1ToV1Block(&ethpb_alpha.SignedBeaconBlock{Block: v1alpha1Block})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert block: %v", err)
+	}
+	return &ethpb.ProposerBlockResponse{Data: block.Block}, nil
+}
+
+// GetAttestationData requests that the beacon node produces attestation data for
+// the requested committee index and slot based on the nodes current head.
+func (vs *Server) GetAttestationData(ctx context.Context, req *ethpb.AttestationDataRequest) (*ethpb.AttestationDataResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetAttestationData")
+	defer span.End()
+
+	v1alpha1Req := &ethpb_alpha.AttestationDataRequest{
+		Slot:           req.Slot,
+		CommitteeIndex: req.CommitteeIndex,
+	}
+	v1alpha1Data, err := vs.V1Alpha1Server.GetAttestationData(ctx, v1alpha1Req)
+	if err != nil {
+		// Errors returned by the v1alpha1 server are already gRPC status errors.
+		return nil, err
+	}
+	return &ethpb.AttestationDataResponse{Data: migration.V1Alpha1AttDataToV1(v1alpha1Data)}, nil
+}
+
+// GetAggregateAttestation aggregates all attestations matching the given attestation data root and slot, returning the aggregated result.
+func (vs *Server) GetAggregateAttestation(ctx context.Context, req *ethpb.AggregateAttestationRequest) (*ethpb.AttestationResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.GetAggregateAttestation")
+	defer span.End()
+
+	if len(req.AttestationDataRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Attestation data root must be 32 bytes, got %d", len(req.AttestationDataRoot))
+	}
+
+	if err := vs.AttestationsPool.AggregateUnaggregatedAttestations(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not aggregate unaggregated attestations: %v", err)
+	}
+	allAtts := vs.AttestationsPool.AggregatedAttestations()
+	unaggregatedAtts, err := vs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	allAtts = append(allAtts, unaggregatedAtts...)
+
+	var bestMatchingAtt *ethpb_alpha.Attestation
+	for _, att := range allAtts {
+		if att.Data.Slot != req.Slot {
+			continue
+		}
+		root, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get attestation data root: %v", err)
+		}
+		if !bytes.Equal(root[:], req.AttestationDataRoot) {
+			continue
+		}
+		if bestMatchingAtt == nil || att.AggregationBits.Count() > bestMatchingAtt.AggregationBits.Count() {
+			bestMatchingAtt = att
+		}
+	}
+	if bestMatchingAtt == nil {
+		return nil, status.Error(codes.NotFound, "No matching attestation found")
+	}
+	return &ethpb.AttestationResponse{Data: migration.V1Alpha1AttestationToV1(bestMatchingAtt)}, nil
+}
+
+// SubmitAggregateAndProofs verifies given aggregate and proofs and publishes them on appropriate gossipsub topic.
+func (vs *Server) SubmitAggregateAndProofs(ctx context.Context, req *ethpb.AggregateAndProofsSubmit) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitAggregateAndProofs")
+	defer span.End()
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No aggregate and proofs provided")
+	}
+	for _, agg := range req.Data {
+		if agg == nil || agg.Message == nil || agg.Message.Aggregate == nil || agg.Message.Aggregate.Data == nil {
+			return nil, status.Error(codes.InvalidArgument, "Signed aggregate request can't be nil")
+		}
+	}
+
+	for _, agg := range req.Data {
+		v1alpha1Req := &ethpb_alpha.SignedAggregateSubmitRequest{
+			SignedAggregateAndProof: migration.V1SignedAggregateAttAndProofToV1Alpha1(agg),
+		}
+		if _, err := vs.V1Alpha1Server.SubmitSignedAggregateSelectionProof(ctx, v1alpha1Req); err != nil {
+			// Errors returned by the v1alpha1 server are already gRPC status errors.
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SubmitBeaconCommitteeSubscription searches using discv5 for peers related to the provided subnet information
+// and replaces current peers with those ones if necessary.
+func (vs *Server) SubmitBeaconCommitteeSubscription(ctx context.Context, req *ethpb.BeaconCommitteeSubscribeSubmit) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "validatorv1.SubmitBeaconCommitteeSubscription")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No subscriptions provided")
+	}
+
+	v1alpha1Req := &ethpb_alpha.CommitteeSubnetsSubscribeRequest{
+		Slots:        make([]types.Slot, len(req.Data)),
+		CommitteeIds: make([]types.CommitteeIndex, len(req.Data)),
+		IsAggregator: make([]bool, len(req.Data)),
+	}
+	for i, sub := range req.Data {
+		v1alpha1Req.Slots[i] = sub.Slot
+		v1alpha1Req.CommitteeIds[i] = sub.CommitteeIndex
+		v1alpha1Req.IsAggregator[i] = sub.IsAggregator
+	}
+	if _, err := vs.V1Alpha1Server.SubscribeCommitteeSubnets(ctx, v1alpha1Req); err != nil {
+		// Errors returned by the v1alpha1 server are already gRPC status errors.
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// stateAtEpochStart returns the head state advanced with empty transitions up to the start slot of the epoch.
+func (vs *Server) stateAtEpochStart(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	epochStartSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	if s.Slot() < epochStartSlot {
+		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+		}
+	}
+	return s, nil
+}
+
+// genesisBlockRoot returns the root of the genesis block, which is the dependent root of the earliest epochs.
+func (vs *Server) genesisBlockRoot(ctx context.Context) ([]byte, error) {
+	genesisBlock, err := vs.BeaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if genesisBlock == nil || genesisBlock.IsNil() {
+		return nil, status.Error(codes.Internal, "Genesis block not found")
+	}
+	root, err := genesisBlock.Block().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return root[:], nil
+}
@@ -0,0 +1,189 @@
+package validatorv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func saveGenesisBlock(t *testing.T, ctx context.Context, beaconDB db.Database) [32]byte {
+	genBlk := testutil.NewBeaconBlock()
+	root, err := genBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(genBlk)))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+	return root
+}
+
+func TestGetAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	genesisRoot := saveGenesisBlock(t, ctx, beaconDB)
+	bs, _ := testutil.DeterministicGenesisState(t, 64)
+
+	chain := &mockChain.ChainService{State: bs, Root: genesisRoot[:], Genesis: time.Now()}
+	vs := &Server{
+		BeaconDB:    beaconDB,
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	t.Run("Single validator", func(t *testing.T) {
+		resp, err := vs.GetAttesterDuties(ctx, &ethpb.AttesterDutiesRequest{
+			Epoch: 0,
+			Index: []types.ValidatorIndex{0},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.DependentRoot)
+		require.Equal(t, 1, len(resp.Data))
+		duty := resp.Data[0]
+		assert.Equal(t, types.ValidatorIndex(0), duty.ValidatorIndex)
+		pubkey := bs.PubkeyAtIndex(0)
+		assert.DeepEqual(t, pubkey[:], duty.Pubkey)
+		assert.Equal(t, true, duty.Slot < params.BeaconConfig().SlotsPerEpoch)
+		assert.Equal(t, uint64(1), duty.CommitteesAtSlot)
+		assert.Equal(t, true, uint64(duty.ValidatorCommitteeIndex) < duty.CommitteeLength)
+	})
+	t.Run("Next epoch", func(t *testing.T) {
+		resp, err := vs.GetAttesterDuties(ctx, &ethpb.AttesterDutiesRequest{
+			Epoch: 1,
+			Index: []types.ValidatorIndex{0, 1, 2},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.DependentRoot)
+		require.Equal(t, 3, len(resp.Data))
+		for _, duty := range resp.Data {
+			assert.Equal(t, true, duty.Slot >= params.BeaconConfig().SlotsPerEpoch)
+		}
+	})
+	t.Run("Epoch out of bound", func(t *testing.T) {
+		_, err := vs.GetAttesterDuties(ctx, &ethpb.AttesterDutiesRequest{
+			Epoch: 2,
+			Index: []types.ValidatorIndex{0},
+		})
+		assert.ErrorContains(t, "can not be greater than next epoch", err)
+	})
+	t.Run("Validator index out of bound", func(t *testing.T) {
+		_, err := vs.GetAttesterDuties(ctx, &ethpb.AttesterDutiesRequest{
+			Epoch: 0,
+			Index: []types.ValidatorIndex{64},
+		})
+		assert.ErrorContains(t, "Invalid index", err)
+	})
+}
+
+func TestGetAttesterDuties_SyncNotReady(t *testing.T) {
+	vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+	_, err := vs.GetAttesterDuties(context.Background(), &ethpb.AttesterDutiesRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
+
+func TestGetProposerDuties(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	genesisRoot := saveGenesisBlock(t, ctx, beaconDB)
+	bs, _ := testutil.DeterministicGenesisState(t, 64)
+
+	chain := &mockChain.ChainService{State: bs, Root: genesisRoot[:], Genesis: time.Now()}
+	vs := &Server{
+		BeaconDB:    beaconDB,
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	t.Run("Current epoch", func(t *testing.T) {
+		resp, err := vs.GetProposerDuties(ctx, &ethpb.ProposerDutiesRequest{Epoch: 0})
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], resp.DependentRoot)
+		// The genesis slot has no proposer.
+		require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch)-1, len(resp.Data))
+		for i, duty := range resp.Data {
+			assert.Equal(t, types.Slot(i+1), duty.Slot)
+			pubkey := bs.PubkeyAtIndex(duty.ValidatorIndex)
+			assert.DeepEqual(t, pubkey[:], duty.Pubkey)
+		}
+	})
+	t.Run("Epoch other than current", func(t *testing.T) {
+		_, err := vs.GetProposerDuties(ctx, &ethpb.ProposerDutiesRequest{Epoch: 1})
+		assert.ErrorContains(t, "must be equal to current epoch", err)
+	})
+}
+
+func TestGetAggregateAttestation(t *testing.T) {
+	ctx := context.Background()
+	attData := testutil.HydrateAttestationData(&ethpb_alpha.AttestationData{Slot: 1})
+	otherData := testutil.HydrateAttestationData(&ethpb_alpha.AttestationData{Slot: 1, CommitteeIndex: 1})
+	att1 := &ethpb_alpha.Attestation{AggregationBits: bitfield.Bitlist{0b10011}, Data: attData, Signature: make([]byte, 96)}
+	att2 := &ethpb_alpha.Attestation{AggregationBits: bitfield.Bitlist{0b10111}, Data: attData, Signature: make([]byte, 96)}
+	att3 := &ethpb_alpha.Attestation{AggregationBits: bitfield.Bitlist{0b11111}, Data: otherData, Signature: make([]byte, 96)}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestations([]*ethpb_alpha.Attestation{att1, att2, att3}))
+	vs := &Server{AttestationsPool: pool}
+
+	root, err := attData.HashTreeRoot()
+	require.NoError(t, err)
+
+	t.Run("Most aggregation bits", func(t *testing.T) {
+		resp, err := vs.GetAggregateAttestation(ctx, &ethpb.AggregateAttestationRequest{
+			AttestationDataRoot: root[:],
+			Slot:                1,
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, att2.AggregationBits, resp.Data.AggregationBits)
+		v1Root, err := resp.Data.Data.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, root, v1Root)
+	})
+	t.Run("No matching attestation", func(t *testing.T) {
+		_, err := vs.GetAggregateAttestation(ctx, &ethpb.AggregateAttestationRequest{
+			AttestationDataRoot: root[:],
+			Slot:                2,
+		})
+		assert.ErrorContains(t, "No matching attestation found", err)
+	})
+	t.Run("Invalid root length", func(t *testing.T) {
+		_, err := vs.GetAggregateAttestation(ctx, &ethpb.AggregateAttestationRequest{
+			AttestationDataRoot: []byte{1},
+			Slot:                1,
+		})
+		assert.ErrorContains(t, "Attestation data root must be 32 bytes", err)
+	})
+}
+
+func TestSubmitAggregateAndProofs_InvalidRequest(t *testing.T) {
+	vs := &Server{}
+	_, err := vs.SubmitAggregateAndProofs(context.Background(), &ethpb.AggregateAndProofsSubmit{})
+	assert.ErrorContains(t, "No aggregate and proofs provided", err)
+
+	_, err = vs.SubmitAggregateAndProofs(context.Background(), &ethpb.AggregateAndProofsSubmit{
+		Data: []*ethpb.SignedAggregateAttestationAndProof{{Message: &ethpb.AggregateAttestationAndProof{}}},
+	})
+	assert.ErrorContains(t, "Signed aggregate request can't be nil", err)
+}
+
+func TestSubmitBeaconCommitteeSubscription_InvalidRequest(t *testing.T) {
+	vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+	_, err := vs.SubmitBeaconCommitteeSubscription(context.Background(), &ethpb.BeaconCommitteeSubscribeSubmit{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+
+	vs.SyncChecker = &mockSync.Sync{IsSyncing: false}
+	_, err = vs.SubmitBeaconCommitteeSubscription(context.Background(), &ethpb.BeaconCommitteeSubscribeSubmit{})
+	assert.ErrorContains(t, "No subscriptions provided", err)
+}
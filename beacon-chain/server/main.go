@@ -18,14 +18,15 @@ import (
 )
 
 var (
-	beaconRPC               = flag.String("beacon-rpc", "localhost:4000", "Beacon chain gRPC endpoint")
-	port                    = flag.Int("port", 8000, "Port to serve on")
-	apiMiddlewarePort       = flag.Int("port", 8001, "Port to serve API middleware on")
-	host                    = flag.String("host", "127.0.0.1", "Host to serve on")
-	debug                   = flag.Bool("debug", false, "Enable debug logging")
-	allowedOrigins          = flag.String("corsdomain", "localhost:4242", "A comma separated list of CORS domains to allow")
-	enableDebugRPCEndpoints = flag.Bool("enable-debug-rpc-endpoints", false, "Enable debug rpc endpoints such as /eth/v1alpha1/beacon/state")
-	grpcMaxMsgSize          = flag.Int("grpc-max-msg-size", 1<<22, "Integer to define max recieve message call size")
+	beaconRPC                = flag.String("beacon-rpc", "localhost:4000", "Beacon chain gRPC endpoint")
+	port                     = flag.Int("port", 8000, "Port to serve on")
+	apiMiddlewarePort        = flag.Int("port", 8001, "Port to serve API middleware on")
+	host                     = flag.String("host", "127.0.0.1", "Host to serve on")
+	debug                    = flag.Bool("debug", false, "Enable debug logging")
+	allowedOrigins           = flag.String("corsdomain", "localhost:4242", "A comma separated list of CORS domains to allow")
+	enableDebugRPCEndpoints  = flag.Bool("enable-debug-rpc-endpoints", false, "Enable debug rpc endpoints such as /eth/v1alpha1/beacon/state")
+	enablePeerAdminEndpoints = flag.Bool("enable-peer-admin-rpc-endpoints", false, "Enable peer admin rpc endpoints such as /eth/v1alpha1/admin/peers/ban")
+	grpcMaxMsgSize           = flag.Int("grpc-max-msg-size", 1<<22, "Integer to define max recieve message call size")
 )
 
 func init() {
@@ -38,7 +39,7 @@ func main() {
 		log.SetLevel(logrus.DebugLevel)
 	}
 
-	gatewayConfig := beaconGateway.DefaultConfig(*enableDebugRPCEndpoints)
+	gatewayConfig := beaconGateway.DefaultConfig(*enableDebugRPCEndpoints, *enablePeerAdminEndpoints)
 
 	gw := gateway.New(
 		context.Background(),
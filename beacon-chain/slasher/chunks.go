@@ -0,0 +1,321 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// Chunker defines a chunk of min or max spans of validators used for surround vote
+// detection. A span of a validator at epoch e is stored as the distance between e and
+// a target epoch:
+//
+//	min_span[e] = min(target - e) over attestations with source > e
+//	max_span[e] = max(target - e) over attestations with source < e
+//
+// An incoming attestation (source, target) surrounds a previous one of the validator
+// if min_span[source] < target - source, and is surrounded by a previous one if
+// max_span[source] > target - source.
+type Chunker interface {
+	Kind() slashertypes.ChunkKind
+	Chunk() []uint16
+	NeutralElement() uint16
+	CheckSlashable(
+		ctx context.Context,
+		slasherDB db.SlasherDatabase,
+		validatorIdx types.ValidatorIndex,
+		attestation *slashertypes.IndexedAttestationWrapper,
+	) (*ethpb.AttesterSlashing, error)
+	Update(
+		currentEpoch types.Epoch,
+		validatorIdx types.ValidatorIndex,
+		startEpoch, newTargetEpoch types.Epoch,
+	) (keepGoing bool, err error)
+	StartEpoch(sourceEpoch, currentEpoch types.Epoch) (epoch types.Epoch, exists bool)
+	NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch
+}
+
+// MinSpanChunksSlice is a chunk of min spans of validators.
+type MinSpanChunksSlice struct {
+	params *Parameters
+	data   []uint16
+}
+
+// MaxSpanChunksSlice is a chunk of max spans of validators.
+type MaxSpanChunksSlice struct {
+	params *Parameters
+	data   []uint16
+}
+
+// EmptyMinSpanChunksSlice returns a chunk of min spans filled with the neutral element.
+func EmptyMinSpanChunksSlice(params *Parameters) *MinSpanChunksSlice {
+	m := &MinSpanChunksSlice{params: params}
+	m.data = emptyChunk(params, m.NeutralElement())
+	return m
+}
+
+// EmptyMaxSpanChunksSlice returns a chunk of max spans filled with the neutral element.
+func EmptyMaxSpanChunksSlice(params *Parameters) *MaxSpanChunksSlice {
+	m := &MaxSpanChunksSlice{params: params}
+	m.data = emptyChunk(params, m.NeutralElement())
+	return m
+}
+
+// MinChunkSpansSliceFrom creates a chunk of min spans from a slice read from disk.
+func MinChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MinSpanChunksSlice, error) {
+	if err := validateChunkLength(params, chunk); err != nil {
+		return nil, err
+	}
+	return &MinSpanChunksSlice{params: params, data: chunk}, nil
+}
+
+// MaxChunkSpansSliceFrom creates a chunk of max spans from a slice read from disk.
+func MaxChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MaxSpanChunksSlice, error) {
+	if err := validateChunkLength(params, chunk); err != nil {
+		return nil, err
+	}
+	return &MaxSpanChunksSlice{params: params, data: chunk}, nil
+}
+
+func emptyChunk(params *Parameters, neutral uint16) []uint16 {
+	data := make([]uint16, params.chunkSize*params.validatorChunkSize)
+	for i := range data {
+		data[i] = neutral
+	}
+	return data
+}
+
+func validateChunkLength(params *Parameters, chunk []uint16) error {
+	wanted := params.chunkSize * params.validatorChunkSize
+	if uint64(len(chunk)) != wanted {
+		return fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), wanted)
+	}
+	return nil
+}
+
+// Kind of the chunk.
+func (m *MinSpanChunksSlice) Kind() slashertypes.ChunkKind {
+	return slashertypes.MinSpan
+}
+
+// Kind of the chunk.
+func (m *MaxSpanChunksSlice) Kind() slashertypes.ChunkKind {
+	return slashertypes.MaxSpan
+}
+
+// Chunk returns the underlying slice of the chunk.
+func (m *MinSpanChunksSlice) Chunk() []uint16 {
+	return m.data
+}
+
+// Chunk returns the underlying slice of the chunk.
+func (m *MaxSpanChunksSlice) Chunk() []uint16 {
+	return m.data
+}
+
+// NeutralElement of min spans, which never indicates a surround vote.
+func (m *MinSpanChunksSlice) NeutralElement() uint16 {
+	return math.MaxUint16
+}
+
+// NeutralElement of max spans, which never indicates a surround vote.
+func (m *MaxSpanChunksSlice) NeutralElement() uint16 {
+	return 0
+}
+
+// CheckSlashable checks whether an attestation surrounds a previous attestation of the validator.
+// If so, the previous attestation is read from the database and a slashing is returned.
+func (m *MinSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx types.ValidatorIndex,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	data := attestation.IndexedAttestation.Data
+	minTarget, err := targetAtEpoch(m.params, m.data, validatorIdx, data.Source.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if data.Target.Epoch <= minTarget {
+		return nil, nil
+	}
+	existing, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, minTarget)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || !surrounds(attestation, existing) {
+		return nil, nil
+	}
+	surroundingVotesTotal.Inc()
+	return &ethpb.AttesterSlashing{
+		Attestation_1: attestation.IndexedAttestation,
+		Attestation_2: existing.IndexedAttestation,
+	}, nil
+}
+
+// CheckSlashable checks whether an attestation is surrounded by a previous attestation of the
+// validator. If so, the previous attestation is read from the database and a slashing is returned.
+func (m *MaxSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx types.ValidatorIndex,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	data := attestation.IndexedAttestation.Data
+	maxTarget, err := targetAtEpoch(m.params, m.data, validatorIdx, data.Source.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if data.Target.Epoch >= maxTarget {
+		return nil, nil
+	}
+	existing, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, maxTarget)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || !surrounds(existing, attestation) {
+		return nil, nil
+	}
+	surroundedVotesTotal.Inc()
+	return &ethpb.AttesterSlashing{
+		Attestation_1: existing.IndexedAttestation,
+		Attestation_2: attestation.IndexedAttestation,
+	}, nil
+}
+
+// Update the min spans of a validator with the target of a new attestation, going
+// backwards from the start epoch to the first epoch of the chunk. Min spans only
+// grow towards the past, so the update stops at the first span which is not larger
+// than the new one. If the update reached the beginning of the chunk without
+// stopping, it has to continue in the previous chunk.
+func (m *MinSpanChunksSlice) Update(
+	currentEpoch types.Epoch,
+	validatorIdx types.ValidatorIndex,
+	startEpoch, newTargetEpoch types.Epoch,
+) (bool, error) {
+	minEpoch := m.params.minEpoch(currentEpoch)
+	firstEpoch := m.params.firstEpochInChunk(startEpoch)
+	for epoch := startEpoch; epoch >= minEpoch; epoch-- {
+		existingTarget, err := targetAtEpoch(m.params, m.data, validatorIdx, epoch)
+		if err != nil {
+			return false, err
+		}
+		if newTargetEpoch >= existingTarget {
+			return false, nil
+		}
+		if err := setTargetAtEpoch(m.params, m.data, validatorIdx, epoch, newTargetEpoch); err != nil {
+			return false, err
+		}
+		if epoch == firstEpoch {
+			return firstEpoch > minEpoch, nil
+		}
+	}
+	return false, nil
+}
+
+// Update the max spans of a validator with the target of a new attestation, going
+// forwards from the start epoch to the last epoch of the chunk. Max spans only
+// grow towards the future, so the update stops at the first span which is not smaller
+// than the new one. If the update reached the end of the chunk without stopping,
+// it has to continue in the next chunk.
+func (m *MaxSpanChunksSlice) Update(
+	currentEpoch types.Epoch,
+	validatorIdx types.ValidatorIndex,
+	startEpoch, newTargetEpoch types.Epoch,
+) (bool, error) {
+	lastEpoch := m.params.lastEpochInChunk(startEpoch)
+	for epoch := startEpoch; epoch <= currentEpoch && epoch < newTargetEpoch; epoch++ {
+		existingTarget, err := targetAtEpoch(m.params, m.data, validatorIdx, epoch)
+		if err != nil {
+			return false, err
+		}
+		if newTargetEpoch <= existingTarget {
+			return false, nil
+		}
+		if err := setTargetAtEpoch(m.params, m.data, validatorIdx, epoch, newTargetEpoch); err != nil {
+			return false, err
+		}
+		if epoch == lastEpoch {
+			return lastEpoch < currentEpoch && lastEpoch+1 < newTargetEpoch, nil
+		}
+	}
+	return false, nil
+}
+
+// StartEpoch of a min span update, which is the epoch before the source of the attestation.
+// Attestations with a source of 0 can not surround any previous attestation.
+func (m *MinSpanChunksSlice) StartEpoch(sourceEpoch, currentEpoch types.Epoch) (types.Epoch, bool) {
+	if sourceEpoch == 0 || sourceEpoch-1 < m.params.minEpoch(currentEpoch) {
+		return 0, false
+	}
+	return sourceEpoch - 1, true
+}
+
+// StartEpoch of a max span update, which is the epoch after the source of the attestation.
+func (m *MaxSpanChunksSlice) StartEpoch(sourceEpoch, currentEpoch types.Epoch) (types.Epoch, bool) {
+	if sourceEpoch >= currentEpoch {
+		return 0, false
+	}
+	return sourceEpoch + 1, true
+}
+
+// NextChunkStartEpoch returns the last epoch of the previous chunk, where a min span update
+// continues.
+func (m *MinSpanChunksSlice) NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch {
+	return m.params.firstEpochInChunk(startEpoch) - 1
+}
+
+// NextChunkStartEpoch returns the first epoch of the next chunk, where a max span update
+// continues.
+func (m *MaxSpanChunksSlice) NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch {
+	return m.params.lastEpochInChunk(startEpoch) + 1
+}
+
+// surrounds returns true if the first attestation surrounds the second one.
+func surrounds(att1, att2 *slashertypes.IndexedAttestationWrapper) bool {
+	data1 := att1.IndexedAttestation.Data
+	data2 := att2.IndexedAttestation.Data
+	return data1.Source.Epoch < data2.Source.Epoch && data2.Target.Epoch < data1.Target.Epoch
+}
+
+// targetAtEpoch returns the target epoch stored as the span of a validator at an epoch.
+func targetAtEpoch(
+	params *Parameters, chunk []uint16, validatorIdx types.ValidatorIndex, epoch types.Epoch,
+) (types.Epoch, error) {
+	cell := params.cellIndex(validatorIdx, epoch)
+	if cell >= uint64(len(chunk)) {
+		return 0, fmt.Errorf("cell index %d out of bounds of chunk with length %d", cell, len(chunk))
+	}
+	return epoch + types.Epoch(chunk[cell]), nil
+}
+
+// setTargetAtEpoch stores a target epoch as the span of a validator at an epoch.
+func setTargetAtEpoch(
+	params *Parameters, chunk []uint16, validatorIdx types.ValidatorIndex, epoch, target types.Epoch,
+) error {
+	if target < epoch {
+		return fmt.Errorf("target epoch %d is before epoch %d", target, epoch)
+	}
+	distance := uint64(target - epoch)
+	if distance >= math.MaxUint16 {
+		return fmt.Errorf("distance between epoch %d and target epoch %d does not fit into a span", epoch, target)
+	}
+	return setSpanAtEpoch(params, chunk, validatorIdx, epoch, uint16(distance))
+}
+
+// setSpanAtEpoch stores the span of a validator at an epoch.
+func setSpanAtEpoch(
+	params *Parameters, chunk []uint16, validatorIdx types.ValidatorIndex, epoch types.Epoch, span uint16,
+) error {
+	cell := params.cellIndex(validatorIdx, epoch)
+	if cell >= uint64(len(chunk)) {
+		return fmt.Errorf("cell index %d out of bounds of chunk with length %d", cell, len(chunk))
+	}
+	chunk[cell] = span
+	return nil
+}
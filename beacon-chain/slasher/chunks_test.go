@@ -0,0 +1,124 @@
+package slasher
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestChunkSliceFrom_WrongLength(t *testing.T) {
+	params := DefaultParams()
+	_, err := MinChunkSpansSliceFrom(params, []uint16{})
+	assert.ErrorContains(t, "chunk has wrong length", err)
+	_, err = MaxChunkSpansSliceFrom(params, []uint16{})
+	assert.ErrorContains(t, "chunk has wrong length", err)
+}
+
+func TestMinSpanChunksSlice_Update(t *testing.T) {
+	params := &Parameters{chunkSize: 3, validatorChunkSize: 2, historyLength: 6}
+	chunk := EmptyMinSpanChunksSlice(params)
+	validatorIdx := types.ValidatorIndex(1)
+
+	// An attestation (4, 5) sets the min spans at epochs 3 and below within the chunk of epoch 3.
+	start, ok := chunk.StartEpoch(4, 5)
+	require.Equal(t, true, ok)
+	require.Equal(t, types.Epoch(3), start)
+	keepGoing, err := chunk.Update(5, validatorIdx, start, 5)
+	require.NoError(t, err)
+	// Epoch 3 is the first epoch of its chunk, and epochs 0 to 2 are still in the history.
+	assert.Equal(t, true, keepGoing)
+	assert.DeepEqual(t, []uint16{
+		math.MaxUint16, math.MaxUint16, math.MaxUint16,
+		2, math.MaxUint16, math.MaxUint16,
+	}, chunk.Chunk())
+	assert.Equal(t, types.Epoch(2), chunk.NextChunkStartEpoch(start))
+
+	// A larger target does not update the spans.
+	keepGoing, err = chunk.Update(5, validatorIdx, start, 6)
+	require.NoError(t, err)
+	assert.Equal(t, false, keepGoing)
+	target, err := targetAtEpoch(params, chunk.Chunk(), validatorIdx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(5), target)
+
+	// Attestations with a source epoch of 0 can not surround anything.
+	_, ok = chunk.StartEpoch(0, 5)
+	assert.Equal(t, false, ok)
+	// Epochs before the history are not updated.
+	_, ok = chunk.StartEpoch(1, 7)
+	assert.Equal(t, false, ok)
+}
+
+func TestMaxSpanChunksSlice_Update(t *testing.T) {
+	params := &Parameters{chunkSize: 3, validatorChunkSize: 2, historyLength: 6}
+	chunk := EmptyMaxSpanChunksSlice(params)
+	validatorIdx := types.ValidatorIndex(0)
+
+	// An attestation (0, 4) sets the max spans at epochs 1 to 3 until the end of the chunk.
+	start, ok := chunk.StartEpoch(0, 4)
+	require.Equal(t, true, ok)
+	require.Equal(t, types.Epoch(1), start)
+	keepGoing, err := chunk.Update(4, validatorIdx, start, 4)
+	require.NoError(t, err)
+	assert.Equal(t, true, keepGoing)
+	assert.DeepEqual(t, []uint16{0, 3, 2, 0, 0, 0}, chunk.Chunk())
+	assert.Equal(t, types.Epoch(3), chunk.NextChunkStartEpoch(start))
+
+	// The update does not go beyond the target epoch.
+	chunk = EmptyMaxSpanChunksSlice(params)
+	keepGoing, err = chunk.Update(4, validatorIdx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, false, keepGoing)
+	assert.DeepEqual(t, []uint16{0, 1, 0, 0, 0, 0}, chunk.Chunk())
+
+	// Attestations with a source at the current epoch do not update any span.
+	_, ok = chunk.StartEpoch(4, 4)
+	assert.Equal(t, false, ok)
+}
+
+func TestChunks_CheckSlashable(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	params := &Parameters{chunkSize: 4, validatorChunkSize: 1, historyLength: 8}
+	validatorIdx := types.ValidatorIndex(0)
+	existing := createAttestationWrapper(2, 3, []uint64{0}, []byte{1})
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(
+		ctx, []*slashertypes.IndexedAttestationWrapper{existing},
+	))
+
+	minChunk := EmptyMinSpanChunksSlice(params)
+	_, err := minChunk.Update(3, validatorIdx, 1, 3)
+	require.NoError(t, err)
+	// (1, 4) surrounds (2, 3).
+	surrounding := createAttestationWrapper(1, 4, []uint64{0}, []byte{2})
+	slashing, err := minChunk.CheckSlashable(ctx, slasherDB, validatorIdx, surrounding)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	assert.DeepEqual(t, surrounding.IndexedAttestation, slashing.Attestation_1)
+	assert.DeepEqual(t, existing.IndexedAttestation, slashing.Attestation_2)
+	// (1, 3) does not surround (2, 3).
+	slashing, err = minChunk.CheckSlashable(ctx, slasherDB, validatorIdx, createAttestationWrapper(1, 3, []uint64{0}, []byte{3}))
+	require.NoError(t, err)
+	assert.Equal(t, true, slashing == nil)
+
+	existing = createAttestationWrapper(0, 3, []uint64{0}, []byte{4})
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(
+		ctx, []*slashertypes.IndexedAttestationWrapper{existing},
+	))
+	maxChunk := EmptyMaxSpanChunksSlice(params)
+	_, err = maxChunk.Update(3, validatorIdx, 1, 3)
+	require.NoError(t, err)
+	// (1, 2) is surrounded by (0, 3).
+	surrounded := createAttestationWrapper(1, 2, []uint64{0}, []byte{5})
+	slashing, err = maxChunk.CheckSlashable(ctx, slasherDB, validatorIdx, surrounded)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	assert.DeepEqual(t, existing.IndexedAttestation, slashing.Attestation_1)
+	assert.DeepEqual(t, surrounded.IndexedAttestation, slashing.Attestation_2)
+}
@@ -0,0 +1,319 @@
+package slasher
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// checkSlashableAttestations detects double votes and surround votes of a batch of attestations,
+// records the attestations and updates the min and max spans of their attesters.
+func (s *Service) checkSlashableAttestations(
+	ctx context.Context, currentEpoch types.Epoch, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.checkSlashableAttestations")
+	defer span.End()
+	if len(atts) == 0 {
+		return nil, nil
+	}
+	slashings, err := s.checkDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check double votes")
+	}
+	// Attestations are recorded before the spans are updated, so that surround votes
+	// between attestations of the same batch can be resolved.
+	if err := s.cfg.Database.SaveAttestationRecordsForValidators(ctx, atts); err != nil {
+		return nil, errors.Wrap(err, "could not save attestation records")
+	}
+	groupedAtts := s.groupByValidatorChunkIndex(atts)
+	validatorChunkIndices := make([]uint64, 0, len(groupedAtts))
+	for validatorChunkIdx := range groupedAtts {
+		validatorChunkIndices = append(validatorChunkIndices, validatorChunkIdx)
+	}
+	sort.Slice(validatorChunkIndices, func(i, j int) bool {
+		return validatorChunkIndices[i] < validatorChunkIndices[j]
+	})
+	for _, validatorChunkIdx := range validatorChunkIndices {
+		surroundSlashings, err := s.detectSurroundVotes(ctx, currentEpoch, validatorChunkIdx, groupedAtts[validatorChunkIdx])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect surround votes in validator chunk %d", validatorChunkIdx)
+		}
+		slashings = append(slashings, surroundSlashings...)
+	}
+	return slashings, nil
+}
+
+// checkDoubleVotes detects attestations of the batch with the same target epoch as another
+// attestation of the batch or a recorded attestation, but with different data.
+func (s *Service) checkDoubleVotes(
+	ctx context.Context, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	type validatorTarget struct {
+		validatorIdx types.ValidatorIndex
+		target       types.Epoch
+	}
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	seen := make(map[validatorTarget]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range atts {
+		for _, idx := range att.IndexedAttestation.AttestingIndices {
+			key := validatorTarget{
+				validatorIdx: types.ValidatorIndex(idx),
+				target:       att.IndexedAttestation.Data.Target.Epoch,
+			}
+			existing, ok := seen[key]
+			if !ok {
+				seen[key] = att
+				continue
+			}
+			if existing.SigningRoot != att.SigningRoot {
+				doubleVotesTotal.Inc()
+				slashings = append(slashings, &ethpb.AttesterSlashing{
+					Attestation_1: existing.IndexedAttestation,
+					Attestation_2: att.IndexedAttestation,
+				})
+			}
+		}
+	}
+	doubleVotes, err := s.cfg.Database.CheckAttesterDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, err
+	}
+	for _, doubleVote := range doubleVotes {
+		doubleVotesTotal.Inc()
+		slashings = append(slashings, &ethpb.AttesterSlashing{
+			Attestation_1: doubleVote.PrevAttestationWrapper.IndexedAttestation,
+			Attestation_2: doubleVote.AttestationWrapper.IndexedAttestation,
+		})
+	}
+	return slashings, nil
+}
+
+// groupByValidatorChunkIndex groups attestations by the validator chunk indices of their attesters.
+// An attestation belongs to every validator chunk which holds one of its attesters.
+func (s *Service) groupByValidatorChunkIndex(
+	atts []*slashertypes.IndexedAttestationWrapper,
+) map[uint64][]*slashertypes.IndexedAttestationWrapper {
+	grouped := make(map[uint64][]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range atts {
+		added := make(map[uint64]bool)
+		for _, idx := range att.IndexedAttestation.AttestingIndices {
+			validatorChunkIdx := s.params.validatorChunkIndex(types.ValidatorIndex(idx))
+			if added[validatorChunkIdx] {
+				continue
+			}
+			added[validatorChunkIdx] = true
+			grouped[validatorChunkIdx] = append(grouped[validatorChunkIdx], att)
+		}
+	}
+	return grouped
+}
+
+// detectSurroundVotes checks the attestations of the validators in a validator chunk against their
+// min and max spans, updates the spans and saves the modified chunks.
+func (s *Service) detectSurroundVotes(
+	ctx context.Context,
+	currentEpoch types.Epoch,
+	validatorChunkIdx uint64,
+	atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.detectSurroundVotes")
+	defer span.End()
+	caches := []*chunkCache{
+		s.newChunkCache(slashertypes.MinSpan, validatorChunkIdx),
+		s.newChunkCache(slashertypes.MaxSpan, validatorChunkIdx),
+	}
+	validatorIndices := make([]types.ValidatorIndex, 0)
+	seen := make(map[types.ValidatorIndex]bool)
+	for _, att := range atts {
+		for _, idx := range att.IndexedAttestation.AttestingIndices {
+			validatorIdx := types.ValidatorIndex(idx)
+			if s.params.validatorChunkIndex(validatorIdx) != validatorChunkIdx || seen[validatorIdx] {
+				continue
+			}
+			seen[validatorIdx] = true
+			validatorIndices = append(validatorIndices, validatorIdx)
+		}
+	}
+	if err := s.epochUpdateForValidators(ctx, caches, currentEpoch, validatorIndices); err != nil {
+		return nil, err
+	}
+
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	for _, att := range atts {
+		for _, idx := range att.IndexedAttestation.AttestingIndices {
+			validatorIdx := types.ValidatorIndex(idx)
+			if s.params.validatorChunkIndex(validatorIdx) != validatorChunkIdx {
+				continue
+			}
+			for _, cache := range caches {
+				slashing, err := s.applyAttestationForValidator(ctx, cache, currentEpoch, validatorIdx, att)
+				if err != nil {
+					return nil, err
+				}
+				if slashing != nil {
+					slashings = append(slashings, slashing)
+				}
+			}
+		}
+	}
+
+	for _, cache := range caches {
+		if err := cache.save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.cfg.Database.SaveLastEpochWrittenForValidators(ctx, validatorIndices, currentEpoch); err != nil {
+		return nil, errors.Wrap(err, "could not save last epoch written for validators")
+	}
+	return slashings, nil
+}
+
+// epochUpdateForValidators resets the spans of validators at the epochs which passed since their
+// spans were last written. As epochs are mapped onto chunks modulo the history length, these spans
+// still hold values of epochs which left the history.
+func (s *Service) epochUpdateForValidators(
+	ctx context.Context, caches []*chunkCache, currentEpoch types.Epoch, validatorIndices []types.ValidatorIndex,
+) error {
+	attestedEpochs, err := s.cfg.Database.LastEpochWrittenForValidators(ctx, validatorIndices)
+	if err != nil {
+		return errors.Wrap(err, "could not get last epoch written for validators")
+	}
+	minEpoch := s.params.minEpoch(currentEpoch)
+	for _, attestedEpoch := range attestedEpochs {
+		if attestedEpoch.Epoch >= currentEpoch {
+			continue
+		}
+		startEpoch := attestedEpoch.Epoch + 1
+		if startEpoch < minEpoch {
+			startEpoch = minEpoch
+		}
+		for epoch := startEpoch; epoch <= currentEpoch; epoch++ {
+			for _, cache := range caches {
+				chunk, err := cache.chunk(ctx, s.params.chunkIndex(epoch))
+				if err != nil {
+					return err
+				}
+				if err := setSpanAtEpoch(s.params, chunk.Chunk(), attestedEpoch.ValidatorIndex, epoch, chunk.NeutralElement()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// applyAttestationForValidator checks an attestation of a validator against the spans of a chunk kind.
+// If the attestation is not slashable, the spans of the validator are updated with the attestation,
+// continuing over as many chunks as the update requires.
+func (s *Service) applyAttestationForValidator(
+	ctx context.Context,
+	cache *chunkCache,
+	currentEpoch types.Epoch,
+	validatorIdx types.ValidatorIndex,
+	att *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := att.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := att.IndexedAttestation.Data.Target.Epoch
+	chunk, err := cache.chunk(ctx, s.params.chunkIndex(sourceEpoch))
+	if err != nil {
+		return nil, err
+	}
+	slashing, err := chunk.CheckSlashable(ctx, s.cfg.Database, validatorIdx, att)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check validator %d for surround votes", validatorIdx)
+	}
+	if slashing != nil {
+		return slashing, nil
+	}
+	startEpoch, exists := chunk.StartEpoch(sourceEpoch, currentEpoch)
+	for exists {
+		chunk, err = cache.chunk(ctx, s.params.chunkIndex(startEpoch))
+		if err != nil {
+			return nil, err
+		}
+		keepGoing, err := chunk.Update(currentEpoch, validatorIdx, startEpoch, targetEpoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not update spans of validator %d", validatorIdx)
+		}
+		if !keepGoing {
+			break
+		}
+		startEpoch = chunk.NextChunkStartEpoch(startEpoch)
+	}
+	return nil, nil
+}
+
+// chunkCache holds the chunks of a kind and a validator chunk index which are read and
+// modified while a batch is processed.
+type chunkCache struct {
+	s                 *Service
+	kind              slashertypes.ChunkKind
+	validatorChunkIdx uint64
+	chunks            map[uint64]Chunker
+}
+
+func (s *Service) newChunkCache(kind slashertypes.ChunkKind, validatorChunkIdx uint64) *chunkCache {
+	return &chunkCache{
+		s:                 s,
+		kind:              kind,
+		validatorChunkIdx: validatorChunkIdx,
+		chunks:            make(map[uint64]Chunker),
+	}
+}
+
+// chunk returns the chunk with a chunk index, loading it from the database if it was not read yet.
+func (c *chunkCache) chunk(ctx context.Context, chunkIdx uint64) (Chunker, error) {
+	if chunk, ok := c.chunks[chunkIdx]; ok {
+		return chunk, nil
+	}
+	params := c.s.params
+	key := params.flatSliceID(c.validatorChunkIdx, chunkIdx)
+	data, exists, err := c.s.cfg.Database.LoadSlasherChunks(ctx, c.kind, [][]byte{key})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load chunk %d of validator chunk %d", chunkIdx, c.validatorChunkIdx)
+	}
+	var chunk Chunker
+	switch c.kind {
+	case slashertypes.MinSpan:
+		if exists[0] {
+			chunk, err = MinChunkSpansSliceFrom(params, data[0])
+		} else {
+			chunk = EmptyMinSpanChunksSlice(params)
+		}
+	case slashertypes.MaxSpan:
+		if exists[0] {
+			chunk, err = MaxChunkSpansSliceFrom(params, data[0])
+		} else {
+			chunk = EmptyMaxSpanChunksSlice(params)
+		}
+	default:
+		return nil, errors.Errorf("unknown chunk kind %d", c.kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.chunks[chunkIdx] = chunk
+	return chunk, nil
+}
+
+// save writes the chunks which were read to the database.
+func (c *chunkCache) save(ctx context.Context) error {
+	if len(c.chunks) == 0 {
+		return nil
+	}
+	keys := make([][]byte, 0, len(c.chunks))
+	chunks := make([][]uint16, 0, len(c.chunks))
+	for chunkIdx, chunk := range c.chunks {
+		keys = append(keys, c.s.params.flatSliceID(c.validatorChunkIdx, chunkIdx))
+		chunks = append(chunks, chunk.Chunk())
+	}
+	if err := c.s.cfg.Database.SaveSlasherChunks(ctx, c.kind, keys, chunks); err != nil {
+		return errors.Wrapf(err, "could not save chunks of validator chunk %d", c.validatorChunkIdx)
+	}
+	return nil
+}
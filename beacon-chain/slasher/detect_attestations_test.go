@@ -0,0 +1,161 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_CheckSlashableAttestations(t *testing.T) {
+	type batch struct {
+		currentEpoch types.Epoch
+		atts         []*slashertypes.IndexedAttestationWrapper
+		// Pairs of attestations expected to be slashable, in the order of the slashings.
+		want [][2]*slashertypes.IndexedAttestationWrapper
+	}
+	att := createAttestationWrapper
+	doubleVote1 := att(0, 1, []uint64{1, 2}, []byte{1})
+	doubleVote2 := att(0, 1, []uint64{2, 3}, []byte{2})
+	surrounding := att(1, 4, []uint64{2}, []byte{3})
+	surrounded := att(2, 3, []uint64{2}, []byte{4})
+	beforeWrap := att(1, 1, []uint64{2}, []byte{5})
+	afterWrap := att(9, 10, []uint64{2}, []byte{6})
+	surroundingAfterWrap := att(7, 11, []uint64{2}, []byte{7})
+	tests := []struct {
+		name    string
+		batches []batch
+	}{
+		{
+			name: "double vote in the same batch",
+			batches: []batch{
+				{currentEpoch: 1, atts: []*slashertypes.IndexedAttestationWrapper{doubleVote1, doubleVote2}, want: [][2]*slashertypes.IndexedAttestationWrapper{
+					{doubleVote1, doubleVote2},
+				}},
+			},
+		},
+		{
+			name: "double vote across batches",
+			batches: []batch{
+				{currentEpoch: 1, atts: []*slashertypes.IndexedAttestationWrapper{doubleVote1}},
+				{currentEpoch: 2, atts: []*slashertypes.IndexedAttestationWrapper{doubleVote2}, want: [][2]*slashertypes.IndexedAttestationWrapper{
+					{doubleVote1, doubleVote2},
+				}},
+			},
+		},
+		{
+			name: "same attestation twice is not slashable",
+			batches: []batch{
+				{currentEpoch: 1, atts: []*slashertypes.IndexedAttestationWrapper{doubleVote1, doubleVote1}},
+				{currentEpoch: 2, atts: []*slashertypes.IndexedAttestationWrapper{doubleVote1}},
+			},
+		},
+		{
+			name: "surrounding vote across batches",
+			batches: []batch{
+				{currentEpoch: 3, atts: []*slashertypes.IndexedAttestationWrapper{surrounded}},
+				{currentEpoch: 4, atts: []*slashertypes.IndexedAttestationWrapper{surrounding}, want: [][2]*slashertypes.IndexedAttestationWrapper{
+					{surrounding, surrounded},
+				}},
+			},
+		},
+		{
+			name: "surrounded vote across batches",
+			batches: []batch{
+				{currentEpoch: 4, atts: []*slashertypes.IndexedAttestationWrapper{surrounding}},
+				{currentEpoch: 5, atts: []*slashertypes.IndexedAttestationWrapper{surrounded}, want: [][2]*slashertypes.IndexedAttestationWrapper{
+					{surrounding, surrounded},
+				}},
+			},
+		},
+		{
+			name: "surround vote in the same batch",
+			batches: []batch{
+				{currentEpoch: 4, atts: []*slashertypes.IndexedAttestationWrapper{surrounded, surrounding}, want: [][2]*slashertypes.IndexedAttestationWrapper{
+					{surrounding, surrounded},
+				}},
+			},
+		},
+		{
+			name: "consecutive votes are not slashable",
+			batches: []batch{
+				{currentEpoch: 1, atts: []*slashertypes.IndexedAttestationWrapper{att(0, 1, []uint64{2}, []byte{1})}},
+				{currentEpoch: 2, atts: []*slashertypes.IndexedAttestationWrapper{att(1, 2, []uint64{2}, []byte{2})}},
+				{currentEpoch: 4, atts: []*slashertypes.IndexedAttestationWrapper{att(2, 4, []uint64{2}, []byte{3})}},
+			},
+		},
+		{
+			// The spans of epoch 0 are stored where the spans of epoch 8 are stored, and must not
+			// prevent the spans of epoch 8 from being updated once the history wrapped around.
+			name: "surround vote after the history wrapped around",
+			batches: []batch{
+				{currentEpoch: 1, atts: []*slashertypes.IndexedAttestationWrapper{beforeWrap}},
+				{currentEpoch: 10, atts: []*slashertypes.IndexedAttestationWrapper{afterWrap}},
+				{currentEpoch: 11, atts: []*slashertypes.IndexedAttestationWrapper{surroundingAfterWrap}, want: [][2]*slashertypes.IndexedAttestationWrapper{
+					{surroundingAfterWrap, afterWrap},
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := &Service{
+				params: &Parameters{chunkSize: 4, validatorChunkSize: 2, historyLength: 8},
+				cfg:    &Config{Database: dbtest.SetupSlasherDB(t)},
+			}
+			for _, b := range tt.batches {
+				slashings, err := s.checkSlashableAttestations(ctx, b.currentEpoch, b.atts)
+				require.NoError(t, err)
+				require.Equal(t, len(b.want), len(slashings), "Unexpected number of slashings at epoch %d", b.currentEpoch)
+				for i, pair := range b.want {
+					assert.DeepEqual(t, pair[0].IndexedAttestation, slashings[i].Attestation_1)
+					assert.DeepEqual(t, pair[1].IndexedAttestation, slashings[i].Attestation_2)
+				}
+			}
+		})
+	}
+}
+
+func TestService_GroupByValidatorChunkIndex(t *testing.T) {
+	s := &Service{params: &Parameters{chunkSize: 4, validatorChunkSize: 2, historyLength: 8}}
+	att1 := createAttestationWrapper(0, 1, []uint64{0, 1, 2}, []byte{1})
+	att2 := createAttestationWrapper(0, 1, []uint64{5}, []byte{2})
+	grouped := s.groupByValidatorChunkIndex([]*slashertypes.IndexedAttestationWrapper{att1, att2})
+	assert.DeepEqual(t, map[uint64][]*slashertypes.IndexedAttestationWrapper{
+		0: {att1},
+		1: {att1},
+		2: {att2},
+	}, grouped)
+}
+
+func createAttestationWrapper(
+	source, target types.Epoch, indices []uint64, signingRoot []byte,
+) *slashertypes.IndexedAttestationWrapper {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: bytesutil.PadTo(signingRoot, 32),
+		Source: &ethpb.Checkpoint{
+			Epoch: source,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+		Target: &ethpb.Checkpoint{
+			Epoch: target,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+	}
+	return &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
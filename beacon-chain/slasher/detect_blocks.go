@@ -0,0 +1,57 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// detectProposerSlashings detects block headers of the batch which were signed by a proposer for
+// the same slot as another header of the batch or a recorded header, but with a different root.
+// The first header of each proposer and slot is recorded.
+func (s *Service) detectProposerSlashings(
+	ctx context.Context, proposals []*slashertypes.SignedBlockHeaderWrapper,
+) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.detectProposerSlashings")
+	defer span.End()
+	if len(proposals) == 0 {
+		return nil, nil
+	}
+	type proposerSlot struct {
+		proposerIdx types.ValidatorIndex
+		slot        types.Slot
+	}
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	seen := make(map[proposerSlot]*slashertypes.SignedBlockHeaderWrapper)
+	firstProposals := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(proposals))
+	for _, proposal := range proposals {
+		header := proposal.SignedBeaconBlockHeader.Header
+		key := proposerSlot{proposerIdx: header.ProposerIndex, slot: header.Slot}
+		existing, ok := seen[key]
+		if !ok {
+			seen[key] = proposal
+			firstProposals = append(firstProposals, proposal)
+			continue
+		}
+		if existing.SigningRoot != proposal.SigningRoot {
+			slashings = append(slashings, &ethpb.ProposerSlashing{
+				Header_1: existing.SignedBeaconBlockHeader,
+				Header_2: proposal.SignedBeaconBlockHeader,
+			})
+		}
+	}
+	diskSlashings, err := s.cfg.Database.CheckDoubleBlockProposals(ctx, firstProposals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check double block proposals")
+	}
+	slashings = append(slashings, diskSlashings...)
+	if err := s.cfg.Database.SaveBlockProposals(ctx, firstProposals); err != nil {
+		return nil, errors.Wrap(err, "could not save block proposals")
+	}
+	doubleProposalsTotal.Add(float64(len(slashings)))
+	return slashings, nil
+}
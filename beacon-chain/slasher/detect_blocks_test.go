@@ -0,0 +1,57 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_DetectProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params: DefaultParams(),
+		cfg:    &Config{Database: dbtest.SetupSlasherDB(t)},
+	}
+	proposal1 := createProposalWrapper(1, 3, []byte{1})
+	proposal2 := createProposalWrapper(1, 3, []byte{2})
+	proposal3 := createProposalWrapper(1, 3, []byte{3})
+	otherProposer := createProposalWrapper(1, 4, []byte{4})
+
+	// The same proposal twice is not slashable.
+	slashings, err := s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{proposal1, proposal1, otherProposer})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(slashings))
+
+	// A double proposal is detected against the recorded proposal and within the batch.
+	slashings, err = s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{proposal2, proposal3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(slashings))
+	assert.DeepEqual(t, proposal2.SignedBeaconBlockHeader, slashings[0].Header_1)
+	assert.DeepEqual(t, proposal3.SignedBeaconBlockHeader, slashings[0].Header_2)
+	assert.DeepEqual(t, proposal1.SignedBeaconBlockHeader, slashings[1].Header_1)
+	assert.DeepEqual(t, proposal2.SignedBeaconBlockHeader, slashings[1].Header_2)
+}
+
+func createProposalWrapper(slot types.Slot, proposerIdx types.ValidatorIndex, signingRoot []byte) *slashertypes.SignedBlockHeaderWrapper {
+	return &slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposerIdx,
+				ParentRoot:    params.BeaconConfig().ZeroHash[:],
+				StateRoot:     params.BeaconConfig().ZeroHash[:],
+				BodyRoot:      bytesutil.PadTo(signingRoot, 32),
+			},
+			Signature: params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
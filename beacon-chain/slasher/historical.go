@@ -0,0 +1,209 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// historicalLogInterval is the number of epochs between two progress logs of a historical run.
+const historicalLogInterval = 256
+
+// HistoricalConfig options for the detection of slashable offenses in the history of the chain.
+type HistoricalConfig struct {
+	// BeaconDB is the database of a beacon node, which is only read from.
+	BeaconDB db.NoHeadAccessDatabase
+	// SlasherDB records the attestations, block headers and spans of the run.
+	SlasherDB db.SlasherDatabase
+	// ReportPath is the JSON file the detected slashings are written to.
+	ReportPath string
+}
+
+type historicalDetector struct {
+	cfg      *HistoricalConfig
+	slasher  *Service
+	stateGen *stategen.State
+	report   *historicalReport
+	// state has the canonical blocks of all epochs before the processed epoch applied.
+	state iface.BeaconState
+}
+
+// DetectHistoricalSlashings processes the finalized epochs of a beacon node database in order,
+// running the attestations and block headers of their blocks through slashing detection.
+// Attestations are converted into indexed attestations with states regenerated from the
+// database, and signatures are not verified, as blocks are only saved once they are valid.
+// The report is saved after every epoch, so that a run resumes from its last processed epoch
+// given the same report and slasher database.
+func DetectHistoricalSlashings(ctx context.Context, cfg *HistoricalConfig) error {
+	if cfg.BeaconDB == nil || cfg.SlasherDB == nil {
+		return errors.New("beacon node and slasher databases are required")
+	}
+	report, err := loadHistoricalReport(cfg.ReportPath)
+	if err != nil {
+		return err
+	}
+	finalized, err := cfg.BeaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	if report.NextEpoch >= finalized.Epoch {
+		log.WithField("finalizedEpoch", finalized.Epoch).Info("All finalized epochs have been processed")
+		return nil
+	}
+	h := &historicalDetector{
+		cfg: cfg,
+		slasher: &Service{
+			params: DefaultParams(),
+			cfg:    &Config{Database: cfg.SlasherDB},
+		},
+		stateGen: stategen.New(cfg.BeaconDB),
+		report:   report,
+	}
+	if err := h.loadState(ctx, report.NextEpoch); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"startEpoch":     report.NextEpoch,
+		"finalizedEpoch": finalized.Epoch,
+	}).Info("Detecting slashable offenses in finalized epochs")
+	for epoch := report.NextEpoch; epoch < finalized.Epoch; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := h.processEpoch(ctx, epoch); err != nil {
+			return errors.Wrapf(err, "could not process epoch %d", epoch)
+		}
+		report.NextEpoch = epoch + 1
+		if err := report.save(cfg.ReportPath); err != nil {
+			return err
+		}
+		if epoch%historicalLogInterval == 0 {
+			log.WithFields(logrus.Fields{
+				"epoch":             epoch,
+				"finalizedEpoch":    finalized.Epoch,
+				"attesterSlashings": len(report.AttesterSlashings),
+				"proposerSlashings": len(report.ProposerSlashings),
+			}).Info("Processed finalized epochs")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"attesterSlashings": len(report.AttesterSlashings),
+		"proposerSlashings": len(report.ProposerSlashings),
+		"report":            cfg.ReportPath,
+	}).Info("Processed all finalized epochs")
+	return nil
+}
+
+// loadState regenerates the state with the canonical blocks of all epochs before an epoch applied.
+func (h *historicalDetector) loadState(ctx context.Context, epoch types.Epoch) error {
+	var st iface.BeaconState
+	var err error
+	if epoch == 0 {
+		st, err = h.cfg.BeaconDB.GenesisState(ctx)
+	} else {
+		var startSlot types.Slot
+		startSlot, err = helpers.StartSlot(epoch)
+		if err != nil {
+			return err
+		}
+		st, err = h.stateGen.StateBySlot(ctx, startSlot-1)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not get state before epoch %d", epoch)
+	}
+	if st == nil || st.IsNil() {
+		return errors.Errorf("state before epoch %d is nil", epoch)
+	}
+	h.state = st.Copy()
+	return nil
+}
+
+// processEpoch detects slashable offenses in the blocks of an epoch and applies the canonical
+// ones to the state. Blocks of the epoch which did not become canonical are checked as well,
+// as double proposals can only be found among them.
+func (h *historicalDetector) processEpoch(ctx context.Context, epoch types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.historicalDetector.processEpoch")
+	defer span.End()
+	blks, roots, err := h.cfg.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(epoch).SetEndEpoch(epoch))
+	if err != nil {
+		return errors.Wrap(err, "could not get blocks")
+	}
+	atts := make([]*ethpb.Attestation, 0)
+	headers := make([]*ethpb.SignedBeaconBlockHeader, 0, len(blks))
+	// Canonical blocks are replayed in decreasing slot order.
+	canonical := make([]interfaces.SignedBeaconBlock, 0, len(blks))
+	for i, blk := range blks {
+		if blk == nil || blk.IsNil() || blk.Block().Slot() == 0 {
+			continue
+		}
+		pbBlk, err := blk.PbPhase0Block()
+		if err != nil {
+			return err
+		}
+		header, err := blockutil.SignedBeaconBlockHeaderFromBlock(pbBlk)
+		if err != nil {
+			return errors.Wrap(err, "could not get block header")
+		}
+		headers = append(headers, header)
+		atts = append(atts, pbBlk.Block.Body.Attestations...)
+		if h.cfg.BeaconDB.IsFinalizedBlock(ctx, roots[i]) {
+			canonical = append([]interfaces.SignedBeaconBlock{blk}, canonical...)
+		}
+	}
+
+	indexedAtts, _ := h.slasher.indexedAttestations(ctx, h.state, epoch, atts, false /* verify signatures */)
+	attSlashings, err := h.slasher.checkSlashableAttestations(ctx, epoch, indexedAtts)
+	if err != nil {
+		return errors.Wrap(err, "could not check attestations")
+	}
+	for _, slashing := range attSlashings {
+		added, err := h.report.addAttesterSlashing(slashing)
+		if err != nil {
+			return err
+		}
+		if added {
+			log.WithFields(attesterSlashingFields(slashing)).Info("Attester slashing detected")
+		}
+	}
+
+	proposals := h.slasher.blockHeaders(h.state, headers, false /* verify signatures */)
+	propSlashings, err := h.slasher.detectProposerSlashings(ctx, proposals)
+	if err != nil {
+		return errors.Wrap(err, "could not check block headers")
+	}
+	for _, slashing := range propSlashings {
+		added, err := h.report.addProposerSlashing(slashing)
+		if err != nil {
+			return err
+		}
+		if added {
+			log.WithFields(proposerSlashingFields(slashing)).Info("Proposer slashing detected")
+		}
+	}
+
+	lastSlot, err := helpers.EndSlot(epoch)
+	if err != nil {
+		return err
+	}
+	h.state, err = h.stateGen.ReplayBlocks(ctx, h.state, canonical, lastSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not replay blocks")
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":        epoch,
+		"blocks":       len(headers),
+		"attestations": len(indexedAtts),
+	}).Debug("Processed finalized epoch")
+	return nil
+}
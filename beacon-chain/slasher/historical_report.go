@@ -0,0 +1,119 @@
+package slasher
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// historicalReport of the slashings detected in the history of the chain. The report
+// doubles as the checkpoint of a run, as it records the next epoch to be processed.
+type historicalReport struct {
+	NextEpoch         types.Epoch       `json:"next_epoch"`
+	AttesterSlashings []json.RawMessage `json:"attester_slashings"`
+	ProposerSlashings []json.RawMessage `json:"proposer_slashings"`
+
+	seen map[[32]byte]bool
+}
+
+// loadHistoricalReport reads the report of a previous run from a file, or returns an
+// empty report if the file does not exist.
+func loadHistoricalReport(path string) (*historicalReport, error) {
+	report := &historicalReport{
+		AttesterSlashings: make([]json.RawMessage, 0),
+		ProposerSlashings: make([]json.RawMessage, 0),
+		seen:              make(map[[32]byte]bool),
+	}
+	if !fileutil.FileExists(path) {
+		return report, nil
+	}
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read report")
+	}
+	if err := json.Unmarshal(enc, report); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal report")
+	}
+	for _, raw := range report.AttesterSlashings {
+		slashing := &ethpb.AttesterSlashing{}
+		if err := protojson.Unmarshal(raw, slashing); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal attester slashing")
+		}
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		report.seen[root] = true
+	}
+	for _, raw := range report.ProposerSlashings {
+		slashing := &ethpb.ProposerSlashing{}
+		if err := protojson.Unmarshal(raw, slashing); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal proposer slashing")
+		}
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		report.seen[root] = true
+	}
+	return report, nil
+}
+
+// addAttesterSlashing adds a slashing to the report and returns whether it was not
+// reported before. A slashing is found again if an epoch is processed twice, after a
+// run was interrupted before its checkpoint was saved.
+func (r *historicalReport) addAttesterSlashing(slashing *ethpb.AttesterSlashing) (bool, error) {
+	root, err := slashing.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash attester slashing")
+	}
+	if r.seen[root] {
+		return false, nil
+	}
+	enc, err := protojson.Marshal(slashing)
+	if err != nil {
+		return false, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	r.seen[root] = true
+	r.AttesterSlashings = append(r.AttesterSlashings, enc)
+	return true, nil
+}
+
+// addProposerSlashing adds a slashing to the report and returns whether it was not
+// reported before.
+func (r *historicalReport) addProposerSlashing(slashing *ethpb.ProposerSlashing) (bool, error) {
+	root, err := slashing.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash proposer slashing")
+	}
+	if r.seen[root] {
+		return false, nil
+	}
+	enc, err := protojson.Marshal(slashing)
+	if err != nil {
+		return false, errors.Wrap(err, "could not marshal proposer slashing")
+	}
+	r.seen[root] = true
+	r.ProposerSlashings = append(r.ProposerSlashings, enc)
+	return true, nil
+}
+
+// save writes the report to a temporary file first, so that an interrupted write
+// never corrupts the report of a previous checkpoint.
+func (r *historicalReport) save(path string) error {
+	enc, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal report")
+	}
+	tmpPath := path + ".tmp"
+	if err := fileutil.WriteFile(tmpPath, enc); err != nil {
+		return errors.Wrap(err, "could not write report")
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,151 @@
+package slasher
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDetectHistoricalSlashings(t *testing.T) {
+	// Override the network name so that the hardcoded genesis state is not loaded.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.ConfigName = "test"
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	roots := setupHistoricalChain(t, beaconDB, 2*params.BeaconConfig().SlotsPerEpoch)
+
+	// A second block of the proposer of slot 40, which holds an attestation with the same
+	// target as an attestation of the canonical block but for another block root.
+	canonicalBlk, err := beaconDB.Block(ctx, roots[40])
+	require.NoError(t, err)
+	pbBlk, err := canonicalBlk.PbPhase0Block()
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(pbBlk.Block.Body.Attestations))
+	orphanedBlk := proto.Clone(pbBlk).(*ethpb.SignedBeaconBlock)
+	orphanedBlk.Block.Body.Graffiti = bytesutil.PadTo([]byte("orphaned"), 32)
+	orphanedBlk.Block.Body.Attestations[0].Data.BeaconBlockRoot = bytesutil.PadTo([]byte("orphaned"), 32)
+	require.NoError(t, beaconDB.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(orphanedBlk)))
+
+	saveFinalizedCheckpoint(t, beaconDB, 1, roots)
+	reportPath := filepath.Join(t.TempDir(), "slashings.json")
+	historicalCfg := &HistoricalConfig{
+		BeaconDB:   beaconDB,
+		SlasherDB:  dbtest.SetupSlasherDB(t),
+		ReportPath: reportPath,
+	}
+	require.NoError(t, DetectHistoricalSlashings(ctx, historicalCfg))
+	report := readHistoricalReport(t, reportPath)
+	assert.Equal(t, types.Epoch(1), report.NextEpoch)
+	assert.Equal(t, 0, len(report.AttesterSlashings))
+	assert.Equal(t, 0, len(report.ProposerSlashings))
+
+	// The run resumes from the checkpoint once another epoch is finalized.
+	saveFinalizedCheckpoint(t, beaconDB, 2, roots)
+	require.NoError(t, DetectHistoricalSlashings(ctx, historicalCfg))
+	report = readHistoricalReport(t, reportPath)
+	assert.Equal(t, types.Epoch(2), report.NextEpoch)
+	require.Equal(t, 1, len(report.AttesterSlashings))
+	attSlashing := &ethpb.AttesterSlashing{}
+	require.NoError(t, protojson.Unmarshal(report.AttesterSlashings[0], attSlashing))
+	assert.DeepEqual(t, pbBlk.Block.Body.Attestations[0].Data, attSlashing.Attestation_1.Data)
+	assert.DeepEqual(t, orphanedBlk.Block.Body.Attestations[0].Data, attSlashing.Attestation_2.Data)
+	require.Equal(t, 1, len(report.ProposerSlashings))
+	propSlashing := &ethpb.ProposerSlashing{}
+	require.NoError(t, protojson.Unmarshal(report.ProposerSlashings[0], propSlashing))
+	assert.Equal(t, pbBlk.Block.ProposerIndex, propSlashing.Header_1.Header.ProposerIndex)
+	assert.Equal(t, types.Slot(40), propSlashing.Header_2.Header.Slot)
+
+	// Running again once all finalized epochs are processed leaves the report as is.
+	require.NoError(t, DetectHistoricalSlashings(ctx, historicalCfg))
+	assert.DeepEqual(t, report, readHistoricalReport(t, reportPath))
+}
+
+func TestHistoricalReport_SkipsReportedSlashings(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "slashings.json")
+	report, err := loadHistoricalReport(reportPath)
+	require.NoError(t, err)
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: createAttestationWrapper(0, 1, []uint64{1}, []byte{1}).IndexedAttestation,
+		Attestation_2: createAttestationWrapper(0, 1, []uint64{1}, []byte{2}).IndexedAttestation,
+	}
+	added, err := report.addAttesterSlashing(slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, added)
+	report.NextEpoch = 5
+	require.NoError(t, report.save(reportPath))
+
+	report, err = loadHistoricalReport(reportPath)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(5), report.NextEpoch)
+	added, err = report.addAttesterSlashing(slashing)
+	require.NoError(t, err)
+	assert.Equal(t, false, added)
+	assert.Equal(t, 1, len(report.AttesterSlashings))
+}
+
+// setupHistoricalChain saves a genesis state and a canonical chain with a block at every slot
+// up to the last slot, and returns the block roots indexed by slot.
+func setupHistoricalChain(t *testing.T, beaconDB db.Database, lastSlot types.Slot) [][32]byte {
+	ctx := context.Background()
+	genesis, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := genesis.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(genesisBlk)))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, genesis, genesisRoot))
+
+	roots := [][32]byte{genesisRoot}
+	st := genesis.Copy()
+	for slot := types.Slot(1); slot <= lastSlot; slot++ {
+		blk, err := testutil.GenerateFullBlock(st, privKeys, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wrapped := interfaces.WrappedPhase0SignedBeaconBlock(blk)
+		st, err = state.ExecuteStateTransition(ctx, st, wrapped)
+		require.NoError(t, err)
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapped))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: slot, Root: root[:]}))
+		roots = append(roots, root)
+	}
+	return roots
+}
+
+func saveFinalizedCheckpoint(t *testing.T, beaconDB db.Database, epoch types.Epoch, roots [][32]byte) {
+	root := roots[params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))]
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(context.Background(), &ethpb.Checkpoint{
+		Epoch: epoch,
+		Root:  root[:],
+	}))
+}
+
+func readHistoricalReport(t *testing.T, path string) *historicalReport {
+	enc, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	report := &historicalReport{}
+	require.NoError(t, json.Unmarshal(enc, report))
+	return report
+}
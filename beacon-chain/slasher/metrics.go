@@ -0,0 +1,41 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	attestationsProcessedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_processed_total",
+		Help: "The number of verified attestations checked for slashable offenses.",
+	})
+	attestationsDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_dropped_total",
+		Help: "The number of attestations dropped because they were invalid, too old or did not fit into the queue.",
+	})
+	blocksProcessedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_blocks_processed_total",
+		Help: "The number of verified block headers checked for slashable offenses.",
+	})
+	blocksDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_blocks_dropped_total",
+		Help: "The number of block headers dropped because they were invalid or did not fit into the queue.",
+	})
+	doubleVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_double_votes_total",
+		Help: "The number of double votes detected.",
+	})
+	surroundingVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_surrounding_votes_total",
+		Help: "The number of attestations detected which surround a previous attestation.",
+	})
+	surroundedVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_surrounded_votes_total",
+		Help: "The number of attestations detected which are surrounded by a previous attestation.",
+	})
+	doubleProposalsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_double_proposals_total",
+		Help: "The number of double block proposals detected.",
+	})
+)
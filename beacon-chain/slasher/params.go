@@ -0,0 +1,92 @@
+package slasher
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Parameters for slashing detection.
+//
+// Min and max spans of validators are stored on disk in chunks. A chunk is a flat
+// slice holding the spans of validatorChunkSize validators over chunkSize epochs,
+// so that a single disk read serves many validators at once:
+//
+//	validator offset 0 -> [span at epoch 0, span at epoch 1, ..., span at epoch C-1]
+//	validator offset 1 -> [span at epoch 0, span at epoch 1, ..., span at epoch C-1]
+//	...
+//
+// Spans are kept for the last historyLength epochs. Epochs are mapped onto chunks
+// modulo the history length, so the chunks of a validator form a ring buffer.
+type Parameters struct {
+	chunkSize          uint64
+	validatorChunkSize uint64
+	historyLength      types.Epoch
+}
+
+// DefaultParams defines the default parameters for slasher.
+func DefaultParams() *Parameters {
+	return &Parameters{
+		chunkSize:          16,
+		validatorChunkSize: 256,
+		historyLength:      4096,
+	}
+}
+
+// chunkIndex of the chunk holding an epoch of a validator's spans.
+func (p *Parameters) chunkIndex(epoch types.Epoch) uint64 {
+	return (uint64(epoch) % uint64(p.historyLength)) / p.chunkSize
+}
+
+// chunkOffset of an epoch within its chunk.
+func (p *Parameters) chunkOffset(epoch types.Epoch) uint64 {
+	return uint64(epoch) % p.chunkSize
+}
+
+// firstEpochInChunk returns the first epoch of the chunk which holds an epoch.
+func (p *Parameters) firstEpochInChunk(epoch types.Epoch) types.Epoch {
+	return epoch - types.Epoch(p.chunkOffset(epoch))
+}
+
+// lastEpochInChunk returns the last epoch of the chunk which holds an epoch.
+func (p *Parameters) lastEpochInChunk(epoch types.Epoch) types.Epoch {
+	return p.firstEpochInChunk(epoch) + types.Epoch(p.chunkSize) - 1
+}
+
+// validatorChunkIndex of the chunks holding the spans of a validator.
+func (p *Parameters) validatorChunkIndex(validatorIdx types.ValidatorIndex) uint64 {
+	return uint64(validatorIdx) / p.validatorChunkSize
+}
+
+// validatorOffset of a validator within its chunks.
+func (p *Parameters) validatorOffset(validatorIdx types.ValidatorIndex) uint64 {
+	return uint64(validatorIdx) % p.validatorChunkSize
+}
+
+// cellIndex of the span of a validator at an epoch within a chunk.
+func (p *Parameters) cellIndex(validatorIdx types.ValidatorIndex, epoch types.Epoch) uint64 {
+	return p.validatorOffset(validatorIdx)*p.chunkSize + p.chunkOffset(epoch)
+}
+
+// flatSliceID returns the disk key of the chunk with a validator chunk index and a chunk index.
+func (p *Parameters) flatSliceID(validatorChunkIdx, chunkIdx uint64) []byte {
+	width := uint64(p.historyLength) / p.chunkSize
+	return ssz.MarshalUint64(make([]byte, 0), validatorChunkIdx*width+chunkIdx)
+}
+
+// validatorIndicesInChunk returns the validator indices whose spans are held by the
+// chunks with a validator chunk index.
+func (p *Parameters) validatorIndicesInChunk(validatorChunkIdx uint64) []types.ValidatorIndex {
+	indices := make([]types.ValidatorIndex, p.validatorChunkSize)
+	for i := uint64(0); i < p.validatorChunkSize; i++ {
+		indices[i] = types.ValidatorIndex(validatorChunkIdx*p.validatorChunkSize + i)
+	}
+	return indices
+}
+
+// minEpoch returns the oldest epoch whose spans are kept at the current epoch.
+func (p *Parameters) minEpoch(currentEpoch types.Epoch) types.Epoch {
+	if currentEpoch < p.historyLength {
+		return 0
+	}
+	return currentEpoch - p.historyLength + 1
+}
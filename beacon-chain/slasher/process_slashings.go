@@ -0,0 +1,70 @@
+package slasher
+
+import (
+	"context"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/sirupsen/logrus"
+)
+
+// processAttesterSlashings inserts detected attester slashings into the slashings pool, which
+// verifies them against the state. Slashings are deduplicated, as a double vote of an aggregate
+// is detected once for each of its attesters.
+func (s *Service) processAttesterSlashings(
+	ctx context.Context, st iface.ReadOnlyBeaconState, slashings []*ethpb.AttesterSlashing,
+) {
+	seen := make(map[[32]byte]bool)
+	for _, slashing := range slashings {
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			log.WithError(err).Error("Could not hash attester slashing")
+			continue
+		}
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		logger := log.WithFields(attesterSlashingFields(slashing))
+		if err := s.cfg.SlashingsPool.InsertAttesterSlashing(ctx, st, slashing); err != nil {
+			logger.WithError(err).Debug("Could not insert attester slashing into pool")
+			continue
+		}
+		logger.Info("Attester slashing detected")
+	}
+}
+
+// processProposerSlashings inserts detected proposer slashings into the slashings pool, which
+// verifies them against the state.
+func (s *Service) processProposerSlashings(
+	ctx context.Context, st iface.BeaconState, slashings []*ethpb.ProposerSlashing,
+) {
+	for _, slashing := range slashings {
+		logger := log.WithFields(proposerSlashingFields(slashing))
+		if err := s.cfg.SlashingsPool.InsertProposerSlashing(ctx, st, slashing); err != nil {
+			logger.WithError(err).Debug("Could not insert proposer slashing into pool")
+			continue
+		}
+		logger.Info("Proposer slashing detected")
+	}
+}
+
+func attesterSlashingFields(slashing *ethpb.AttesterSlashing) logrus.Fields {
+	return logrus.Fields{
+		"validatorIndices": sliceutil.IntersectionUint64(
+			slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices,
+		),
+		"sourceEpoch1": slashing.Attestation_1.Data.Source.Epoch,
+		"targetEpoch1": slashing.Attestation_1.Data.Target.Epoch,
+		"sourceEpoch2": slashing.Attestation_2.Data.Source.Epoch,
+		"targetEpoch2": slashing.Attestation_2.Data.Target.Epoch,
+	}
+}
+
+func proposerSlashingFields(slashing *ethpb.ProposerSlashing) logrus.Fields {
+	return logrus.Fields{
+		"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+		"slot":          slashing.Header_1.Header.Slot,
+	}
+}
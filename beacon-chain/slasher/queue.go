@@ -0,0 +1,95 @@
+package slasher
+
+import (
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// attestationsQueue collects the attestations received during an epoch until they are
+// processed as a batch.
+type attestationsQueue struct {
+	lock    sync.Mutex
+	maxSize int
+	items   []*ethpb.Attestation
+}
+
+// blocksQueue collects the block headers received during an epoch until they are
+// processed as a batch.
+type blocksQueue struct {
+	lock    sync.Mutex
+	maxSize int
+	items   []*ethpb.SignedBeaconBlockHeader
+}
+
+func newAttestationsQueue(maxSize int) *attestationsQueue {
+	return &attestationsQueue{maxSize: maxSize}
+}
+
+func newBlocksQueue(maxSize int) *blocksQueue {
+	return &blocksQueue{maxSize: maxSize}
+}
+
+// push appends attestations to the queue and returns the number of attestations which
+// were dropped because the queue is full.
+func (q *attestationsQueue) push(atts ...*ethpb.Attestation) int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	free := q.maxSize - len(q.items)
+	if free < 0 {
+		free = 0
+	}
+	if len(atts) > free {
+		q.items = append(q.items, atts[:free]...)
+		return len(atts) - free
+	}
+	q.items = append(q.items, atts...)
+	return 0
+}
+
+// dequeue removes and returns all attestations in the queue.
+func (q *attestationsQueue) dequeue() []*ethpb.Attestation {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	items := q.items
+	q.items = nil
+	return items
+}
+
+func (q *attestationsQueue) size() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.items)
+}
+
+// push appends block headers to the queue and returns the number of headers which
+// were dropped because the queue is full.
+func (q *blocksQueue) push(headers ...*ethpb.SignedBeaconBlockHeader) int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	free := q.maxSize - len(q.items)
+	if free < 0 {
+		free = 0
+	}
+	if len(headers) > free {
+		q.items = append(q.items, headers[:free]...)
+		return len(headers) - free
+	}
+	q.items = append(q.items, headers...)
+	return 0
+}
+
+// dequeue removes and returns all block headers in the queue.
+func (q *blocksQueue) dequeue() []*ethpb.SignedBeaconBlockHeader {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	items := q.items
+	q.items = nil
+	return items
+}
+
+func (q *blocksQueue) size() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.items)
+}
@@ -0,0 +1,204 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// receiveEvents queues the attestations and block headers of the block and operation feeds.
+// Events are only queued here, as the feeds are blocked until every subscriber received an event.
+// They are verified when the batch of the epoch is processed.
+func (s *Service) receiveEvents() {
+	blockChan := make(chan *feed.Event, 1)
+	blockSub := s.cfg.BlockNotifier.BlockFeed().Subscribe(blockChan)
+	defer blockSub.Unsubscribe()
+	opsChan := make(chan *feed.Event, 1)
+	opsSub := s.cfg.OperationNotifier.OperationFeed().Subscribe(opsChan)
+	defer opsSub.Unsubscribe()
+	for {
+		select {
+		case event := <-blockChan:
+			s.receiveBlockEvent(event)
+		case event := <-opsChan:
+			s.receiveOperationEvent(event)
+		case err := <-blockSub.Err():
+			log.WithError(err).Error("Subscription to block feed failed")
+			return
+		case err := <-opsSub.Err():
+			log.WithError(err).Error("Subscription to operation feed failed")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) receiveBlockEvent(event *feed.Event) {
+	if event.Type != blockfeed.ReceivedBlock {
+		return
+	}
+	data, ok := event.Data.(*blockfeed.ReceivedBlockData)
+	if !ok || data.SignedBlock == nil || data.SignedBlock.IsNil() {
+		return
+	}
+	blk, err := data.SignedBlock.PbPhase0Block()
+	if err != nil {
+		log.WithError(err).Debug("Could not get phase 0 block")
+		return
+	}
+	header, err := blockutil.SignedBeaconBlockHeaderFromBlock(blk)
+	if err != nil {
+		log.WithError(err).Debug("Could not get block header")
+		return
+	}
+	blocksDroppedTotal.Add(float64(s.blksQueue.push(header)))
+	// Attestations included in the block are checked as well, as they may have never been gossiped.
+	attestationsDroppedTotal.Add(float64(s.attsQueue.push(blk.Block.Body.Attestations...)))
+}
+
+func (s *Service) receiveOperationEvent(event *feed.Event) {
+	var att *ethpb.Attestation
+	switch event.Type {
+	case opfeed.UnaggregatedAttReceived:
+		data, ok := event.Data.(*opfeed.UnAggregatedAttReceivedData)
+		if !ok {
+			return
+		}
+		att = data.Attestation
+	case opfeed.AggregatedAttReceived:
+		data, ok := event.Data.(*opfeed.AggregatedAttReceivedData)
+		if !ok || data.Attestation == nil {
+			return
+		}
+		att = data.Attestation.Aggregate
+	default:
+		return
+	}
+	if att == nil {
+		return
+	}
+	attestationsDroppedTotal.Add(float64(s.attsQueue.push(att)))
+}
+
+// indexedAttestations converts queued attestations into indexed attestations, verifying their
+// signatures if requested. Attestations with a target epoch after the current epoch are returned
+// separately to be processed with a later batch. Invalid attestations, duplicates and attestations
+// with a source epoch older than the history of slasher are dropped.
+func (s *Service) indexedAttestations(
+	ctx context.Context,
+	st iface.ReadOnlyBeaconState,
+	currentEpoch types.Epoch,
+	atts []*ethpb.Attestation,
+	verifySignatures bool,
+) (valid []*slashertypes.IndexedAttestationWrapper, deferred []*ethpb.Attestation) {
+	minEpoch := s.params.minEpoch(currentEpoch)
+	seen := make(map[string]bool)
+	for _, att := range atts {
+		if err := helpers.ValidateNilAttestation(att); err != nil {
+			attestationsDroppedTotal.Inc()
+			continue
+		}
+		source := att.Data.Source.Epoch
+		target := att.Data.Target.Epoch
+		if target > currentEpoch {
+			deferred = append(deferred, att)
+			continue
+		}
+		if source > target || source < minEpoch {
+			attestationsDroppedTotal.Inc()
+			continue
+		}
+		signingRoot, err := att.Data.HashTreeRoot()
+		if err != nil {
+			attestationsDroppedTotal.Inc()
+			continue
+		}
+		key := string(signingRoot[:]) + string(att.AggregationBits)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		indexedAtt, err := indexedAttestation(ctx, st, att, verifySignatures)
+		if err != nil {
+			attestationsDroppedTotal.Inc()
+			log.WithError(err).WithField("slot", att.Data.Slot).Debug("Dropping invalid attestation")
+			continue
+		}
+		valid = append(valid, &slashertypes.IndexedAttestationWrapper{
+			IndexedAttestation: indexedAtt,
+			SigningRoot:        signingRoot,
+		})
+	}
+	attestationsProcessedTotal.Add(float64(len(valid)))
+	return valid, deferred
+}
+
+// indexedAttestation converts an attestation into an indexed attestation and optionally verifies
+// its signature.
+func indexedAttestation(
+	ctx context.Context, st iface.ReadOnlyBeaconState, att *ethpb.Attestation, verifySignature bool,
+) (*ethpb.IndexedAttestation, error) {
+	committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get committee")
+	}
+	indexedAtt, err := attestationutil.ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert to indexed attestation")
+	}
+	if !verifySignature {
+		return indexedAtt, nil
+	}
+	if err := blocks.VerifyIndexedAttestation(ctx, st, indexedAtt); err != nil {
+		return nil, errors.Wrap(err, "could not verify indexed attestation")
+	}
+	return indexedAtt, nil
+}
+
+// blockHeaders wraps queued block headers with their signing roots. If signatures are verified,
+// headers with an invalid proposer signature are dropped.
+func (s *Service) blockHeaders(
+	st iface.BeaconState, headers []*ethpb.SignedBeaconBlockHeader, verifySignatures bool,
+) []*slashertypes.SignedBlockHeaderWrapper {
+	valid := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(headers))
+	for _, header := range headers {
+		signingRoot, err := header.Header.HashTreeRoot()
+		if err != nil {
+			blocksDroppedTotal.Inc()
+			continue
+		}
+		if verifySignatures {
+			if err := helpers.ComputeDomainVerifySigningRoot(
+				st,
+				header.Header.ProposerIndex,
+				helpers.SlotToEpoch(header.Header.Slot),
+				header.Header,
+				params.BeaconConfig().DomainBeaconProposer,
+				header.Signature,
+			); err != nil {
+				blocksDroppedTotal.Inc()
+				log.WithError(err).WithField("slot", header.Header.Slot).Debug("Dropping invalid block header")
+				continue
+			}
+		}
+		valid = append(valid, &slashertypes.SignedBlockHeaderWrapper{
+			SignedBeaconBlockHeader: header,
+			SigningRoot:             signingRoot,
+		})
+	}
+	blocksProcessedTotal.Add(float64(len(valid)))
+	return valid
+}
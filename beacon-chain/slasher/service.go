@@ -0,0 +1,208 @@
+// Package slasher defines a service which detects slashable offenses in the blocks and
+// attestations received by the beacon node. Attestations and block headers are collected
+// from the block and operation feeds and processed in a batch at the start of every epoch.
+// Double votes and double proposals are detected from the records stored by slasherkv, while
+// surround votes are detected with min and max spans of the validators stored in chunks.
+// Detected slashings are inserted into the slashings pool, to be included in proposed blocks.
+package slasher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMaxQueueSize = 1 << 20
+	// Number of epochs of records pruned in a single database transaction.
+	pruningEpochIncrements = 10
+)
+
+// Config options for the slasher service.
+type Config struct {
+	Database          db.SlasherDatabase
+	HeadStateFetcher  blockchain.HeadFetcher
+	StateNotifier     statefeed.Notifier
+	BlockNotifier     blockfeed.Notifier
+	OperationNotifier opfeed.Notifier
+	SlashingsPool     slashings.PoolManager
+	// MaxQueueSize is the maximum number of attestations and of block headers waiting to be
+	// processed. Attestations and headers which do not fit into the queues are dropped.
+	MaxQueueSize int
+}
+
+// Service detects slashable offenses in the blocks and attestations received by the beacon node.
+type Service struct {
+	params    *Parameters
+	cfg       *Config
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	stateChan chan *feed.Event
+	stateSub  event.Subscription
+	attsQueue *attestationsQueue
+	blksQueue *blocksQueue
+	errLock   sync.RWMutex
+	err       error
+}
+
+// NewService creates a slasher service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Database == nil {
+		return nil, errors.New("no slasher database configured")
+	}
+	if cfg.MaxQueueSize <= 0 {
+		cfg.MaxQueueSize = defaultMaxQueueSize
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		params:    DefaultParams(),
+		cfg:       cfg,
+		ctx:       ctx,
+		cancel:    cancel,
+		stateChan: make(chan *feed.Event, 1),
+		attsQueue: newAttestationsQueue(cfg.MaxQueueSize),
+		blksQueue: newBlocksQueue(cfg.MaxQueueSize),
+	}
+	// The state feed is subscribed to before any service is started, so that the initialization
+	// of the chain is not missed.
+	s.stateSub = cfg.StateNotifier.StateFeed().Subscribe(s.stateChan)
+	return s, nil
+}
+
+// Start collecting attestations and block headers, and process them once the chain is initialized.
+func (s *Service) Start() {
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		s.receiveEvents()
+	}()
+	go func() {
+		defer s.wg.Done()
+		s.run()
+	}()
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+// Status returns the error of the last batch which could not be processed, if any.
+func (s *Service) Status() error {
+	s.errLock.RLock()
+	defer s.errLock.RUnlock()
+	return s.err
+}
+
+func (s *Service) setStatus(err error) {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+	s.err = err
+}
+
+func (s *Service) run() {
+	genesisTime, ok := s.waitForChainInitialization()
+	if !ok {
+		return
+	}
+	log.Info("Detecting slashable offenses")
+	ticker := slotutil.NewSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !helpers.IsEpochStart(slot) {
+				continue
+			}
+			err := s.processEpoch(s.ctx, helpers.SlotToEpoch(slot))
+			if err != nil {
+				log.WithError(err).Error("Could not process batch")
+			}
+			s.setStatus(err)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// waitForChainInitialization returns the genesis time once the chain is initialized.
+func (s *Service) waitForChainInitialization() (time.Time, bool) {
+	defer s.stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-s.stateChan:
+			if event.Type != statefeed.Initialized {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.InitializedData)
+			if !ok {
+				log.Error("Event feed data is not type *statefeed.InitializedData")
+				continue
+			}
+			return data.StartTime, true
+		case err := <-s.stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed failed")
+			return time.Time{}, false
+		case <-s.ctx.Done():
+			return time.Time{}, false
+		}
+	}
+}
+
+// processEpoch processes the attestations and block headers collected until the start of an epoch.
+func (s *Service) processEpoch(ctx context.Context, currentEpoch types.Epoch) error {
+	headState, err := s.cfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil || headState.IsNil() {
+		return errors.New("head state is nil")
+	}
+
+	atts, deferred := s.indexedAttestations(ctx, headState, currentEpoch, s.attsQueue.dequeue(), true /* verify signatures */)
+	attestationsDroppedTotal.Add(float64(s.attsQueue.push(deferred...)))
+	attSlashings, err := s.checkSlashableAttestations(ctx, currentEpoch, atts)
+	if err != nil {
+		return errors.Wrap(err, "could not check attestations")
+	}
+	s.processAttesterSlashings(ctx, headState, attSlashings)
+
+	proposals := s.blockHeaders(headState, s.blksQueue.dequeue(), true /* verify signatures */)
+	propSlashings, err := s.detectProposerSlashings(ctx, proposals)
+	if err != nil {
+		return errors.Wrap(err, "could not check block headers")
+	}
+	s.processProposerSlashings(ctx, headState, propSlashings)
+
+	log.WithFields(logrus.Fields{
+		"epoch":        currentEpoch,
+		"attestations": len(atts),
+		"blocks":       len(proposals),
+	}).Debug("Processed batch")
+
+	if err := s.cfg.Database.PruneAttestations(ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength); err != nil {
+		log.WithError(err).Error("Could not prune attestations")
+	}
+	if err := s.cfg.Database.PruneProposals(ctx, currentEpoch, pruningEpochIncrements, s.params.historyLength); err != nil {
+		log.WithError(err).Error("Could not prune proposals")
+	}
+	return nil
+}
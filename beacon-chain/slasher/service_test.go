@@ -0,0 +1,148 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupService(t *testing.T, st iface.BeaconState) (*Service, *mock.ChainService) {
+	chainService := &mock.ChainService{State: st}
+	s, err := NewService(context.Background(), &Config{
+		Database:          dbtest.SetupSlasherDB(t),
+		HeadStateFetcher:  chainService,
+		StateNotifier:     chainService.StateNotifier(),
+		BlockNotifier:     chainService.BlockNotifier(),
+		OperationNotifier: chainService.OperationNotifier(),
+		SlashingsPool:     slashings.NewPool(),
+	})
+	require.NoError(t, err)
+	return s, chainService
+}
+
+func TestService_ProcessEpoch(t *testing.T) {
+	ctx := context.Background()
+	st, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	s, _ := setupService(t, st)
+
+	// Two signed attestations of a validator for the same target with different block roots.
+	committee, err := helpers.BeaconCommitteeFromState(st, 1, 0)
+	require.NoError(t, err)
+	attester := committee[0]
+	att1 := signedAttestation(t, st, privKeys[attester], len(committee), []byte{1}, 0)
+	att2 := signedAttestation(t, st, privKeys[attester], len(committee), []byte{2}, 0)
+	s.receiveOperationEvent(&feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{Attestation: att1},
+	})
+	s.receiveOperationEvent(&feed.Event{
+		Type: opfeed.AggregatedAttReceived,
+		Data: &opfeed.AggregatedAttReceivedData{Attestation: &ethpb.AggregateAttestationAndProof{Aggregate: att2}},
+	})
+	// An attestation with an invalid signature is dropped.
+	invalidAtt := signedAttestation(t, st, privKeys[attester], len(committee), []byte{3}, 0)
+	invalidAtt.Signature = att1.Signature
+	// An attestation of a later epoch is kept for a later batch.
+	laterAtt := signedAttestation(t, st, privKeys[attester], len(committee), []byte{4}, 2)
+	s.attsQueue.push(invalidAtt, laterAtt)
+
+	// Two signed blocks of a proposer for the same slot.
+	proposer := types.ValidatorIndex(5)
+	s.receiveBlockEvent(&feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: signedBlock(t, st, privKeys[proposer], proposer, []byte{1})},
+	})
+	s.receiveBlockEvent(&feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: signedBlock(t, st, privKeys[proposer], proposer, []byte{2})},
+	})
+
+	require.NoError(t, s.processEpoch(ctx, 1))
+
+	attesterSlashings := s.cfg.SlashingsPool.PendingAttesterSlashings(ctx, st, true)
+	require.Equal(t, 1, len(attesterSlashings))
+	assert.DeepEqual(t, []uint64{uint64(attester)}, attesterSlashings[0].Attestation_1.AttestingIndices)
+	assert.DeepEqual(t, att1.Data, attesterSlashings[0].Attestation_1.Data)
+	assert.DeepEqual(t, att2.Data, attesterSlashings[0].Attestation_2.Data)
+	proposerSlashings := s.cfg.SlashingsPool.PendingProposerSlashings(ctx, st, true)
+	require.Equal(t, 1, len(proposerSlashings))
+	assert.Equal(t, proposer, proposerSlashings[0].Header_1.Header.ProposerIndex)
+	assert.Equal(t, 1, s.attsQueue.size())
+	assert.Equal(t, 0, s.blksQueue.size())
+}
+
+func TestService_ReceivesFeedEvents(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	s, chainService := setupService(t, st)
+	// The mock notifiers create their feeds lazily, so fetch them before the service does.
+	opFeed := chainService.OperationNotifier().OperationFeed()
+	blkFeed := chainService.BlockNotifier().BlockFeed()
+	s.Start()
+
+	att := testutil.HydrateAttestation(&ethpb.Attestation{})
+	blk := interfaces.WrappedPhase0SignedBeaconBlock(testutil.NewBeaconBlock())
+	for s.attsQueue.size() < 2 || s.blksQueue.size() < 1 {
+		opFeed.Send(&feed.Event{
+			Type: opfeed.UnaggregatedAttReceived,
+			Data: &opfeed.UnAggregatedAttReceivedData{Attestation: att},
+		})
+		blkFeed.Send(&feed.Event{
+			Type: blockfeed.ReceivedBlock,
+			Data: &blockfeed.ReceivedBlockData{SignedBlock: blk},
+		})
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.NoError(t, s.Stop())
+}
+
+func signedAttestation(
+	t *testing.T, st iface.BeaconState, priv bls.SecretKey, committeeSize int, blockRoot []byte, targetEpoch types.Epoch,
+) *ethpb.Attestation {
+	bits := bitfield.NewBitlist(uint64(committeeSize))
+	bits.SetBitAt(0, true)
+	att := testutil.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            1,
+			BeaconBlockRoot: bytesutil.PadTo(blockRoot, 32),
+			Target:          &ethpb.Checkpoint{Epoch: targetEpoch},
+		},
+	})
+	sig, err := helpers.ComputeDomainAndSign(st, targetEpoch, att.Data, params.BeaconConfig().DomainBeaconAttester, priv)
+	require.NoError(t, err)
+	att.Signature = sig
+	return att
+}
+
+func signedBlock(
+	t *testing.T, st iface.BeaconState, priv bls.SecretKey, proposer types.ValidatorIndex, graffiti []byte,
+) interfaces.SignedBeaconBlock {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ProposerIndex = proposer
+	blk.Block.Body.Graffiti = bytesutil.PadTo(graffiti, 32)
+	sig, err := helpers.ComputeDomainAndSign(st, 0, blk.Block, params.BeaconConfig().DomainBeaconProposer, priv)
+	require.NoError(t, err)
+	blk.Signature = sig
+	return interfaces.WrappedPhase0SignedBeaconBlock(blk)
+}
@@ -5,3 +5,4 @@ import "errors"
 var errUnknownBoundaryState = errors.New("unknown boundary state")
 var errUnknownState = errors.New("unknown state")
 var errUnknownBlock = errors.New("unknown block")
+var errSlotBeforeOrigin = errors.New("slot is before the checkpoint sync origin")
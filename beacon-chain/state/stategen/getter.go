@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -208,6 +209,15 @@ func (s *State) loadStateBySlot(ctx context.Context, slot types.Slot) (iface.Bea
 		return s.beaconDB.GenesisState(ctx)
 	}
 
+	// A node started from a checkpoint can't regenerate states before its origin.
+	originSlot, ok, err := s.originSlot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get checkpoint origin slot")
+	}
+	if ok && slot < originSlot {
+		return nil, errors.Wrapf(errSlotBeforeOrigin, "requested slot %d, origin slot %d", slot, originSlot)
+	}
+
 	// Gather the last saved block root and the slot number.
 	lastValidRoot, lastValidSlot, err := s.lastSavedBlock(ctx, slot)
 	if err != nil {
@@ -294,3 +304,20 @@ func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (iface.Bea
 		}
 	}
 }
+
+// This returns the slot of the checkpoint state the node was started from. The boolean is
+// false if the node was started from genesis.
+func (s *State) originSlot(ctx context.Context) (types.Slot, bool, error) {
+	originRoot, err := s.beaconDB.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	summary, err := s.stateSummary(ctx, originRoot)
+	if err != nil {
+		return 0, false, err
+	}
+	return summary.Slot, true, nil
+}
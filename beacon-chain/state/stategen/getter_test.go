@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/interfaces"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -491,3 +492,39 @@ func TestState_HasStateInCache(t *testing.T) {
 		require.Equal(t, tc.want, got)
 	}
 }
+
+func TestLoadStateBySlot_BeforeCheckpointOrigin(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(originSlot))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = originSlot
+	bodyRoot, err := b.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       originSlot,
+		ParentRoot: b.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b.Block.StateRoot = stateRoot[:]
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOrigin(ctx, serState, serBlock))
+
+	_, err = service.loadStateBySlot(ctx, originSlot-1)
+	assert.ErrorContains(t, errSlotBeforeOrigin.Error(), err)
+
+	loadedState, err := service.loadStateBySlot(ctx, originSlot+1)
+	require.NoError(t, err)
+	assert.Equal(t, originSlot+1, loadedState.Slot())
+}
@@ -0,0 +1,27 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillLowestSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_lowest_slot",
+			Help: "The slot of the oldest block saved by backfilling below the checkpoint origin.",
+		},
+	)
+	backfillBlocksSaved = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_saved_total",
+			Help: "Count of historical blocks saved by backfilling.",
+		},
+	)
+	backfillBatchesFailed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_batches_failed_total",
+			Help: "Count of block batches which could not be fetched or failed verification.",
+		},
+	)
+)
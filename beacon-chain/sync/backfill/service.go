@@ -0,0 +1,334 @@
+// Package backfill downloads the chain history below the checkpoint a beacon node was
+// started from. Blocks are requested backwards from the checkpoint origin with
+// BeaconBlocksByRange and linked to the trusted origin through their parent roots, so they
+// are saved without running the state transition.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// pollInterval is the time waited before checking again for the end of initial sync or for
+// suitable peers.
+var pollInterval = 5 * time.Second
+
+// retryBackoff is the time waited before retrying after a failed batch. It doubles with each
+// consecutive failure, up to maxRetryBackoff.
+var (
+	retryBackoff    = time.Second
+	maxRetryBackoff = time.Minute
+)
+
+var errInvalidBatch = errors.New("blocks do not link to the oldest saved block")
+
+// StatusFetcher reports the progress of backfilling.
+type StatusFetcher interface {
+	Backfilling() bool
+	LowestBackfilledSlot() types.Slot
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P         p2p.P2P
+	DB          db.NoHeadAccessDatabase
+	Chain       blockchain.ChainInfoFetcher
+	InitialSync prysmsync.Checker
+}
+
+// Service downloads and saves the blocks below the checkpoint sync origin.
+type Service struct {
+	cfg         *Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	backfilling *abool.AtomicBool
+	rand        *rand.Rand
+
+	lock   sync.RWMutex
+	lowest interfaces.SignedBeaconBlock
+	// endSlot is the exclusive upper slot bound of the next batch. It is lower than the slot
+	// of the oldest saved block when a batch only covered skipped slots.
+	endSlot types.Slot
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:         cfg,
+		ctx:         ctx,
+		cancel:      cancel,
+		backfilling: abool.New(),
+		rand:        rand.NewGenerator(),
+	}
+}
+
+// Start backfilling, if the node was started from a checkpoint and the history below it has
+// not been completely saved yet. Backfilling resumes from the oldest saved block after a
+// restart, and only begins once initial sync has caught up with the chain head.
+func (s *Service) Start() {
+	done, err := s.resume(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not resume backfilling")
+		return
+	}
+	if done {
+		return
+	}
+	s.backfilling.Set()
+	defer s.backfilling.UnSet()
+
+	if !s.waitForInitialSync() {
+		return
+	}
+	log.WithField("slot", s.LowestBackfilledSlot()).Info("Backfilling blocks below the checkpoint origin")
+	if err := s.run(s.ctx); err != nil {
+		if errors.Is(s.ctx.Err(), context.Canceled) {
+			return
+		}
+		log.WithError(err).Error("Backfilling stopped")
+		return
+	}
+	log.Info("Backfilling complete, all blocks down to genesis have been saved")
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service. Backfilling runs in the background, so an incomplete
+// history is not reported as an error.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Backfilling returns true while blocks below the checkpoint origin are being downloaded.
+func (s *Service) Backfilling() bool {
+	return s.backfilling.IsSet()
+}
+
+// LowestBackfilledSlot returns the slot of the oldest block in the database, which is the
+// genesis slot for a node that was not started from a checkpoint.
+func (s *Service) LowestBackfilledSlot() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.lowest == nil || s.lowest.IsNil() {
+		return 0
+	}
+	return s.lowest.Block().Slot()
+}
+
+// resume loads the oldest saved block, which is the checkpoint origin if no blocks have been
+// backfilled yet. It returns true if there is nothing to backfill.
+func (s *Service) resume(ctx context.Context) (bool, error) {
+	originRoot, err := s.cfg.DB.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		log.Debug("Node was not started from a checkpoint, nothing to backfill")
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "could not get checkpoint origin block root")
+	}
+	root, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundBackfillBlockRoot) {
+		root = originRoot
+	} else if err != nil {
+		return false, errors.Wrap(err, "could not get backfill block root")
+	}
+	blk, err := s.cfg.DB.Block(ctx, root)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not get block %#x", root)
+	}
+	if blk == nil || blk.IsNil() {
+		return false, fmt.Errorf("oldest saved block %#x is missing from the database", root)
+	}
+	s.setLowest(blk)
+	return s.complete(ctx), nil
+}
+
+// waitForInitialSync returns once initial sync is complete, so that backfilling does not
+// compete with it for peers. It returns false if the service is stopped while waiting.
+func (s *Service) waitForInitialSync() bool {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for !s.cfg.InitialSync.Synced() {
+		select {
+		case <-s.ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+	return true
+}
+
+// run requests batches of blocks backwards from the oldest saved block until the history is
+// linked to a block which was already in the database, such as the genesis block. Failed batches
+// are retried with an exponential backoff.
+func (s *Service) run(ctx context.Context) error {
+	backoff := retryBackoff
+	for !s.complete(ctx) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pid, err := s.selectPeer()
+		if err != nil {
+			log.WithError(err).Debug("No suitable peers to backfill from")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(pollInterval):
+			}
+			continue
+		}
+		if err := s.backfillBatch(ctx, pid); err != nil {
+			backfillBatchesFailed.Inc()
+			log.WithError(err).WithField("peer", pid).WithField("retryIn", backoff).Debug("Could not backfill batch")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > maxRetryBackoff {
+				backoff = maxRetryBackoff
+			}
+			continue
+		}
+		backoff = retryBackoff
+	}
+	return nil
+}
+
+// backfillBatch fetches the batch of blocks below the oldest saved block from the given peer,
+// verifies that they link to it and saves them.
+func (s *Service) backfillBatch(ctx context.Context, pid peer.ID) error {
+	ctx, span := trace.StartSpan(ctx, "backfill.backfillBatch")
+	defer span.End()
+
+	s.lock.RLock()
+	end := s.endSlot
+	expectedRoot := bytesutil.ToBytes32(s.lowest.Block().ParentRoot())
+	s.lock.RUnlock()
+
+	count := uint64(flags.Get().BlockBatchLimit)
+	if count > params.BeaconNetworkConfig().MaxRequestBlocks {
+		count = params.BeaconNetworkConfig().MaxRequestBlocks
+	}
+	start := types.Slot(0)
+	if uint64(end) > count {
+		start = end.Sub(count)
+	}
+	req := &p2ppb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(end.SubSlot(start)),
+		Step:      1,
+	}
+	blocks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.cfg.Chain, s.cfg.P2P, pid, req, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not request blocks")
+	}
+	if len(blocks) == 0 {
+		// The whole range consists of skipped slots, continue below it.
+		s.lock.Lock()
+		s.endSlot = start
+		s.lock.Unlock()
+		return nil
+	}
+
+	lowestRoot, err := verifyBatch(blocks, expectedRoot)
+	if err != nil {
+		s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		// The batch may not link because an earlier peer wrongly returned no blocks for a range,
+		// so request the range directly below the oldest saved block again.
+		s.lock.Lock()
+		s.endSlot = s.lowest.Block().Slot()
+		s.lock.Unlock()
+		return err
+	}
+	if err := s.cfg.DB.SaveBlocks(ctx, blocks); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	if err := s.cfg.DB.SaveBackfillBlockRoot(ctx, lowestRoot); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+	s.setLowest(blocks[0])
+	backfillBlocksSaved.Add(float64(len(blocks)))
+	log.WithFields(logrus.Fields{
+		"peer":   pid,
+		"blocks": len(blocks),
+		"slot":   blocks[0].Block().Slot(),
+	}).Debug("Backfilled blocks")
+	return nil
+}
+
+// verifyBatch checks that the blocks, ordered by increasing slot, form a chain which ends in
+// the block with root expectedRoot. The root of the lowest block is returned.
+func verifyBatch(blocks []interfaces.SignedBeaconBlock, expectedRoot [32]byte) ([32]byte, error) {
+	var root [32]byte
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if blocks[i] == nil || blocks[i].IsNil() {
+			return [32]byte{}, errors.New("nil block in batch")
+		}
+		var err error
+		root, err = blocks[i].Block().HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not compute block root")
+		}
+		if root != expectedRoot {
+			return [32]byte{}, errors.Wrapf(errInvalidBatch, "block at slot %d has root %#x, expected %#x",
+				blocks[i].Block().Slot(), root, expectedRoot)
+		}
+		expectedRoot = bytesutil.ToBytes32(blocks[i].Block().ParentRoot())
+	}
+	return root, nil
+}
+
+// complete returns true if the parent of the oldest saved block is already in the database.
+func (s *Service) complete(ctx context.Context) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	b := s.lowest.Block()
+	return b.Slot() == 0 || s.cfg.DB.HasBlock(ctx, bytesutil.ToBytes32(b.ParentRoot()))
+}
+
+// selectPeer returns a random peer which has finalized at least the same epoch as the node,
+// and therefore has the history below the checkpoint origin.
+func (s *Service) selectPeer() (peer.ID, error) {
+	_, peers := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, s.cfg.Chain.FinalizedCheckpt().Epoch)
+	if len(peers) == 0 {
+		return "", errors.New("no peers available")
+	}
+	return peers[s.rand.Intn(len(peers))], nil
+}
+
+func (s *Service) setLowest(blk interfaces.SignedBeaconBlock) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lowest = blk
+	s.endSlot = blk.Block().Slot()
+	backfillLowestSlot.Set(float64(blk.Block().Slot()))
+}
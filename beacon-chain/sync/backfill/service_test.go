@@ -0,0 +1,308 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	beaconsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMain(m *testing.M) {
+	logrus.SetLevel(logrus.DebugLevel)
+	logrus.SetOutput(ioutil.Discard)
+
+	// Override network name so that hardcoded genesis files are not loaded.
+	cfg := params.BeaconConfig()
+	cfg.ConfigName = "test"
+	params.OverrideBeaconConfig(cfg)
+
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{
+		EnablePeerScorer: true,
+	})
+	defer resetCfg()
+
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{
+		BlockBatchLimit:            16,
+		BlockBatchLimitBurstFactor: 10,
+	})
+	defer func() {
+		flags.Init(resetFlags)
+	}()
+
+	m.Run()
+}
+
+// buildHistory returns a chain of blocks from genesis up to, but excluding, originSlot. Slots
+// in skipped have no block.
+func buildHistory(t *testing.T, originSlot types.Slot, skipped map[types.Slot]bool) []*ethpb.SignedBeaconBlock {
+	genesis := testutil.NewBeaconBlock()
+	history := []*ethpb.SignedBeaconBlock{genesis}
+	for slot := types.Slot(1); slot < originSlot; slot++ {
+		if skipped[slot] {
+			continue
+		}
+		parentRoot, err := history[len(history)-1].Block.HashTreeRoot()
+		require.NoError(t, err)
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = parentRoot[:]
+		history = append(history, blk)
+	}
+	return history
+}
+
+// setupOrigin initializes the database from a checkpoint at originSlot, whose block is the
+// child of the last block in history. The genesis block is saved as well.
+func setupOrigin(t *testing.T, d db.Database, originSlot types.Slot, history []*ethpb.SignedBeaconBlock) [32]byte {
+	ctx := context.Background()
+	genesisRoot, err := history[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(history[0])))
+	require.NoError(t, d.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	parentRoot, err := history[len(history)-1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(originSlot))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = originSlot
+	blk.Block.ParentRoot = parentRoot[:]
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       originSlot,
+		ParentRoot: parentRoot[:],
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveOrigin(ctx, serState, serBlock))
+	originRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return originRoot
+}
+
+// connectPeerHavingBlocks connects a peer to the host, which serves the given blocks.
+func connectPeerHavingBlocks(t *testing.T, host *p2pt.TestP2P, blocks []*ethpb.SignedBeaconBlock, finalizedEpoch types.Epoch) peer.ID {
+	p := p2pt.NewTestP2P(t)
+	p.SetStreamHandler(p2p.RPCBlocksByRangeTopicV1+p.Encoding().ProtocolSuffix(), func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &p2ppb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+		for _, blk := range blocks {
+			if blk.Block.Slot >= req.StartSlot && blk.Block.Slot < req.StartSlot.Add(req.Count) {
+				assert.NoError(t, beaconsync.WriteChunk(stream, nil, p.Encoding(), blk))
+			}
+		}
+	})
+	p.Connect(host)
+
+	host.Peers().Add(new(enr.Record), p.PeerID(), nil, network.DirOutbound)
+	host.Peers().SetConnectionState(p.PeerID(), peers.PeerConnected)
+	host.Peers().SetChainState(p.PeerID(), &p2ppb.Status{
+		ForkDigest:     params.BeaconConfig().GenesisForkVersion,
+		FinalizedRoot:  bytesutil.PadTo([]byte("finalized_root"), 32),
+		FinalizedEpoch: finalizedEpoch,
+		HeadRoot:       bytesutil.PadTo([]byte("head_root"), 32),
+		HeadSlot:       params.BeaconConfig().SlotsPerEpoch.Mul(uint64(finalizedEpoch)),
+	})
+	return p.PeerID()
+}
+
+func newTestService(t *testing.T, d db.Database, host *p2pt.TestP2P, finalizedEpoch types.Epoch) *Service {
+	return NewService(context.Background(), &Config{
+		P2P:         host,
+		DB:          d,
+		Chain:       &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: finalizedEpoch}},
+		InitialSync: &mockSync.Sync{IsSynced: true},
+	})
+}
+
+func TestService_NotStartedFromCheckpoint(t *testing.T) {
+	d := dbtest.SetupDB(t)
+	s := newTestService(t, d, p2pt.NewTestP2P(t), 0)
+	s.Start()
+	assert.Equal(t, false, s.Backfilling())
+	assert.Equal(t, types.Slot(0), s.LowestBackfilledSlot())
+	_, err := d.BackfillBlockRoot(context.Background())
+	assert.Equal(t, true, errors.Is(err, db.ErrNotFoundBackfillBlockRoot))
+}
+
+func TestService_Backfill(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	// Skip a whole batch worth of slots, so that an empty batch is received as well.
+	skipped := map[types.Slot]bool{5: true, 40: true}
+	for slot := types.Slot(17); slot < 34; slot++ {
+		skipped[slot] = true
+	}
+	history := buildHistory(t, originSlot, skipped)
+	setupOrigin(t, d, originSlot, history)
+
+	host := p2pt.NewTestP2P(t)
+	connectPeerHavingBlocks(t, host, history, 2)
+	s := newTestService(t, d, host, 2)
+	s.Start()
+
+	assert.Equal(t, false, s.Backfilling())
+	assert.Equal(t, types.Slot(0), s.LowestBackfilledSlot())
+	for _, blk := range history {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, d.HasBlock(ctx, root), "Missing block at slot %d", blk.Block.Slot)
+	}
+	backfillRoot, err := d.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	genesisRoot, err := history[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfillRoot)
+}
+
+func TestService_Resume(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	history := buildHistory(t, originSlot, nil)
+	setupOrigin(t, d, originSlot, history)
+
+	// Blocks down to slot 40 were backfilled before a restart.
+	saved := make([]interfaces.SignedBeaconBlock, 0)
+	for _, blk := range history[40:] {
+		saved = append(saved, interfaces.WrappedPhase0SignedBeaconBlock(blk))
+	}
+	require.NoError(t, d.SaveBlocks(ctx, saved))
+	root, err := history[40].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBackfillBlockRoot(ctx, root))
+
+	s := newTestService(t, d, p2pt.NewTestP2P(t), 2)
+	done, err := s.resume(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, done)
+	assert.Equal(t, types.Slot(40), s.LowestBackfilledSlot())
+	assert.Equal(t, types.Slot(40), s.endSlot)
+
+	// Once the genesis block is linked, there is nothing left to do.
+	genesisRoot, err := history[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBackfillBlockRoot(ctx, genesisRoot))
+	done, err = s.resume(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, done)
+}
+
+func TestService_BackfillBatch_InvalidBlocks(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	history := buildHistory(t, originSlot, nil)
+	setupOrigin(t, d, originSlot, history)
+
+	// The peer serves blocks from a different chain.
+	forked := buildHistory(t, originSlot, map[types.Slot]bool{3: true})
+	host := p2pt.NewTestP2P(t)
+	pid := connectPeerHavingBlocks(t, host, forked, 2)
+	s := newTestService(t, d, host, 2)
+	_, err := s.resume(ctx)
+	require.NoError(t, err)
+
+	err = s.backfillBatch(ctx, pid)
+	assert.Equal(t, true, errors.Is(err, errInvalidBatch))
+	assert.Equal(t, originSlot, s.LowestBackfilledSlot())
+	assert.Equal(t, originSlot, s.endSlot)
+	badResponses, err := host.Peers().Scorers().BadResponsesScorer().Count(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	_, err = d.BackfillBlockRoot(ctx)
+	assert.Equal(t, true, errors.Is(err, db.ErrNotFoundBackfillBlockRoot))
+}
+
+func TestService_Run_RetryBackoff(t *testing.T) {
+	prevBackoff, prevMax := retryBackoff, maxRetryBackoff
+	retryBackoff, maxRetryBackoff = 100*time.Millisecond, time.Second
+	defer func() {
+		retryBackoff, maxRetryBackoff = prevBackoff, prevMax
+	}()
+
+	d := dbtest.SetupDB(t)
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	history := buildHistory(t, originSlot, nil)
+	setupOrigin(t, d, originSlot, history)
+
+	// Every batch fails verification, as the peer serves blocks from a different chain.
+	forked := buildHistory(t, originSlot, map[types.Slot]bool{3: true})
+	host := p2pt.NewTestP2P(t)
+	connectPeerHavingBlocks(t, host, forked, 2)
+	s := newTestService(t, d, host, 2)
+	_, err := s.resume(context.Background())
+	require.NoError(t, err)
+
+	// Attempts are made after 0, 100 and 300ms, the next one would be after 700ms.
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	before := promtestutil.ToFloat64(backfillBatchesFailed)
+	err = s.run(ctx)
+	assert.Equal(t, true, errors.Is(err, context.DeadlineExceeded))
+	failed := promtestutil.ToFloat64(backfillBatchesFailed) - before
+	assert.Equal(t, true, failed >= 2 && failed <= 3, "Unexpected number of attempts: %v", failed)
+}
+
+func TestVerifyBatch(t *testing.T) {
+	history := buildHistory(t, 10, nil)
+	blocks := make([]interfaces.SignedBeaconBlock, 0, len(history))
+	for _, blk := range history[3:] {
+		blocks = append(blocks, interfaces.WrappedPhase0SignedBeaconBlock(blk))
+	}
+	topRoot, err := history[len(history)-1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	lowestRoot, err := history[3].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := verifyBatch(blocks, topRoot)
+	require.NoError(t, err)
+	assert.Equal(t, lowestRoot, root)
+
+	_, err = verifyBatch(blocks, lowestRoot)
+	assert.Equal(t, true, errors.Is(err, errInvalidBatch))
+
+	// A gap in the middle of the batch breaks the chain.
+	_, err = verifyBatch(append(blocks[:2:2], blocks[3:]...), topRoot)
+	assert.Equal(t, true, errors.Is(err, errInvalidBatch))
+}
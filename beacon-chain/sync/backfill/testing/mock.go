@@ -0,0 +1,23 @@
+// Package testing includes useful mocks for testing backfill
+// status in unit tests.
+package testing
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Backfill defines a mock for the backfill service.
+type Backfill struct {
+	IsBackfilling bool
+	LowestSlot    types.Slot
+}
+
+// Backfilling --
+func (b *Backfill) Backfilling() bool {
+	return b.IsBackfilling
+}
+
+// LowestBackfilledSlot --
+func (b *Backfill) LowestBackfilledSlot() types.Slot {
+	return b.LowestSlot
+}
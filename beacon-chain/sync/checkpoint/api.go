@@ -0,0 +1,116 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+)
+
+const (
+	getStatePath = "/eth/v1/debug/beacon/states/%s"
+	getBlockPath = "/eth/v1/beacon/blocks/%s"
+	sszMediaType = "application/octet-stream"
+	// Beacon states are large, give the remote node ample time to serialize them.
+	requestTimeout = 5 * time.Minute
+)
+
+// APIInitializer downloads the latest finalized state and its block from a trusted beacon
+// node, using the ssz variants of the standard debug and beacon API endpoints.
+type APIInitializer struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewAPIInitializer creates an Initializer fetching the checkpoint from the beacon node API
+// served at beaconNodeURL, for example http://localhost:3500.
+func NewAPIInitializer(beaconNodeURL string) (*APIInitializer, error) {
+	if beaconNodeURL == "" {
+		return nil, errors.New("no beacon node url provided")
+	}
+	if !strings.HasPrefix(beaconNodeURL, "http://") && !strings.HasPrefix(beaconNodeURL, "https://") {
+		beaconNodeURL = "http://" + beaconNodeURL
+	}
+	return &APIInitializer{
+		client:  &http.Client{Timeout: requestTimeout},
+		baseURL: strings.TrimSuffix(beaconNodeURL, "/"),
+	}, nil
+}
+
+// Initialize downloads the finalized checkpoint and saves it as origin of the chain in the
+// database. The genesis state is downloaded as well if the database does not have one.
+func (ai *APIInitializer) Initialize(ctx context.Context, d db.HeadAccessDatabase) error {
+	initialized, err := alreadyInitialized(ctx, d)
+	if err != nil || initialized {
+		return err
+	}
+	gs, err := d.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if gs == nil || gs.IsNil() {
+		log.WithField("url", ai.baseURL).Info("Downloading genesis state")
+		serGenesis, err := ai.getSSZ(ctx, fmt.Sprintf(getStatePath, "genesis"))
+		if err != nil {
+			return errors.Wrap(err, "could not download genesis state")
+		}
+		if err := d.LoadGenesis(ctx, bytes.NewReader(serGenesis)); err != nil {
+			return errors.Wrap(err, "could not load downloaded genesis state")
+		}
+	}
+
+	log.WithField("url", ai.baseURL).Info("Downloading finalized checkpoint state")
+	serState, err := ai.getSSZ(ctx, fmt.Sprintf(getStatePath, "finalized"))
+	if err != nil {
+		return errors.Wrap(err, "could not download finalized state")
+	}
+	blockRoot, err := blockRootFromState(serState)
+	if err != nil {
+		return errors.Wrap(err, "could not determine block root of finalized state")
+	}
+	log.WithField("blockRoot", fmt.Sprintf("%#x", blockRoot)).Info("Downloading finalized checkpoint block")
+	serBlock, err := ai.getSSZ(ctx, fmt.Sprintf(getBlockPath, fmt.Sprintf("%#x", blockRoot)))
+	if err != nil {
+		return errors.Wrap(err, "could not download finalized block")
+	}
+	serState, err = advanceToEpochStart(ctx, serState)
+	if err != nil {
+		return errors.Wrap(err, "could not advance finalized state to the start of the epoch")
+	}
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return errors.Wrap(err, "could not save checkpoint as origin")
+	}
+	log.WithField("blockRoot", fmt.Sprintf("%#x", blockRoot)).Info("Initialized database from remote checkpoint")
+	return nil
+}
+
+func (ai *APIInitializer) getSSZ(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ai.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", sszMediaType)
+	resp, err := ai.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request to %s failed with status %d: %s", path, resp.StatusCode, string(body))
+	}
+	return body, nil
+}
@@ -0,0 +1,121 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func checkpointServer(t *testing.T, serGenesis, serState, serBlock []byte, blockRoot [32]byte) *httptest.Server {
+	mux := http.NewServeMux()
+	serve := func(path string, body []byte) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, sszMediaType, r.Header.Get("Accept"))
+			_, err := w.Write(body)
+			require.NoError(t, err)
+		})
+	}
+	serve(fmt.Sprintf(getStatePath, "genesis"), serGenesis)
+	serve(fmt.Sprintf(getStatePath, "finalized"), serState)
+	serve(fmt.Sprintf(getBlockPath, fmt.Sprintf("%#x", blockRoot)), serBlock)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewAPIInitializer(t *testing.T) {
+	_, err := NewAPIInitializer("")
+	assert.ErrorContains(t, "no beacon node url provided", err)
+
+	ai, err := NewAPIInitializer("localhost:3500/")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:3500", ai.baseURL)
+}
+
+func TestAPIInitializer_Initialize(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	serGenesis, serState, serBlock, blockRoot := checkpointFixture(t, params.BeaconConfig().SlotsPerEpoch*3)
+	srv := checkpointServer(t, serGenesis, serState, serBlock, blockRoot)
+
+	ai, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, ai.Initialize(ctx, d))
+
+	gs, err := d.GenesisState(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, gs)
+	originRoot, err := d.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+	head, err := d.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch*3, head.Block().Slot())
+}
+
+func TestAPIInitializer_Initialize_SkippedEpochStart(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	// The finalized block is the last block before epoch 3, whose first slot was skipped.
+	epochStart := params.BeaconConfig().SlotsPerEpoch * 3
+	serGenesis, serState, serBlock, blockRoot := checkpointFixture(t, epochStart-2)
+	srv := checkpointServer(t, serGenesis, serState, serBlock, blockRoot)
+
+	ai, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, ai.Initialize(ctx, d))
+
+	originRoot, err := d.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+	head, err := d.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, epochStart-2, head.Block().Slot())
+	st, err := d.State(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, epochStart, st.Slot())
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), finalized.Epoch)
+}
+
+func TestAPIInitializer_Initialize_ExistingGenesis(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	serGenesis, serState, serBlock, blockRoot := checkpointFixture(t, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, d.LoadGenesis(ctx, bytes.NewReader(serGenesis)))
+	// The genesis state must not be downloaded again.
+	srv := checkpointServer(t, nil, serState, serBlock, blockRoot)
+
+	ai, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, ai.Initialize(ctx, d))
+	originRoot, err := d.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+}
+
+func TestAPIInitializer_Initialize_RequestFailure(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	serGenesis, _, _, _ := checkpointFixture(t, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, d.LoadGenesis(ctx, bytes.NewReader(serGenesis)))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "state not found", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	ai, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	err = ai.Initialize(ctx, d)
+	assert.ErrorContains(t, "failed with status 404", err)
+}
@@ -0,0 +1,120 @@
+// Package checkpoint implements bootstrapping a beacon node database from a trusted,
+// finalized state and block, so that the node syncs forward from that checkpoint instead
+// of from genesis. The checkpoint can be read from ssz files on disk or downloaded from
+// another beacon node through the standard beacon API.
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrDatabaseNotEmpty is returned when a checkpoint is given for a database which has
+// already synced blocks past genesis.
+var ErrDatabaseNotEmpty = errors.New("database already contains a synced chain, " +
+	"run with --clear-db to start from the checkpoint")
+
+// Initializer prepares a beacon node database to start syncing from a checkpoint.
+type Initializer interface {
+	Initialize(ctx context.Context, d db.HeadAccessDatabase) error
+}
+
+// alreadyInitialized returns true if the database was previously initialized from a
+// checkpoint, in which case the node resumes from its own data. An error is returned if
+// the database holds a chain which was synced from genesis.
+func alreadyInitialized(ctx context.Context, d db.HeadAccessDatabase) (bool, error) {
+	originRoot, err := d.OriginCheckpointBlockRoot(ctx)
+	if err == nil {
+		log.WithField("originRoot", fmt.Sprintf("%#x", originRoot)).
+			Info("Database was already initialized from a checkpoint, ignoring checkpoint sync flags")
+		return true, nil
+	}
+	if !errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return false, err
+	}
+	head, err := d.HeadBlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	if head != nil && !head.IsNil() && head.Block().Slot() > 0 {
+		return false, ErrDatabaseNotEmpty
+	}
+	return false, nil
+}
+
+// ensureGenesis returns an error if there is no genesis state in the database. The genesis
+// state is still required by a node started from a checkpoint, for the genesis time and
+// validators root it carries.
+func ensureGenesis(ctx context.Context, d db.HeadAccessDatabase) error {
+	gs, err := d.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if gs == nil || gs.IsNil() {
+		return errors.New("checkpoint sync requires a genesis state, none was found in the database")
+	}
+	return nil
+}
+
+// blockRootFromState returns the root of the latest block applied to the given ssz encoded
+// beacon state. This is the root of the block which must accompany the state as checkpoint.
+func blockRootFromState(serState []byte) ([32]byte, error) {
+	st := &pbp2p.BeaconState{}
+	if err := st.UnmarshalSSZ(serState); err != nil {
+		return [32]byte{}, err
+	}
+	header := st.LatestBlockHeader
+	if header == nil {
+		return [32]byte{}, errors.New("checkpoint state has no latest block header")
+	}
+	// The state root of the latest block header is only filled in during the next slot.
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := st.HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
+
+// advanceToEpochStart advances the given ssz encoded beacon state to the start of the next epoch,
+// unless it is already at an epoch start. The finalized state served by beacon nodes is the
+// post-state of the finalized block, which is not at an epoch start when the first slots of the
+// finalized epoch were skipped, while the checkpoint origin must be an epoch boundary state.
+func advanceToEpochStart(ctx context.Context, serState []byte) ([]byte, error) {
+	pbState := &pbp2p.BeaconState{}
+	if err := pbState.UnmarshalSSZ(serState); err != nil {
+		return nil, err
+	}
+	if helpers.IsEpochStart(pbState.Slot) {
+		return serState, nil
+	}
+	st, err := stateV0.InitializeFromProtoUnsafe(pbState)
+	if err != nil {
+		return nil, err
+	}
+	epochStart, err := helpers.StartSlot(helpers.SlotToEpoch(st.Slot()) + 1)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"stateSlot":  st.Slot(),
+		"epochStart": epochStart,
+	}).Info("Advancing checkpoint state to the start of the epoch")
+	advanced, err := state.ProcessSlots(ctx, st, epochStart)
+	if err != nil {
+		return nil, err
+	}
+	return advanced.MarshalSSZ()
+}
@@ -0,0 +1,58 @@
+package checkpoint
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// checkpointFixture returns an ssz encoded genesis state, a finalized state at the given
+// slot, the ssz encoded block last applied to that state and the root of the block.
+func checkpointFixture(t *testing.T, slot types.Slot) ([]byte, []byte, []byte, [32]byte) {
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	serGenesis, err := gs.MarshalSSZ()
+	require.NoError(t, err)
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{0x01}, 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	return serGenesis, serState, serBlock, blockRoot
+}
+
+func TestBlockRootFromState(t *testing.T) {
+	_, serState, _, blockRoot := checkpointFixture(t, params.BeaconConfig().SlotsPerEpoch)
+	root, err := blockRootFromState(serState)
+	require.NoError(t, err)
+	require.Equal(t, blockRoot, root)
+
+	_, err = blockRootFromState([]byte("not a state"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,56 @@
+package checkpoint
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+)
+
+// FileInitializer loads the checkpoint state and block from ssz encoded files.
+type FileInitializer struct {
+	statePath string
+	blockPath string
+}
+
+// NewFileInitializer creates an Initializer reading the checkpoint from the given
+// state and block files.
+func NewFileInitializer(statePath, blockPath string) (*FileInitializer, error) {
+	if statePath == "" || blockPath == "" {
+		return nil, errors.New("both a checkpoint state and a checkpoint block file are required")
+	}
+	return &FileInitializer{
+		statePath: filepath.Clean(statePath),
+		blockPath: filepath.Clean(blockPath),
+	}, nil
+}
+
+// Initialize saves the checkpoint read from disk as origin of the chain in the database.
+func (fi *FileInitializer) Initialize(ctx context.Context, d db.HeadAccessDatabase) error {
+	initialized, err := alreadyInitialized(ctx, d)
+	if err != nil || initialized {
+		return err
+	}
+	if err := ensureGenesis(ctx, d); err != nil {
+		return errors.Wrap(err, "use --genesis-state to provide the genesis state of the network")
+	}
+	serState, err := ioutil.ReadFile(fi.statePath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read checkpoint state file %s", fi.statePath)
+	}
+	serBlock, err := ioutil.ReadFile(fi.blockPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read checkpoint block file %s", fi.blockPath)
+	}
+	serState, err = advanceToEpochStart(ctx, serState)
+	if err != nil {
+		return errors.Wrap(err, "could not advance checkpoint state to the start of the epoch")
+	}
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return errors.Wrap(err, "could not save checkpoint as origin")
+	}
+	log.WithField("statePath", fi.statePath).Info("Initialized database from checkpoint files")
+	return nil
+}
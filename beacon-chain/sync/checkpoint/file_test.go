@@ -0,0 +1,78 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/interfaces"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNewFileInitializer_MissingPaths(t *testing.T) {
+	_, err := NewFileInitializer("state.ssz", "")
+	assert.ErrorContains(t, "both a checkpoint state and a checkpoint block file are required", err)
+	_, err = NewFileInitializer("", "block.ssz")
+	assert.ErrorContains(t, "both a checkpoint state and a checkpoint block file are required", err)
+}
+
+func TestFileInitializer_Initialize(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	serGenesis, serState, serBlock, blockRoot := checkpointFixture(t, params.BeaconConfig().SlotsPerEpoch*2)
+	require.NoError(t, d.LoadGenesis(ctx, bytes.NewReader(serGenesis)))
+
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	require.NoError(t, ioutil.WriteFile(statePath, serState, 0600))
+	require.NoError(t, ioutil.WriteFile(blockPath, serBlock, 0600))
+
+	fi, err := NewFileInitializer(statePath, blockPath)
+	require.NoError(t, err)
+	require.NoError(t, fi.Initialize(ctx, d))
+
+	originRoot, err := d.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+	cp, err := d.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blockRoot[:], cp.Root)
+
+	// A restarted node keeps using its own data, even if the files are gone.
+	fi, err = NewFileInitializer(filepath.Join(dir, "missing"), filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	require.NoError(t, fi.Initialize(ctx, d))
+}
+
+func TestFileInitializer_Initialize_NoGenesis(t *testing.T) {
+	d := dbtest.SetupDB(t)
+	fi, err := NewFileInitializer("state.ssz", "block.ssz")
+	require.NoError(t, err)
+	err = fi.Initialize(context.Background(), d)
+	assert.ErrorContains(t, "checkpoint sync requires a genesis state", err)
+}
+
+func TestFileInitializer_Initialize_SyncedDatabase(t *testing.T) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 10
+	require.NoError(t, d.SaveBlock(ctx, interfaces.WrappedPhase0SignedBeaconBlock(blk)))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveStateSummary(ctx, &pb.StateSummary{Slot: 10, Root: root[:]}))
+	require.NoError(t, d.SaveHeadBlockRoot(ctx, root))
+
+	fi, err := NewFileInitializer("state.ssz", "block.ssz")
+	require.NoError(t, err)
+	err = fi.Initialize(ctx, d)
+	assert.Equal(t, ErrDatabaseNotEmpty, err)
+}
@@ -15,6 +15,7 @@ import (
 	gcache "github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
@@ -68,6 +69,14 @@ type Config struct {
 	BlockNotifier       blockfeed.Notifier
 	AttestationNotifier operation.Notifier
 	StateGen            *stategen.State
+	LivenessCache       *cache.LivenessCache
+	AttestationObserver AttestationObserver
+}
+
+// AttestationObserver is notified of the attestations received on gossip once they passed validation,
+// along with the aggregates of the aggregate and proofs.
+type AttestationObserver interface {
+	ObserveAttestation(att *ethpb.Attestation)
 }
 
 // This defines the interface for interacting with block chain service
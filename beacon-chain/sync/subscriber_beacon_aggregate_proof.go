@@ -12,7 +12,7 @@ import (
 
 // beaconAggregateProofSubscriber forwards the incoming validated aggregated attestation and proof to the
 // attestation pool for processing.
-func (s *Service) beaconAggregateProofSubscriber(_ context.Context, msg proto.Message) error {
+func (s *Service) beaconAggregateProofSubscriber(ctx context.Context, msg proto.Message) error {
 	a, ok := msg.(*ethpb.SignedAggregateAttestationAndProof)
 	if !ok {
 		return fmt.Errorf("message was not type *eth.SignedAggregateAttestationAndProof, type=%T", msg)
@@ -21,6 +21,12 @@ func (s *Service) beaconAggregateProofSubscriber(_ context.Context, msg proto.Me
 	if a.Message.Aggregate == nil || a.Message.Aggregate.Data == nil {
 		return errors.New("nil aggregate")
 	}
+	if err := s.markAttestersLive(ctx, a.Message.Aggregate, a.Message.AggregatorIndex); err != nil {
+		log.WithError(err).Debug("Could not mark aggregator and attesters as live")
+	}
+	if s.cfg.AttestationObserver != nil {
+		s.cfg.AttestationObserver.ObserveAttestation(a.Message.Aggregate)
+	}
 
 	// An unaggregated attestation can make it here. It’s valid, the aggregator it just itself, although it means poor performance for the subnet.
 	if !helpers.IsAggregated(a.Message.Aggregate) {
@@ -7,6 +7,8 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -64,3 +66,73 @@ func TestBeaconAggregateProofSubscriber_CanSaveUnaggregatedAttestation(t *testin
 	require.NoError(t, err)
 	assert.DeepEqual(t, []*ethpb.Attestation{a.Message.Aggregate}, atts, "Did not save unaggregated attestation")
 }
+
+func TestBeaconAggregateProofSubscriber_MarksAggregatorAndAttestersLive(t *testing.T) {
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	chain := &mock.ChainService{State: st}
+	r := &Service{
+		cfg: &Config{
+			AttPool:             attestations.NewPool(),
+			AttestationNotifier: chain.OperationNotifier(),
+			Chain:               chain,
+			LivenessCache:       cache.NewLivenessCache(),
+		},
+		seenAttestationCache: c,
+	}
+
+	a := &ethpb.SignedAggregateAttestationAndProof{
+		Message: &ethpb.AggregateAttestationAndProof{
+			Aggregate: testutil.HydrateAttestation(&ethpb.Attestation{
+				AggregationBits: bitfield.Bitlist{0x07},
+			}),
+			AggregatorIndex: 60,
+		},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, r.beaconAggregateProofSubscriber(context.Background(), a))
+
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(committee))
+	for _, idx := range committee {
+		assert.Equal(t, true, r.cfg.LivenessCache.IsLive(0, idx))
+	}
+	assert.Equal(t, true, r.cfg.LivenessCache.IsLive(0, 60))
+	assert.Equal(t, false, r.cfg.LivenessCache.IsLive(1, 60))
+}
+
+type mockAttestationObserver struct {
+	observed []*ethpb.Attestation
+}
+
+func (m *mockAttestationObserver) ObserveAttestation(att *ethpb.Attestation) {
+	m.observed = append(m.observed, att)
+}
+
+func TestAttestationSubscribers_NotifyObserver(t *testing.T) {
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	observer := &mockAttestationObserver{}
+	r := &Service{
+		cfg: &Config{
+			AttPool:             attestations.NewPool(),
+			AttestationObserver: observer,
+		},
+		seenAttestationCache: c,
+	}
+
+	att := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0x05}})
+	require.NoError(t, r.committeeIndexBeaconAttestationSubscriber(context.Background(), att))
+	a := &ethpb.SignedAggregateAttestationAndProof{
+		Message: &ethpb.AggregateAttestationAndProof{
+			Aggregate: testutil.HydrateAttestation(&ethpb.Attestation{
+				AggregationBits: bitfield.Bitlist{0x07},
+			}),
+		},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, r.beaconAggregateProofSubscriber(context.Background(), a))
+	assert.DeepEqual(t, []*ethpb.Attestation{att, a.Message.Aggregate}, observer.observed)
+}
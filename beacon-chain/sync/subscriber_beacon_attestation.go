@@ -9,12 +9,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"google.golang.org/protobuf/proto"
 )
 
-func (s *Service) committeeIndexBeaconAttestationSubscriber(_ context.Context, msg proto.Message) error {
+func (s *Service) committeeIndexBeaconAttestationSubscriber(ctx context.Context, msg proto.Message) error {
 	a, ok := msg.(*eth.Attestation)
 	if !ok {
 		return fmt.Errorf("message was not type *eth.Attestation, type=%T", msg)
@@ -24,6 +25,12 @@ func (s *Service) committeeIndexBeaconAttestationSubscriber(_ context.Context, m
 		return errors.New("nil attestation")
 	}
 	s.setSeenCommitteeIndicesSlot(a.Data.Slot, a.Data.CommitteeIndex, a.AggregationBits)
+	if err := s.markAttestersLive(ctx, a); err != nil {
+		log.WithError(err).Debug("Could not mark attesters as live")
+	}
+	if s.cfg.AttestationObserver != nil {
+		s.cfg.AttestationObserver.ObserveAttestation(a)
+	}
 
 	exists, err := s.cfg.AttPool.HasAggregatedAttestation(a)
 	if err != nil {
@@ -36,6 +43,32 @@ func (s *Service) committeeIndexBeaconAttestationSubscriber(_ context.Context, m
 	return s.cfg.AttPool.SaveUnaggregatedAttestation(a)
 }
 
+// markAttestersLive marks the attesters of a validated attestation as live in its target epoch,
+// along with any additional validators which were observed with it, such as the aggregator.
+func (s *Service) markAttestersLive(ctx context.Context, a *eth.Attestation, others ...types.ValidatorIndex) error {
+	if s.cfg.LivenessCache == nil {
+		return nil
+	}
+	preState, err := s.cfg.Chain.AttestationPreState(ctx, a)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestation pre state")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(preState, a.Data.Slot, a.Data.CommitteeIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestation committee")
+	}
+	indices, err := attestationutil.AttestingIndices(a.AggregationBits, committee)
+	if err != nil {
+		return errors.Wrap(err, "could not get attesting indices")
+	}
+	live := others
+	for _, idx := range indices {
+		live = append(live, types.ValidatorIndex(idx))
+	}
+	s.cfg.LivenessCache.MarkLive(a.Data.Target.Epoch, live...)
+	return nil
+}
+
 func (s *Service) persistentSubnetIndices() []uint64 {
 	return cache.SubnetIDs.GetAllSubnets()
 }
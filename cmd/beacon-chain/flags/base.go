@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -143,6 +145,36 @@ var (
 		Name:  "enable-debug-rpc-endpoints",
 		Usage: "Enables the debug rpc service, containing utility endpoints such as /eth/v1alpha1/beacon/state.",
 	}
+	// EnablePeerAdminRPCEndpoints enables the peer admin service, which bans, trusts and disconnects peers.
+	EnablePeerAdminRPCEndpoints = &cli.BoolFlag{
+		Name:  "enable-peer-admin-rpc-endpoints",
+		Usage: "Enables the peer admin rpc service, containing endpoints to ban, trust and disconnect peers such as /eth/v1alpha1/admin/peers/ban.",
+	}
+	// ApiAccessConfig points to the YAML file configuring the per-route rate limits and client allowlists of the
+	// gRPC API and the HTTP gateway.
+	ApiAccessConfig = &cli.StringFlag{
+		Name: "api-access-config",
+		Usage: "Path to a YAML file with the rate limits of the API routes and the clients allowed to call them, " +
+			"identified by bearer tokens or mTLS certificates. The API is open to all clients if not set.",
+	}
+	// BlockBodyProviderURL points to an external service providing the contents of the proposed blocks.
+	BlockBodyProviderURL = &cli.StringFlag{
+		Name: "block-body-provider-url",
+		Usage: "URL of an HTTP service asked for the body, or for extra operations, of each proposed block. " +
+			"The node validates the result and falls back to its local block if it is invalid.",
+	}
+	// BlockBodyProviderTimeout is the time given to the block body provider to respond.
+	BlockBodyProviderTimeout = &cli.DurationFlag{
+		Name:  "block-body-provider-timeout",
+		Usage: "Time given to the block body provider to respond before falling back to the local block.",
+		Value: 500 * time.Millisecond,
+	}
+	// ForkChoiceJournal points to the file recording the inputs of the fork choice store.
+	ForkChoiceJournal = &cli.StringFlag{
+		Name: "fork-choice-journal",
+		Usage: "Path to a file to which all the inputs of fork choice are appended, for debugging head selection. " +
+			"The file can be replayed with the forkchoice-replay tool.",
+	}
 	// SubscribeToAllSubnets defines a flag to specify whether to subscribe to all possible attestation subnets or not.
 	SubscribeToAllSubnets = &cli.BoolFlag{
 		Name:  "subscribe-all-subnets",
@@ -182,4 +214,53 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// CheckpointStatePath defines a flag to start the beacon chain from a trusted finalized state file.
+	CheckpointStatePath = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Start syncing from a trusted finalized beacon state loaded from an ssz file, instead of from genesis. " +
+			"Must be used together with --checkpoint-block.",
+	}
+	// CheckpointBlockPath defines a flag to load the block of the trusted finalized state file.
+	CheckpointBlockPath = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "The ssz encoded signed beacon block of the state given with --checkpoint-state.",
+	}
+	// CheckpointSyncURL defines a flag to download a trusted finalized state and block from another beacon node.
+	CheckpointSyncURL = &cli.StringFlag{
+		Name: "checkpoint-sync-url",
+		Usage: "URL of a trusted beacon node API (e.g. http://localhost:3500) to download the latest finalized " +
+			"state and block from, so that the node starts syncing from that checkpoint instead of from genesis.",
+	}
+	// ExporterSinkFlag defines a flag to export blocks, finalized checkpoints, reorgs, attestations and slashings to a sink.
+	ExporterSinkFlag = &cli.StringFlag{
+		Name: "exporter-sink",
+		Usage: "Export blocks, finalized checkpoints, chain reorgs, attestations and slashings as ordered records " +
+			"to a sink for data analysis. Supported sinks are a newline-delimited JSON file (file:///path/to/records.ndjson), " +
+			"an HTTP webhook (http://localhost:8080/records) and a Kafka topic (kafka://host:9092/topic).",
+	}
+	// SlasherFlag enables the slasher of the beacon node.
+	SlasherFlag = &cli.BoolFlag{
+		Name: "slasher",
+		Usage: "Detects slashable offenses in the blocks and attestations received by the beacon node and " +
+			"inserts slashings into the slashings pool. Results in additional storage and CPU usage",
+	}
+	// SlasherDirFlag defines a flag for the directory of the slasher database.
+	SlasherDirFlag = &cli.StringFlag{
+		Name:  "slasher-datadir",
+		Usage: "Directory for the slasher database. Defaults to the data directory of the beacon node",
+	}
+	// SlashingsReportFlag defines a flag for the report of the slashings detected in the history of the chain.
+	SlashingsReportFlag = &cli.StringFlag{
+		Name: "slashings-report",
+		Usage: "JSON file the slashings detected in the history of the chain are written to. An interrupted " +
+			"run resumes from the last epoch recorded in the report. Defaults to slashings.json in the directory " +
+			"of the historical slasher database",
+	}
+	// MonitorValidatorsFlag defines a flag for the validators tracked by the validator monitor.
+	MonitorValidatorsFlag = &cli.StringSliceFlag{
+		Name: "monitor-validators",
+		Usage: "Validator indices or 0x-prefixed public keys of validators whose attestations, proposals, " +
+			"slashings, exits and balances are tracked by the beacon node, exposed as metrics and logged at " +
+			"every epoch boundary. This flag may be used multiple times",
+	}
 )
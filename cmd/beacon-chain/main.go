@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	dbcommands "github.com/prysmaticlabs/prysm/cmd/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	slashercommands "github.com/prysmaticlabs/prysm/cmd/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -55,6 +56,11 @@ var appFlags = []cli.Flag{
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
 	flags.EnableDebugRPCEndpoints,
+	flags.EnablePeerAdminRPCEndpoints,
+	flags.ApiAccessConfig,
+	flags.BlockBodyProviderURL,
+	flags.BlockBodyProviderTimeout,
+	flags.ForkChoiceJournal,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
@@ -62,6 +68,13 @@ var appFlags = []cli.Flag{
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.CheckpointStatePath,
+	flags.CheckpointBlockPath,
+	flags.CheckpointSyncURL,
+	flags.ExporterSinkFlag,
+	flags.SlasherFlag,
+	flags.SlasherDirFlag,
+	flags.MonitorValidatorsFlag,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -125,6 +138,7 @@ func main() {
 	app.Version = version.Version()
 	app.Commands = []*cli.Command{
 		dbcommands.Commands,
+		slashercommands.Commands,
 	}
 
 	app.Flags = appFlags
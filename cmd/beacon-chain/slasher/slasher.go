@@ -0,0 +1,115 @@
+package slasher
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
+	beaconslasher "github.com/prysmaticlabs/prysm/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// historicalSlasherDbDirName is the name of the directory containing the slasher database of a
+// historical run, which is kept apart from the slasher database of a running beacon node.
+const historicalSlasherDbDirName = "historicalslasherdata"
+
+var log = logrus.WithField("prefix", "slasher")
+
+// Commands for detecting slashable offenses with the slasher of the beacon node.
+var Commands = &cli.Command{
+	Name:     "slasher",
+	Category: "slasher",
+	Usage:    "defines commands for detecting slashable offenses in the history of the chain",
+	Subcommands: []*cli.Command{
+		{
+			Name: "detect-historical",
+			Description: `detects slashable offenses in the finalized blocks of a stopped beacon node's database ` +
+				`and writes the slashings found to a JSON report`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.BoltMMapInitialSizeFlag,
+				cmd.ChainConfigFileFlag,
+				flags.SlasherDirFlag,
+				flags.SlashingsReportFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureBeaconChain(cliCtx)
+				if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+					params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name))
+				}
+				if err := detectHistorical(cliCtx); err != nil {
+					log.Fatalf("Could not detect historical slashings: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+func detectHistorical(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	beaconDB, err := kv.NewKVStore(ctx, filepath.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		ReadOnly:        true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open beacon node database")
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node database")
+		}
+	}()
+
+	slasherDir := dataDir
+	if cliCtx.IsSet(flags.SlasherDirFlag.Name) {
+		slasherDir = cliCtx.String(flags.SlasherDirFlag.Name)
+	}
+	slasherDir = filepath.Join(slasherDir, historicalSlasherDbDirName)
+	slasherDB, err := beacondb.NewSlasherDB(ctx, slasherDir, &slasherkv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := slasherDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+
+	reportPath := filepath.Join(slasherDir, "slashings.json")
+	if cliCtx.IsSet(flags.SlashingsReportFlag.Name) {
+		reportPath = cliCtx.String(flags.SlashingsReportFlag.Name)
+	}
+	log.WithFields(logrus.Fields{
+		"beaconDatabasePath":  beaconDB.DatabasePath(),
+		"slasherDatabasePath": slasherDir,
+		"report":              reportPath,
+	}).Info("Starting historical slashing detection")
+	return beaconslasher.DetectHistoricalSlashings(ctx, &beaconslasher.HistoricalConfig{
+		BeaconDB:   beaconDB,
+		SlasherDB:  slasherDB,
+		ReportPath: reportPath,
+	})
+}
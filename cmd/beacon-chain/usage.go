@@ -114,6 +114,11 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
+			flags.EnablePeerAdminRPCEndpoints,
+			flags.ApiAccessConfig,
+			flags.BlockBodyProviderURL,
+			flags.BlockBodyProviderTimeout,
+			flags.ForkChoiceJournal,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
@@ -121,6 +126,13 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.CheckpointStatePath,
+			flags.CheckpointBlockPath,
+			flags.CheckpointSyncURL,
+			flags.ExporterSinkFlag,
+			flags.SlasherFlag,
+			flags.SlasherDirFlag,
+			flags.MonitorValidatorsFlag,
 		},
 	},
 	{
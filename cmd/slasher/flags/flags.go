@@ -46,6 +46,30 @@ var (
 		Usage: "RPC port exposed by the slasher",
 		Value: 4002,
 	}
+	// DisableGRPCGateway for JSON-HTTP requests to the slasher.
+	DisableGRPCGateway = &cli.BoolFlag{
+		Name:  "disable-grpc-gateway",
+		Usage: "Disable the gRPC gateway for JSON-HTTP requests",
+	}
+	// GRPCGatewayHost specifies the host of the slasher's gRPC gateway.
+	GRPCGatewayHost = &cli.StringFlag{
+		Name:  "grpc-gateway-host",
+		Usage: "The host on which the gateway server runs on",
+		Value: "127.0.0.1",
+	}
+	// GRPCGatewayPort specifies the port of the slasher's gRPC gateway.
+	GRPCGatewayPort = &cli.IntFlag{
+		Name:  "grpc-gateway-port",
+		Usage: "The port on which the gateway server runs on",
+		Value: 3502,
+	}
+	// GPRCGatewayCorsDomain serves preflight requests when serving the slasher's gRPC JSON gateway.
+	GPRCGatewayCorsDomain = &cli.StringFlag{
+		Name: "grpc-gateway-corsdomain",
+		Usage: "Comma separated list of domains from which to accept cross origin requests " +
+			"(browser enforced). This flag has no effect if not used with --grpc-gateway-port.",
+		Value: "http://localhost:4200,http://localhost:7500,http://127.0.0.1:4200,http://127.0.0.1:7500,http://0.0.0.0:4200,http://0.0.0.0:7500",
+	}
 	// EnableHistoricalDetectionFlag is a flag to enable historical detection for the slasher. Requires --historical-slasher-node on the beacon node.
 	EnableHistoricalDetectionFlag = &cli.BoolFlag{
 		Name:  "enable-historical-detection",
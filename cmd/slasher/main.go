@@ -72,6 +72,10 @@ var appFlags = []cli.Flag{
 	debug.TraceFlag,
 	flags.RPCPort,
 	flags.RPCHost,
+	flags.DisableGRPCGateway,
+	flags.GRPCGatewayHost,
+	flags.GRPCGatewayPort,
+	flags.GPRCGatewayCorsDomain,
 	flags.CertFlag,
 	flags.KeyFlag,
 	flags.BeaconCertFlag,
@@ -85,6 +85,10 @@ var appHelpFlagGroups = []flagGroup{
 			flags.KeyFlag,
 			flags.RPCPort,
 			flags.RPCHost,
+			flags.DisableGRPCGateway,
+			flags.GRPCGatewayHost,
+			flags.GRPCGatewayPort,
+			flags.GPRCGatewayCorsDomain,
 			flags.BeaconRPCProviderFlag,
 			flags.EnableHistoricalDetectionFlag,
 			flags.SpanCacheSize,
@@ -38,6 +38,13 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// EnableBeaconRESTApiFlag makes the validator client talk to the beacon node through the standard REST API.
+	EnableBeaconRESTApiFlag = &cli.BoolFlag{
+		Name: "enable-beacon-rest-api",
+		Usage: "Connects to the beacon node through the standard beacon node REST API served at " +
+			"--beacon-rpc-gateway-provider instead of Prysm's gRPC API, allowing the validator client " +
+			"to run against any compliant beacon node.",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -267,10 +274,30 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// Web3SignerURLFlag defines the base URL of a Web3Signer-compatible remote signer.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "web3signer-url",
+		Usage: "Base URL of a remote signer implementing the Web3Signer HTTP signing API, such as https://signer.example.com:9000",
+		Value: "",
+	}
+	// Web3SignerGenesisValidatorsRootFlag defines the genesis validators root sent to a Web3Signer-compatible
+	// remote signer as part of the fork info of each sign request.
+	Web3SignerGenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "web3signer-genesis-validators-root",
+		Usage: "Hex-encoded genesis validators root of the chain, sent to the Web3Signer remote signer along with each sign request",
+		Value: "",
+	}
+	// Web3SignerCACertPathFlag defines the path to a ca.crt file for a wallet to verify
+	// the TLS certificate of a Web3Signer-compatible remote signer.
+	Web3SignerCACertPathFlag = &cli.StringFlag{
+		Name:  "web3signer-ca-crt-path",
+		Usage: "/path/to/ca.crt for verifying the TLS certificate of a Web3Signer remote signer, if it is not signed by a system CA",
+		Value: "",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote, or web3signer, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation prompt for sending a deposit to the deposit contract.
@@ -292,6 +319,26 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// PerformancePublicKeysFlag defines the public keys to show the performance history of.
+	PerformancePublicKeysFlag = &cli.StringSliceFlag{
+		Name:  "performance-public-keys",
+		Usage: "Comma-separated list of public keys to show the performance history of, all keys with a history if not set",
+	}
+	// PerformanceStartEpochFlag defines the first epoch of the performance history to show.
+	PerformanceStartEpochFlag = &cli.Uint64Flag{
+		Name:  "performance-start-epoch",
+		Usage: "First epoch of the performance history to show",
+	}
+	// PerformanceEndEpochFlag defines the last epoch of the performance history to show.
+	PerformanceEndEpochFlag = &cli.Uint64Flag{
+		Name:  "performance-end-epoch",
+		Usage: "Last epoch of the performance history to show, the latest recorded epoch if not set",
+	}
+	// PerformanceShowEpochsFlag enables showing the performance of each epoch along with the summary.
+	PerformanceShowEpochsFlag = &cli.BoolFlag{
+		Name:  "performance-show-epochs",
+		Usage: "Shows the performance of each epoch in addition to the summary of each key",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
@@ -303,6 +350,27 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// EnableDoppelGangerFlag enables checking for another instance of the validator keys before signing.
+	EnableDoppelGangerFlag = &cli.BoolFlag{
+		Name: "enable-doppelganger",
+		Usage: "Enables the validator to check the network for activity of its keys before performing any " +
+			"duties, and to exit if another instance of them is found. This delays the start of duties by " +
+			"the number of epochs set with --doppelganger-epochs",
+		Value: false,
+	}
+	// DoppelGangerEpochsFlag defines the number of epochs to check for doppelgangers.
+	DoppelGangerEpochsFlag = &cli.Uint64Flag{
+		Name:  "doppelganger-epochs",
+		Usage: "The number of epochs to check for another instance of the validator keys when --enable-doppelganger is set",
+		Value: 2,
+	}
+	// EnableAttestationDataConsensusFlag enables requesting attestation data from all the configured beacon nodes.
+	EnableAttestationDataConsensusFlag = &cli.BoolFlag{
+		Name: "enable-attestation-data-consensus",
+		Usage: "Requests attestation data from all the beacon nodes set with --beacon-rpc-provider and signs the " +
+			"data backed by most of them, and broadcasts proposed blocks through all of them",
+		Value: false,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -14,6 +14,7 @@ import (
 	accountcommands "github.com/prysmaticlabs/prysm/cmd/validator/accounts"
 	dbcommands "github.com/prysmaticlabs/prysm/cmd/validator/db"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	performancecommands "github.com/prysmaticlabs/prysm/cmd/validator/performance"
 	slashingprotectioncommands "github.com/prysmaticlabs/prysm/cmd/validator/slashing-protection"
 	walletcommands "github.com/prysmaticlabs/prysm/cmd/validator/wallet"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -48,6 +49,7 @@ func startNode(ctx *cli.Context) error {
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.BeaconRPCGatewayProviderFlag,
+	flags.EnableBeaconRESTApiFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.DisablePenaltyRewardLogFlag,
@@ -73,6 +75,9 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDutyCountDown,
+	flags.EnableDoppelGangerFlag,
+	flags.DoppelGangerEpochsFlag,
+	flags.EnableAttestationDataConsensusFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
 	cmd.MinimalConfigFlag,
@@ -117,6 +122,7 @@ func main() {
 		accountcommands.Commands,
 		slashingprotectioncommands.Commands,
 		dbcommands.Commands,
+		performancecommands.Commands,
 	}
 
 	app.Flags = appFlags
@@ -0,0 +1,45 @@
+package performance
+
+import (
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/validator/performance"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for the performance history of validator keys.
+var Commands = &cli.Command{
+	Name:     "performance",
+	Category: "performance",
+	Usage:    "defines commands for inspecting the performance history recorded by your validator client",
+	Subcommands: []*cli.Command{
+		{
+			Name: "history",
+			Description: `shows the submitted and missed duties, inclusion distances and balance changes of your validator ` +
+				`keys per epoch, as recorded by the validator client. The validator client must not be running`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.PerformancePublicKeysFlag,
+				flags.PerformanceStartEpochFlag,
+				flags.PerformanceEndEpochFlag,
+				flags.PerformanceShowEpochsFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				return performance.HistoryCli(cliCtx)
+			},
+		},
+	},
+}
@@ -333,6 +333,40 @@ func Test_V1AttToV1Alpha1(t *testing.T) {
 	assert.DeepEqual(t, v1Root, alphaRoot)
 }
 
+func Test_V1SignedAggregateAttAndProofToV1Alpha1(t *testing.T) {
+	v1Att := &ethpb.SignedAggregateAttestationAndProof{
+		Message: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: validatorIndex,
+			Aggregate: &ethpb.Attestation{
+				AggregationBits: aggregationBits,
+				Data: &ethpb.AttestationData{
+					Slot:            slot,
+					Index:           committeeIndex,
+					BeaconBlockRoot: beaconBlockRoot,
+					Source: &ethpb.Checkpoint{
+						Epoch: epoch,
+						Root:  sourceRoot,
+					},
+					Target: &ethpb.Checkpoint{
+						Epoch: epoch,
+						Root:  targetRoot,
+					},
+				},
+				Signature: signature,
+			},
+			SelectionProof: signature,
+		},
+		Signature: signature,
+	}
+
+	alphaAtt := V1SignedAggregateAttAndProofToV1Alpha1(v1Att)
+	alphaRoot, err := alphaAtt.HashTreeRoot()
+	require.NoError(t, err)
+	v1Root, err := v1Att.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, v1Root, alphaRoot)
+}
+
 func Test_BlockInterfaceToV1Block(t *testing.T) {
 	v1Alpha1Block := testutil.HydrateSignedBeaconBlock(&ethpb_alpha.SignedBeaconBlock{})
 	v1Alpha1Block.Block.Slot = slot
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -64,12 +65,27 @@ func (m *ApiProxyMiddleware) PrepareRequestForProxying(endpoint Endpoint, req *h
 	req.URL.Scheme = "http"
 	req.URL.Host = m.GatewayAddress
 	req.RequestURI = ""
+	AppendForwardedFor(req)
 	if errJson := HandleURLParameters(endpoint.Path, req, endpoint.GetRequestURLLiterals); errJson != nil {
 		return errJson
 	}
 	return HandleQueryParameters(req, endpoint.GetRequestQueryParams)
 }
 
+// AppendForwardedFor appends the address of the caller to the X-Forwarded-For header of a request proxied to
+// grpc-gateway, so that the gRPC server knows the address of the original caller.
+func AppendForwardedFor(req *http.Request) {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return
+	}
+	if fwd := req.Header.Get("X-Forwarded-For"); fwd != "" {
+		req.Header.Set("X-Forwarded-For", fwd+", "+host)
+	} else {
+		req.Header.Set("X-Forwarded-For", host)
+	}
+}
+
 // HandleURLParameters processes URL parameters, allowing parameterized URLs to be safely and correctly proxied to grpc-gateway.
 func HandleURLParameters(url string, req *http.Request, literals []string) ErrorJson {
 	segments := strings.Split(url, "/")
@@ -59,6 +59,10 @@ type BeaconChainConfig struct {
 	SafeSlotsToUpdateJustified       types.Slot  `yaml:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED" spec:"true"`      // SafeSlotsToUpdateJustified is the minimal slots needed to update justified check point.
 	SecondsPerETH1Block              uint64      `yaml:"SECONDS_PER_ETH1_BLOCK" spec:"true"`              // SecondsPerETH1Block is the approximate time for a single eth1 block to be produced.
 
+	// Fork choice algorithm constants.
+	ProposerScoreBoost uint64 `yaml:"PROPOSER_SCORE_BOOST"` // ProposerScoreBoost defines the weight given to a timely block in fork choice, as a percentage of the weight of a committee.
+	IntervalsPerSlot   uint64 `yaml:"INTERVALS_PER_SLOT"`   // IntervalsPerSlot defines the number of fork choice intervals in a slot, a block is timely if it arrives during the first one.
+
 	// Ethereum PoW parameters.
 	DepositChainID         uint64 `yaml:"DEPOSIT_CHAIN_ID" spec:"true"`         // DepositChainID of the eth1 network. This used for replay protection.
 	DepositNetworkID       uint64 `yaml:"DEPOSIT_NETWORK_ID" spec:"true"`       // DepositNetworkID of the eth1 network. This used for replay protection.
@@ -6,20 +6,25 @@ package node
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"sync"
 	"syscall"
 
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd/slasher/flags"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -32,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/rpc"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // SlasherNode defines a struct that handles the services running a slashing detector
@@ -109,6 +115,10 @@ func New(cliCtx *cli.Context) (*SlasherNode, error) {
 		return nil, err
 	}
 
+	if err := slasher.registerGRPCGateway(); err != nil {
+		return nil, err
+	}
+
 	return slasher, nil
 }
 
@@ -270,14 +280,59 @@ func (n *SlasherNode) registerRPCService() error {
 	cert := n.cliCtx.String(flags.CertFlag.Name)
 	key := n.cliCtx.String(flags.KeyFlag.Name)
 	rpcService := rpc.NewService(n.ctx, &rpc.Config{
-		Host:         host,
-		Port:         port,
-		CertFlag:     cert,
-		KeyFlag:      key,
-		Detector:     detectionService,
-		SlasherDB:    n.db,
-		BeaconClient: bs,
+		Host:                  host,
+		Port:                  port,
+		CertFlag:              cert,
+		KeyFlag:               key,
+		Detector:              detectionService,
+		SlasherDB:             n.db,
+		BeaconClient:          bs,
+		AttesterSlashingsFeed: n.attesterSlashingsFeed,
+		ProposerSlashingsFeed: n.proposerSlashingsFeed,
 	})
 
 	return n.services.RegisterService(rpcService)
 }
+
+// registerGRPCGateway serves the slasher query API as JSON over HTTP.
+func (n *SlasherNode) registerGRPCGateway() error {
+	if n.cliCtx.Bool(flags.DisableGRPCGateway.Name) {
+		return nil
+	}
+	rpcAddress := fmt.Sprintf("%s:%d", n.cliCtx.String(flags.RPCHost.Name), n.cliCtx.Int(flags.RPCPort.Name))
+	gatewayAddress := fmt.Sprintf("%s:%d", n.cliCtx.String(flags.GRPCGatewayHost.Name), n.cliCtx.Int(flags.GRPCGatewayPort.Name))
+	allowedOrigins := strings.Split(n.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
+
+	mux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
+			Marshaler: &gwruntime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		}),
+		gwruntime.WithMarshalerOption(
+			"text/event-stream", &gwruntime.EventSourceJSONPb{},
+		),
+	)
+	pbHandler := gateway.PbMux{
+		Registrations: []gateway.PbHandlerRegistration{slashpb.RegisterSlasherQueryHandler},
+		Patterns:      []string{"/eth/v1alpha1/slasher/"},
+		Mux:           mux,
+	}
+	muxHandler := func(h http.Handler, w http.ResponseWriter, req *http.Request) {
+		h.ServeHTTP(w, req)
+	}
+	g := gateway.New(
+		n.ctx,
+		[]gateway.PbMux{pbHandler},
+		muxHandler,
+		rpcAddress,
+		gatewayAddress,
+	).WithAllowedOrigins(allowedOrigins).
+		WithRemoteCert(n.cliCtx.String(flags.CertFlag.Name))
+	return n.services.RegisterService(g)
+}
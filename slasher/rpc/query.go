@@ -0,0 +1,326 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxQueryEpochRange is the largest number of epochs a validator history request may span,
+// as every epoch of the range is read from the database.
+const maxQueryEpochRange = types.Epoch(1024)
+
+// detectedStatuses are the statuses of the slashings the slasher has detected.
+var detectedStatuses = []dbtypes.SlashingStatus{dbtypes.Active, dbtypes.Included, dbtypes.Reverted}
+
+// ListSlashings returns the slashings stored by the slasher which match the request filters,
+// ordered by epoch, with attester slashings before the proposer slashings of the same epoch.
+func (s *Server) ListSlashings(ctx context.Context, req *slashpb.ListSlashingsRequest) (*slashpb.ListSlashingsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "rpc.ListSlashings")
+	defer span.End()
+
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+	if req.EndEpoch != 0 && req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Start epoch %d can not be greater than end epoch %d",
+			req.StartEpoch, req.EndEpoch)
+	}
+	slashings := make([]*slashpb.DetectedSlashing, 0)
+	for _, st := range detectedStatuses {
+		if req.Type != slashpb.ListSlashingsRequest_PROPOSER {
+			attSlashings, err := s.slasherDB.AttesterSlashings(ctx, st)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve attester slashings: %v", err)
+			}
+			for _, slashing := range attSlashings {
+				if detected := detectedAttesterSlashing(slashing, st); matchesSlashingFilters(detected, req) {
+					slashings = append(slashings, detected)
+				}
+			}
+		}
+		if req.Type != slashpb.ListSlashingsRequest_ATTESTER {
+			propSlashings, err := s.slasherDB.ProposalSlashingsByStatus(ctx, st)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve proposer slashings: %v", err)
+			}
+			for _, slashing := range propSlashings {
+				if detected := detectedProposerSlashing(slashing, st); matchesSlashingFilters(detected, req) {
+					slashings = append(slashings, detected)
+				}
+			}
+		}
+	}
+	if len(slashings) == 0 {
+		return &slashpb.ListSlashingsResponse{
+			Slashings: make([]*slashpb.DetectedSlashing, 0),
+			TotalSize: int32(0),
+		}, nil
+	}
+	if err := sortSlashings(slashings); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not sort slashings: %v", err)
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(slashings))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not paginate slashings: %v", err)
+	}
+	return &slashpb.ListSlashingsResponse{
+		Slashings:     slashings[start:end],
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(slashings)),
+	}, nil
+}
+
+// StreamSlashings streams the slashings matching the request filters as soon as the
+// slasher detects them.
+func (s *Server) StreamSlashings(req *slashpb.ListSlashingsRequest, stream slashpb.SlasherQuery_StreamSlashingsServer) error {
+	if s.attesterSlashingsFeed == nil || s.proposerSlashingsFeed == nil {
+		return status.Error(codes.Unavailable, "Slashing feeds are not available")
+	}
+	if req.EndEpoch != 0 && req.StartEpoch > req.EndEpoch {
+		return status.Errorf(codes.InvalidArgument, "Start epoch %d can not be greater than end epoch %d",
+			req.StartEpoch, req.EndEpoch)
+	}
+	attSlashingsChan := make(chan *ethpb.AttesterSlashing, 1)
+	attSub := s.attesterSlashingsFeed.Subscribe(attSlashingsChan)
+	defer attSub.Unsubscribe()
+	propSlashingsChan := make(chan *ethpb.ProposerSlashing, 1)
+	propSub := s.proposerSlashingsFeed.Subscribe(propSlashingsChan)
+	defer propSub.Unsubscribe()
+	for {
+		var detected *slashpb.DetectedSlashing
+		select {
+		case slashing := <-attSlashingsChan:
+			if req.Type != slashpb.ListSlashingsRequest_PROPOSER {
+				detected = detectedAttesterSlashing(slashing, dbtypes.Active)
+			}
+		case slashing := <-propSlashingsChan:
+			if req.Type != slashpb.ListSlashingsRequest_ATTESTER {
+				detected = detectedProposerSlashing(slashing, dbtypes.Active)
+			}
+		case <-attSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-propSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		if detected == nil || !matchesSlashingFilters(detected, req) {
+			continue
+		}
+		if err := stream.Send(detected); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+}
+
+// ValidatorSpans returns the min/max spans the slasher holds for a validator in an epoch range.
+// Pages cover epochs of the range, and epochs without spans for the validator are left out.
+func (s *Server) ValidatorSpans(ctx context.Context, req *slashpb.ValidatorHistoryRequest) (*slashpb.ValidatorSpansResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "rpc.ValidatorSpans")
+	defer span.End()
+
+	latestEpoch, err := s.slasherDB.GetLatestEpochDetected(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve latest epoch detected: %v", err)
+	}
+	startEpoch, endEpoch, err := historyEpochRange(req, latestEpoch)
+	if err != nil {
+		return nil, err
+	}
+	totalSize := int(endEpoch-startEpoch) + 1
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not paginate spans: %v", err)
+	}
+	spans := make([]*slashpb.EpochSpan, 0, end-start)
+	for epoch := startEpoch + types.Epoch(start); epoch < startEpoch+types.Epoch(end); epoch++ {
+		es, err := s.slasherDB.EpochSpans(ctx, epoch, dbtypes.UseDB)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve spans of epoch %d: %v", epoch, err)
+		}
+		validatorSpan, err := es.GetValidatorSpan(uint64(req.ValidatorIndex))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve span of epoch %d: %v", epoch, err)
+		}
+		if validatorSpan.MinSpan == 0 && validatorSpan.MaxSpan == 0 && !validatorSpan.HasAttested {
+			continue
+		}
+		spans = append(spans, &slashpb.EpochSpan{
+			Epoch:           epoch,
+			MinSpan:         uint32(validatorSpan.MinSpan),
+			MaxSpan:         uint32(validatorSpan.MaxSpan),
+			SignaturePrefix: validatorSpan.SigBytes[:],
+			HasAttested:     validatorSpan.HasAttested,
+		})
+	}
+	return &slashpb.ValidatorSpansResponse{
+		Spans:         spans,
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(totalSize),
+	}, nil
+}
+
+// ValidatorAttestations returns the indexed attestations the slasher holds for a validator,
+// ordered by target epoch.
+func (s *Server) ValidatorAttestations(
+	ctx context.Context, req *slashpb.ValidatorHistoryRequest,
+) (*slashpb.ValidatorAttestationsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "rpc.ValidatorAttestations")
+	defer span.End()
+
+	latestEpoch, err := s.slasherDB.LatestIndexedAttestationsTargetEpoch(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve latest attestation target epoch: %v", err)
+	}
+	startEpoch, endEpoch, err := historyEpochRange(req, types.Epoch(latestEpoch))
+	if err != nil {
+		return nil, err
+	}
+	atts := make([]*ethpb.IndexedAttestation, 0)
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		epochAtts, err := s.slasherDB.IndexedAttestationsForTarget(ctx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve attestations of epoch %d: %v", epoch, err)
+		}
+		for _, att := range epochAtts {
+			if sliceutil.IsInUint64(uint64(req.ValidatorIndex), att.AttestingIndices) {
+				atts = append(atts, att)
+			}
+		}
+	}
+	if len(atts) == 0 {
+		return &slashpb.ValidatorAttestationsResponse{
+			Attestations: make([]*ethpb.IndexedAttestation, 0),
+			TotalSize:    int32(0),
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(atts))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not paginate attestations: %v", err)
+	}
+	return &slashpb.ValidatorAttestationsResponse{
+		Attestations:  atts[start:end],
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(atts)),
+	}, nil
+}
+
+// historyEpochRange validates a validator history request and returns its epoch range, where
+// an unset end epoch defaults to the latest epoch.
+func historyEpochRange(req *slashpb.ValidatorHistoryRequest, latestEpoch types.Epoch) (types.Epoch, types.Epoch, error) {
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+	endEpoch := req.EndEpoch
+	if endEpoch == 0 {
+		endEpoch = latestEpoch
+	}
+	if req.StartEpoch > endEpoch {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "Start epoch %d can not be greater than end epoch %d",
+			req.StartEpoch, endEpoch)
+	}
+	if endEpoch-req.StartEpoch >= maxQueryEpochRange {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "Requested epoch range can not span more than %d epochs",
+			maxQueryEpochRange)
+	}
+	return req.StartEpoch, endEpoch, nil
+}
+
+func detectedAttesterSlashing(slashing *ethpb.AttesterSlashing, st dbtypes.SlashingStatus) *slashpb.DetectedSlashing {
+	indices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	validatorIndices := make([]types.ValidatorIndex, len(indices))
+	for i, idx := range indices {
+		validatorIndices[i] = types.ValidatorIndex(idx)
+	}
+	sort.Slice(validatorIndices, func(i, j int) bool {
+		return validatorIndices[i] < validatorIndices[j]
+	})
+	epoch := slashing.Attestation_1.Data.Target.Epoch
+	if slashing.Attestation_2.Data.Target.Epoch > epoch {
+		epoch = slashing.Attestation_2.Data.Target.Epoch
+	}
+	return &slashpb.DetectedSlashing{
+		Slashing:         &slashpb.DetectedSlashing_AttesterSlashing{AttesterSlashing: slashing},
+		ValidatorIndices: validatorIndices,
+		Epoch:            epoch,
+		Status:           slashpb.DetectedSlashing_Status(st),
+	}
+}
+
+func detectedProposerSlashing(slashing *ethpb.ProposerSlashing, st dbtypes.SlashingStatus) *slashpb.DetectedSlashing {
+	return &slashpb.DetectedSlashing{
+		Slashing:         &slashpb.DetectedSlashing_ProposerSlashing{ProposerSlashing: slashing},
+		ValidatorIndices: []types.ValidatorIndex{slashing.Header_1.Header.ProposerIndex},
+		Epoch:            helpers.SlotToEpoch(slashing.Header_1.Header.Slot),
+		Status:           slashpb.DetectedSlashing_Status(st),
+	}
+}
+
+func matchesSlashingFilters(slashing *slashpb.DetectedSlashing, req *slashpb.ListSlashingsRequest) bool {
+	if slashing.Epoch < req.StartEpoch || (req.EndEpoch != 0 && slashing.Epoch > req.EndEpoch) {
+		return false
+	}
+	if len(req.ValidatorIndices) == 0 {
+		return true
+	}
+	for _, idx := range slashing.ValidatorIndices {
+		for _, wanted := range req.ValidatorIndices {
+			if idx == wanted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortSlashings orders slashings by epoch, then attester slashings before proposer slashings,
+// then by hash tree root, so that pages are stable across requests.
+func sortSlashings(slashings []*slashpb.DetectedSlashing) error {
+	roots := make(map[*slashpb.DetectedSlashing][32]byte, len(slashings))
+	for _, slashing := range slashings {
+		var root [32]byte
+		var err error
+		switch sl := slashing.Slashing.(type) {
+		case *slashpb.DetectedSlashing_AttesterSlashing:
+			root, err = sl.AttesterSlashing.HashTreeRoot()
+		case *slashpb.DetectedSlashing_ProposerSlashing:
+			root, err = sl.ProposerSlashing.HashTreeRoot()
+		}
+		if err != nil {
+			return err
+		}
+		roots[slashing] = root
+	}
+	sort.Slice(slashings, func(i, j int) bool {
+		if slashings[i].Epoch != slashings[j].Epoch {
+			return slashings[i].Epoch < slashings[j].Epoch
+		}
+		iAttester := slashings[i].GetAttesterSlashing() != nil
+		jAttester := slashings[j].GetAttesterSlashing() != nil
+		if iAttester != jAttester {
+			return iAttester
+		}
+		iRoot, jRoot := roots[slashings[i]], roots[slashings[j]]
+		return bytes.Compare(iRoot[:], jRoot[:]) < 0
+	})
+	return nil
+}
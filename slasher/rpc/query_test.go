@@ -0,0 +1,229 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	dbtypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"google.golang.org/grpc"
+)
+
+func TestServer_ListSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	server := Server{ctx: ctx, slasherDB: db}
+
+	attSlashing1 := testAttesterSlashing([]uint64{1, 2}, []uint64{2, 3}, 4)
+	attSlashing2 := testAttesterSlashing([]uint64{5}, []uint64{5}, 2)
+	propSlashing := testProposerSlashing(7, 96)
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Active, attSlashing1))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, dbtypes.Included, attSlashing2))
+	require.NoError(t, db.SaveProposerSlashing(ctx, dbtypes.Active, propSlashing))
+
+	res, err := server.ListSlashings(ctx, &slashpb.ListSlashingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.TotalSize)
+	require.Equal(t, 3, len(res.Slashings))
+	assert.DeepEqual(t, attSlashing2, res.Slashings[0].GetAttesterSlashing())
+	assert.Equal(t, slashpb.DetectedSlashing_INCLUDED, res.Slashings[0].Status)
+	assert.DeepEqual(t, []types.ValidatorIndex{5}, res.Slashings[0].ValidatorIndices)
+	assert.Equal(t, types.Epoch(2), res.Slashings[0].Epoch)
+	assert.DeepEqual(t, propSlashing, res.Slashings[1].GetProposerSlashing())
+	assert.Equal(t, types.Epoch(3), res.Slashings[1].Epoch)
+	assert.DeepEqual(t, attSlashing1, res.Slashings[2].GetAttesterSlashing())
+	assert.DeepEqual(t, []types.ValidatorIndex{2}, res.Slashings[2].ValidatorIndices)
+	assert.Equal(t, slashpb.DetectedSlashing_ACTIVE, res.Slashings[2].Status)
+
+	res, err = server.ListSlashings(ctx, &slashpb.ListSlashingsRequest{ValidatorIndices: []types.ValidatorIndex{2, 7}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Slashings))
+	assert.NotNil(t, res.Slashings[0].GetProposerSlashing())
+	assert.NotNil(t, res.Slashings[1].GetAttesterSlashing())
+
+	res, err = server.ListSlashings(ctx, &slashpb.ListSlashingsRequest{StartEpoch: 3, EndEpoch: 3})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.NotNil(t, res.Slashings[0].GetProposerSlashing())
+
+	res, err = server.ListSlashings(ctx, &slashpb.ListSlashingsRequest{Type: slashpb.ListSlashingsRequest_ATTESTER})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), res.TotalSize)
+
+	res, err = server.ListSlashings(ctx, &slashpb.ListSlashingsRequest{PageSize: 2, PageToken: "1"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashings))
+	assert.DeepEqual(t, attSlashing1, res.Slashings[0].GetAttesterSlashing())
+	assert.Equal(t, "", res.NextPageToken)
+
+	res, err = server.ListSlashings(ctx, &slashpb.ListSlashingsRequest{ValidatorIndices: []types.ValidatorIndex{100}})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), res.TotalSize)
+	assert.Equal(t, 0, len(res.Slashings))
+
+	_, err = server.ListSlashings(ctx, &slashpb.ListSlashingsRequest{StartEpoch: 3, EndEpoch: 2})
+	assert.ErrorContains(t, "can not be greater than end epoch", err)
+}
+
+func TestServer_StreamSlashings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := Server{
+		ctx:                   context.Background(),
+		attesterSlashingsFeed: new(event.Feed),
+		proposerSlashingsFeed: new(event.Feed),
+	}
+	stream := &mockSlashingsStream{ctx: ctx, sent: make(chan *slashpb.DetectedSlashing, 1)}
+	exitRoutine := make(chan bool)
+	go func() {
+		assert.ErrorContains(t, "Context canceled", server.StreamSlashings(&slashpb.ListSlashingsRequest{
+			ValidatorIndices: []types.ValidatorIndex{3},
+		}, stream))
+		<-exitRoutine
+	}()
+	// The proposer slashing does not match the filter and is not streamed.
+	for sent := 0; sent == 0; {
+		sent = server.proposerSlashingsFeed.Send(testProposerSlashing(2, 1))
+	}
+	server.attesterSlashingsFeed.Send(testAttesterSlashing([]uint64{3}, []uint64{3, 4}, 5))
+	detected := <-stream.sent
+	assert.DeepEqual(t, []types.ValidatorIndex{3}, detected.ValidatorIndices)
+	assert.Equal(t, types.Epoch(5), detected.Epoch)
+	assert.Equal(t, slashpb.DetectedSlashing_ACTIVE, detected.Status)
+	cancel()
+	exitRoutine <- true
+}
+
+func TestServer_ValidatorSpans(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	server := Server{ctx: ctx, slasherDB: db}
+
+	for _, epoch := range []types.Epoch{1, 3} {
+		es, err := slashertypes.NewEpochStore([]byte{})
+		require.NoError(t, err)
+		es, err = es.SetValidatorSpan(2, slashertypes.Span{
+			MinSpan:     uint16(epoch),
+			MaxSpan:     uint16(epoch + 1),
+			SigBytes:    [2]byte{1, 2},
+			HasAttested: epoch == 3,
+		})
+		require.NoError(t, err)
+		require.NoError(t, db.SaveEpochSpans(ctx, epoch, es, dbtypes.UseDB))
+	}
+	require.NoError(t, db.SetLatestEpochDetected(ctx, 4))
+
+	res, err := server.ValidatorSpans(ctx, &slashpb.ValidatorHistoryRequest{ValidatorIndex: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), res.TotalSize)
+	require.Equal(t, 2, len(res.Spans))
+	assert.DeepEqual(t, &slashpb.EpochSpan{
+		Epoch:           3,
+		MinSpan:         3,
+		MaxSpan:         4,
+		SignaturePrefix: []byte{1, 2},
+		HasAttested:     true,
+	}, res.Spans[1])
+
+	res, err = server.ValidatorSpans(ctx, &slashpb.ValidatorHistoryRequest{ValidatorIndex: 2, PageSize: 2, PageToken: "1"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Spans))
+	assert.Equal(t, types.Epoch(3), res.Spans[0].Epoch)
+	assert.Equal(t, "2", res.NextPageToken)
+
+	res, err = server.ValidatorSpans(ctx, &slashpb.ValidatorHistoryRequest{ValidatorIndex: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Spans))
+
+	_, err = server.ValidatorSpans(ctx, &slashpb.ValidatorHistoryRequest{ValidatorIndex: 2, EndEpoch: maxQueryEpochRange})
+	assert.ErrorContains(t, "can not span more than", err)
+}
+
+func TestServer_ValidatorAttestations(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	server := Server{ctx: ctx, slasherDB: db}
+
+	atts := []*ethpb.IndexedAttestation{
+		testIndexedAttestation([]uint64{1, 2}, 0, 1, 'a'),
+		testIndexedAttestation([]uint64{3}, 1, 2, 'b'),
+		testIndexedAttestation([]uint64{2}, 2, 3, 'c'),
+	}
+	require.NoError(t, db.SaveIndexedAttestations(ctx, atts))
+
+	res, err := server.ValidatorAttestations(ctx, &slashpb.ValidatorHistoryRequest{ValidatorIndex: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), res.TotalSize)
+	require.Equal(t, 2, len(res.Attestations))
+	assert.DeepEqual(t, atts[0], res.Attestations[0])
+	assert.DeepEqual(t, atts[2], res.Attestations[1])
+
+	res, err = server.ValidatorAttestations(ctx, &slashpb.ValidatorHistoryRequest{ValidatorIndex: 2, StartEpoch: 2})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Attestations))
+	assert.DeepEqual(t, atts[2], res.Attestations[0])
+
+	res, err = server.ValidatorAttestations(ctx, &slashpb.ValidatorHistoryRequest{ValidatorIndex: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Attestations))
+}
+
+type mockSlashingsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *slashpb.DetectedSlashing
+}
+
+func (m *mockSlashingsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSlashingsStream) Send(slashing *slashpb.DetectedSlashing) error {
+	m.sent <- slashing
+	return nil
+}
+
+func testIndexedAttestation(indices []uint64, source, target types.Epoch, root byte) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: indices,
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: bytesutil.PadTo([]byte{root}, 32),
+			Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+		},
+		Signature: bytesutil.PadTo([]byte{root}, 96),
+	}
+}
+
+func testAttesterSlashing(indices1, indices2 []uint64, target types.Epoch) *ethpb.AttesterSlashing {
+	return &ethpb.AttesterSlashing{
+		Attestation_1: testIndexedAttestation(indices1, target-1, target, 'a'),
+		Attestation_2: testIndexedAttestation(indices2, target-1, target, 'b'),
+	}
+}
+
+func testProposerSlashing(proposerIndex types.ValidatorIndex, slot types.Slot) *ethpb.ProposerSlashing {
+	header := func(root byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposerIndex,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      bytesutil.PadTo([]byte{root}, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.ProposerSlashing{
+		Header_1: header('a'),
+		Header_2: header('b'),
+	}
+}
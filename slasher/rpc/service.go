@@ -13,6 +13,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
@@ -43,6 +44,10 @@ type Config struct {
 	Detector     *detection.Service
 	SlasherDB    db.Database
 	BeaconClient *beaconclient.Service
+	// AttesterSlashingsFeed and ProposerSlashingsFeed carry the slashings found by the
+	// detector, which are streamed to clients of the query API.
+	AttesterSlashingsFeed *event.Feed
+	ProposerSlashingsFeed *event.Feed
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -101,12 +106,15 @@ func (s *Service) Start() {
 	s.grpcServer = grpc.NewServer(opts...)
 
 	slasherServer := &Server{
-		ctx:          s.ctx,
-		detector:     s.cfg.Detector,
-		slasherDB:    s.cfg.SlasherDB,
-		beaconClient: s.cfg.BeaconClient,
+		ctx:                   s.ctx,
+		detector:              s.cfg.Detector,
+		slasherDB:             s.cfg.SlasherDB,
+		beaconClient:          s.cfg.BeaconClient,
+		attesterSlashingsFeed: s.cfg.AttesterSlashingsFeed,
+		proposerSlashingsFeed: s.cfg.ProposerSlashingsFeed,
 	}
 	slashpb.RegisterSlasherServer(s.grpcServer, slasherServer)
+	slashpb.RegisterSlasherQueryServer(s.grpcServer, slasherServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
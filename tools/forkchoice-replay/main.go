@@ -0,0 +1,106 @@
+/**
+ * Fork choice replay
+ *
+ * Replays the journal recorded by a beacon node with --fork-choice-journal into a fresh fork choice store.
+ * It reports the head computed at each recorded head computation, the weights of the nodes with --weights,
+ * and where the replay diverges from the head the node computed.
+ *
+ * Usage: forkchoice-replay --journal /path/to/forkchoice.journal [--weights] [--diverged-only]
+ */
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/journal"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "forkchoice_replay")
+
+var (
+	journalPath     = flag.String("journal", "", "Path to the fork choice journal recorded by the beacon node.")
+	chainConfigFile = flag.String("chain-config-file", "", "Path to the YAML chain config of the network, mainnet is used if not set.")
+	weights         = flag.Bool("weights", false, "Print the weights of the nodes at each head computation.")
+	divergedOnly    = flag.Bool("diverged-only", false, "Only print the head computations which diverge from the journal.")
+)
+
+func main() {
+	flag.Parse()
+	if *journalPath == "" {
+		log.Fatal("--journal is required")
+	}
+	if *chainConfigFile != "" {
+		params.LoadChainConfigFile(*chainConfigFile)
+	}
+
+	f, err := os.Open(*journalPath)
+	if err != nil {
+		log.WithError(err).Fatal("Could not open journal")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close journal")
+		}
+	}()
+
+	sum, err := replay(context.Background(), f, os.Stdout, *weights, *divergedOnly)
+	if err != nil {
+		log.WithError(err).Error("Could not replay the whole journal")
+	}
+
+	fmt.Printf("Replayed %d head computations, %d diverged\n", sum.steps, sum.diverged)
+	if sum.firstDiverged >= 0 {
+		fmt.Printf("First divergence at entry %d\n", sum.firstDiverged)
+	}
+}
+
+// summary of the replay of a journal.
+type summary struct {
+	steps         int      // number of replayed head computations.
+	diverged      int      // number of head computations diverging from the journal.
+	firstDiverged int      // entry of the first diverging head computation, -1 if none diverged.
+	head          [32]byte // head computed by the last replayed head computation.
+}
+
+// replay replays the journal read from r, and prints the replayed head computations to out. The summary
+// covers the entries replayed before an error.
+func replay(ctx context.Context, r io.Reader, out io.Writer, weights, divergedOnly bool) (*summary, error) {
+	sum := &summary{firstDiverged: -1}
+	err := journal.Replay(ctx, journal.NewReader(r), func(s *journal.Step) error {
+		sum.steps++
+		sum.head = s.Head
+		if s.Diverged() {
+			sum.diverged++
+			if sum.firstDiverged < 0 {
+				sum.firstDiverged = s.Entry
+			}
+		} else if divergedOnly {
+			return nil
+		}
+
+		status := "ok"
+		if s.Diverged() {
+			status = "DIVERGED"
+		}
+		fmt.Fprintf(out, "entry %d: head %#x, recorded %#x: %s\n", s.Entry, s.Head, s.Recorded, status)
+		if s.Err != nil {
+			fmt.Fprintf(out, "  replay error: %v\n", s.Err)
+		}
+		if s.RecordedFailed {
+			fmt.Fprintln(out, "  recorded head computation failed")
+		}
+		if weights {
+			for _, n := range s.Nodes {
+				fmt.Fprintf(out, "  node %#x slot %d weight %d\n", n.Root(), n.Slot(), n.Weight())
+			}
+		}
+		return nil
+	})
+	return sum, err
+}
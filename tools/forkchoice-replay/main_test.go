@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/journal"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func Test_replay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "forkchoice.journal")
+	genesis := [32]byte{'g'}
+	balances := []uint64{10, 10, 10}
+
+	// Record a run in which head moves from block a to block b as more validators vote for b.
+	w, err := journal.NewWriter(path)
+	require.NoError(t, err)
+	r := journal.New(w, 0, 0, [32]byte{})
+	require.NoError(t, r.ProcessBlock(ctx, 0, genesis, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, r.ProcessBlock(ctx, 1, [32]byte{'a'}, genesis, [32]byte{}, 0, 0))
+	require.NoError(t, r.ProcessBlock(ctx, 1, [32]byte{'b'}, genesis, [32]byte{}, 0, 0))
+	r.ProcessAttestation(ctx, []uint64{0}, [32]byte{'a'}, 0)
+	head, err := r.Head(ctx, 0, genesis, balances, 0)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{'a'}, head)
+	r.ProcessAttestation(ctx, []uint64{1, 2}, [32]byte{'b'}, 0)
+	head, err = r.Head(ctx, 0, genesis, balances, 0)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{'b'}, head)
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	out := new(bytes.Buffer)
+	sum, err := replay(ctx, f, out, true /* weights */, false /* divergedOnly */)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sum.steps)
+	assert.Equal(t, 0, sum.diverged)
+	assert.Equal(t, -1, sum.firstDiverged)
+	assert.Equal(t, [32]byte{'b'}, sum.head)
+	assert.Equal(t, true, bytes.Contains(out.Bytes(), []byte(fmt.Sprintf("head %#x, recorded %#x: ok", head, head))))
+	assert.Equal(t, true, bytes.Contains(out.Bytes(), []byte(fmt.Sprintf("node %#x slot 1 weight 20", head))))
+}
+
+func Test_replay_Diverged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "forkchoice.journal")
+	w, err := journal.NewWriter(path)
+	require.NoError(t, err)
+	genesis := [32]byte{'g'}
+	for _, e := range []*journal.Entry{
+		{Kind: journal.KindNew},
+		{Kind: journal.KindBlock, Root: genesis},
+		{Kind: journal.KindBlock, Slot: 1, Root: [32]byte{'a'}, ParentRoot: genesis},
+		{Kind: journal.KindHead, JustifiedRoot: genesis, Root: [32]byte{'a'}},
+		{Kind: journal.KindHead, JustifiedRoot: genesis, Root: [32]byte{'b'}},
+	} {
+		require.NoError(t, w.Write(e))
+	}
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	out := new(bytes.Buffer)
+	sum, err := replay(context.Background(), f, out, false /* weights */, true /* divergedOnly */)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sum.steps)
+	assert.Equal(t, 1, sum.diverged)
+	assert.Equal(t, 4, sum.firstDiverged)
+	assert.Equal(t, [32]byte{'a'}, sum.head)
+	// Only the diverging head computation is printed.
+	assert.Equal(t, fmt.Sprintf("entry 4: head %#x, recorded %#x: DIVERGED\n", [32]byte{'a'}, [32]byte{'b'}), out.String())
+}
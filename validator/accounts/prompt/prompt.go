@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/logrusorgru/aurora"
 	"github.com/manifoldco/promptui"
 	"github.com/pkg/errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -141,6 +143,69 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	url := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	root := cliCtx.String(flags.Web3SignerGenesisValidatorsRootFlag.Name)
+	ca := cliCtx.String(flags.Web3SignerCACertPathFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if url == "" {
+		url, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as https://signer.example.com:9000)",
+			validateURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if root == "" {
+		root, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Genesis validators root of the chain (such as 0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95 for mainnet)",
+			validateGenesisValidatorsRoot)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := validateGenesisValidatorsRoot(root); err != nil {
+		return nil, err
+	}
+
+	caPath := ""
+	if ca != "" {
+		caPath, err = fileutil.ExpandPath(strings.TrimRight(ca, "\r\n"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", ca)
+		}
+	}
+
+	newCfg := &web3signer.KeymanagerOpts{
+		URL:                   strings.TrimRight(url, "\r\n"),
+		GenesisValidatorsRoot: strings.TrimRight(root, "\r\n"),
+		CACertPath:            caPath,
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+func validateURL(input string) error {
+	if !strings.HasPrefix(input, "http://") && !strings.HasPrefix(input, "https://") {
+		return errors.New("URL must start with http:// or https://")
+	}
+	return nil
+}
+
+func validateGenesisValidatorsRoot(input string) error {
+	root, err := hexutil.Decode(strings.TrimRight(input, "\r\n"))
+	if err != nil {
+		return errors.Wrap(err, "not a valid hex string")
+	}
+	if len(root) != 32 {
+		return fmt.Errorf("genesis validators root must be 32 bytes, got %d", len(root))
+	}
+	return nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -75,3 +76,52 @@ func TestEditWalletConfiguration(t *testing.T) {
 	assert.NoError(t, err)
 	assert.DeepEqual(t, wantCfg, cfg)
 }
+
+func TestEditWalletConfiguration_Web3Signer(t *testing.T) {
+	walletDir, _, passwordFile := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:      walletDir,
+		keymanagerKind: keymanager.Web3Signer,
+	})
+	originalCfg := &web3signer.KeymanagerOpts{
+		URL:                   "http://localhost:9000",
+		GenesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+	}
+	wallet, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      walletDir,
+			KeymanagerKind: keymanager.Web3Signer,
+			WalletPassword: "Passwordz0320$",
+		},
+		Web3SignerKeymanagerOpts: originalCfg,
+	})
+	require.NoError(t, err)
+
+	wantCfg := &web3signer.KeymanagerOpts{
+		URL:                   "https://signer.example.com:9000",
+		GenesisValidatorsRoot: "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb",
+		CACertPath:            "/tmp/ca.crt",
+	}
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, passwordFile, "")
+	set.String(flags.Web3SignerURLFlag.Name, wantCfg.URL, "")
+	set.String(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot, "")
+	set.String(flags.Web3SignerCACertPathFlag.Name, wantCfg.CACertPath, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, passwordFile))
+	assert.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, wantCfg.URL))
+	assert.NoError(t, set.Set(flags.Web3SignerGenesisValidatorsRootFlag.Name, wantCfg.GenesisValidatorsRoot))
+	assert.NoError(t, set.Set(flags.Web3SignerCACertPathFlag.Name, wantCfg.CACertPath))
+	cliCtx = cli.NewContext(&app, set, nil)
+
+	err = EditWalletConfigurationCli(cliCtx)
+	require.NoError(t, err)
+	encoded, err := wallet.ReadKeymanagerConfigFromDisk(cliCtx.Context)
+	require.NoError(t, err)
+
+	cfg, err := web3signer.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+	assert.DeepEqual(t, wantCfg, cfg)
+}
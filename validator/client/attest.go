@@ -68,12 +68,16 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		log.Debug("Empty committee for validator duty, not attesting")
 		return
 	}
+	epoch := helpers.SlotToEpoch(slot)
+	v.recordPerformance(pubKey, epoch, func(p *validatorpb.EpochPerformance) {
+		p.AttestationAssigned = true
+	})
 
 	req := &ethpb.AttestationDataRequest{
 		Slot:           slot,
 		CommitteeIndex: duty.CommitteeIndex,
 	}
-	data, err := v.validatorClient.GetAttestationData(ctx, req)
+	data, err := v.attestationData(ctx, req)
 	if err != nil {
 		log.WithError(err).Error("Could not request attestation to sign at slot")
 		if v.emitAccountMetrics {
@@ -152,6 +156,9 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		traceutil.AnnotateError(span, err)
 		return
 	}
+	v.recordPerformance(pubKey, epoch, func(p *validatorpb.EpochPerformance) {
+		p.AttestationSubmitted = true
+	})
 
 	if err := v.saveAttesterIndexToData(data, duty.ValidatorIndex); err != nil {
 		log.WithError(err).Error("Could not save validator index for logging")
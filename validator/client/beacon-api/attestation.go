@@ -0,0 +1,108 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// GetAttestationData retrieves the attestation data to be signed by an attester of the given slot and committee.
+func (c *Client) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	resp := &attestationDataResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/attestation_data?slot=%d&committee_index=%d", in.Slot, in.CommitteeIndex)
+	if err := c.get(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get attestation data")
+	}
+	d := &fieldDecoder{}
+	data := attestationDataFromJson(d, resp.Data)
+	if d.err != nil {
+		return nil, d.err
+	}
+	return data, nil
+}
+
+// ProposeAttestation submits a signed attestation to the beacon node's pool.
+func (c *Client) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	if in == nil || in.Data == nil {
+		return nil, errors.New("attestation is nil")
+	}
+	root, err := in.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/pool/attestations", []*attestationJson{attestationToJson(in)}, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit attestation")
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof retrieves the best aggregate of the attestations made by the aggregator's committee,
+// wrapped together with the aggregator's selection proof.
+func (c *Client) SubmitAggregateSelectionProof(
+	ctx context.Context,
+	in *ethpb.AggregateSelectionRequest,
+	_ ...grpc.CallOption,
+) (*ethpb.AggregateSelectionResponse, error) {
+	indexResp, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: in.Slot, CommitteeIndex: in.CommitteeIndex})
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+
+	resp := &aggregateAttestationResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/aggregate_attestation?attestation_data_root=%s&slot=%d", hexutil.Encode(root[:]), in.Slot)
+	if err := c.get(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get aggregate attestation")
+	}
+	d := &fieldDecoder{}
+	aggregate := attestationFromJson(d, resp.Data)
+	if d.err != nil {
+		return nil, d.err
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: indexResp.Index,
+			Aggregate:       aggregate,
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof publishes a signed aggregate and proof.
+func (c *Client) SubmitSignedAggregateSelectionProof(
+	ctx context.Context,
+	in *ethpb.SignedAggregateSubmitRequest,
+	_ ...grpc.CallOption,
+) (*ethpb.SignedAggregateSubmitResponse, error) {
+	signed := in.SignedAggregateAndProof
+	if signed == nil || signed.Message == nil || signed.Message.Aggregate == nil || signed.Message.Aggregate.Data == nil {
+		return nil, errors.New("signed aggregate and proof is nil")
+	}
+	root, err := signed.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	req := []*signedAggregateAttestationAndProofJson{{
+		Message: &aggregateAttestationAndProofJson{
+			AggregatorIndex: uint64ToString(uint64(signed.Message.AggregatorIndex)),
+			Aggregate:       attestationToJson(signed.Message.Aggregate),
+			SelectionProof:  hexutil.Encode(signed.Message.SelectionProof),
+		},
+		Signature: hexutil.Encode(signed.Signature),
+	}}
+	if err := c.post(ctx, "/eth/v1/validator/aggregate_and_proofs", req, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit signed aggregate and proof")
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
@@ -0,0 +1,169 @@
+package beaconapi
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// genesis retrieves the genesis information of the chain. The beacon node responds
+// with HTTP 404 for as long as the chain has not started.
+func (c *Client) genesis(ctx context.Context) (*genesisJson, error) {
+	resp := &genesisResponseJson{}
+	if err := c.get(ctx, "/eth/v1/beacon/genesis", resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("genesis data is nil")
+	}
+	return resp.Data, nil
+}
+
+// DomainData computes the signature domain for the requested epoch and domain type,
+// based on the fork of the head state.
+func (c *Client) DomainData(ctx context.Context, in *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	genesis, err := c.genesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	forkResp := &stateForkResponseJson{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/fork", forkResp); err != nil {
+		return nil, errors.Wrap(err, "could not get fork")
+	}
+	d := &fieldDecoder{}
+	genesisValidatorsRoot := d.bytes("genesis validators root", genesis.GenesisValidatorsRoot)
+	var fork *pb.Fork
+	if d.notNil("fork", forkResp.Data == nil) {
+		fork = &pb.Fork{
+			PreviousVersion: d.bytes("previous fork version", forkResp.Data.PreviousVersion),
+			CurrentVersion:  d.bytes("current fork version", forkResp.Data.CurrentVersion),
+			Epoch:           types.Epoch(d.uint64("fork epoch", forkResp.Data.Epoch)),
+		}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	domain, err := helpers.Domain(fork, in.Epoch, bytesutil.ToBytes4(in.Domain), genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute domain")
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
+
+// GetSyncStatus retrieves whether the beacon node is syncing.
+func (c *Client) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	resp := &syncingResponseJson{}
+	if err := c.get(ctx, "/eth/v1/node/syncing", resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("sync status is nil")
+	}
+	return &ethpb.SyncStatus{Syncing: resp.Data.IsSyncing}, nil
+}
+
+// GetChainHead retrieves the head block and the checkpoints of the head state.
+func (c *Client) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	headerResp := &blockHeaderResponseJson{}
+	if err := c.get(ctx, "/eth/v1/beacon/headers/head", headerResp); err != nil {
+		return nil, errors.Wrap(err, "could not get head block header")
+	}
+	checkpointsResp := &stateFinalityCheckpointResponseJson{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", checkpointsResp); err != nil {
+		return nil, errors.Wrap(err, "could not get finality checkpoints")
+	}
+
+	d := &fieldDecoder{}
+	if !d.notNil("head block header", headerResp.Data == nil) || !d.notNil("finality checkpoints", checkpointsResp.Data == nil) {
+		return nil, d.err
+	}
+	header := signedHeaderFromJson(d, headerResp.Data.Header)
+	headRoot := d.bytes("head block root", headerResp.Data.Root)
+	finalized := checkpointFromJson(d, checkpointsResp.Data.Finalized)
+	justified := checkpointFromJson(d, checkpointsResp.Data.CurrentJustified)
+	prevJustified := checkpointFromJson(d, checkpointsResp.Data.PreviousJustified)
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	head := &ethpb.ChainHead{
+		HeadSlot:                   header.Header.Slot,
+		HeadEpoch:                  helpers.SlotToEpoch(header.Header.Slot),
+		HeadBlockRoot:              headRoot,
+		FinalizedEpoch:             finalized.Epoch,
+		FinalizedBlockRoot:         finalized.Root,
+		JustifiedEpoch:             justified.Epoch,
+		JustifiedBlockRoot:         justified.Root,
+		PreviousJustifiedEpoch:     prevJustified.Epoch,
+		PreviousJustifiedBlockRoot: prevJustified.Root,
+	}
+	var err error
+	if head.FinalizedSlot, err = helpers.StartSlot(finalized.Epoch); err != nil {
+		return nil, err
+	}
+	if head.JustifiedSlot, err = helpers.StartSlot(justified.Epoch); err != nil {
+		return nil, err
+	}
+	if head.PreviousJustifiedSlot, err = helpers.StartSlot(prevJustified.Epoch); err != nil {
+		return nil, err
+	}
+	return head, nil
+}
+
+// GetValidatorPerformance is not supported, as the standard API exposes no equivalent of Prysm's performance report.
+func (c *Client) GetValidatorPerformance(
+	_ context.Context,
+	_ *ethpb.ValidatorPerformanceRequest,
+	_ ...grpc.CallOption,
+) (*ethpb.ValidatorPerformanceResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// CheckDoppelGanger checks whether any of the given keys was seen attesting or proposing in the requested epoch,
+// using the beacon node's liveness endpoint. Keys which are not in the beacon state are reported as not live.
+func (c *Client) CheckDoppelGanger(
+	ctx context.Context,
+	in *ethpb.DoppelGangerRequest,
+	_ ...grpc.CallOption,
+) (*ethpb.DoppelGangerResponse, error) {
+	vals, err := c.validatorsByPubKey(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]string, 0, len(vals))
+	for _, v := range vals {
+		indices = append(indices, v.Index)
+	}
+	live := make(map[string]bool, len(indices))
+	if len(indices) > 0 {
+		resp := &livenessResponseJson{}
+		endpoint := "/eth/v1/validator/liveness/" + uint64ToString(uint64(in.Epoch))
+		if err := c.post(ctx, endpoint, indices, resp); err != nil {
+			return nil, errors.Wrap(err, "could not get validator liveness")
+		}
+		for _, l := range resp.Data {
+			if l != nil {
+				live[l.Index] = l.IsLive
+			}
+		}
+	}
+
+	resp := &ethpb.DoppelGangerResponse{
+		Responses: make([]*ethpb.DoppelGangerResponse_ValidatorResponse, len(in.PublicKeys)),
+	}
+	for i, pk := range in.PublicKeys {
+		v, ok := vals[bytesutil.ToBytes48(pk)]
+		resp.Responses[i] = &ethpb.DoppelGangerResponse_ValidatorResponse{
+			PublicKey:       pk,
+			DuplicateExists: ok && live[v.Index],
+		}
+	}
+	return resp, nil
+}
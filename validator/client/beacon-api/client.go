@@ -0,0 +1,129 @@
+// Package beaconapi implements the beacon node interfaces of the validator client
+// on top of the standard beacon node REST API https://ethereum.github.io/eth2.0-APIs/#/,
+// allowing a Prysm validator client to run against any compliant beacon node.
+package beaconapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+)
+
+var (
+	_ = iface.ValidatorClient(&Client{})
+	_ = iface.NodeClient(&Client{})
+	_ = iface.BeaconChainClient(&Client{})
+)
+
+// ErrNotSupported is returned for calls which have no equivalent in the standard API.
+var ErrNotSupported = errors.New("not supported by the standard beacon node API")
+
+// apiError is an error response returned by the beacon node.
+type apiError struct {
+	code    int
+	message string
+}
+
+// Error returns the underlying error message.
+func (e *apiError) Error() string {
+	return fmt.Sprintf("beacon node responded with code %d: %s", e.code, e.message)
+}
+
+// subscriptionKey identifies a committee by its slot and index.
+type subscriptionKey struct {
+	slot           types.Slot
+	committeeIndex types.CommitteeIndex
+}
+
+// subscriptionInfo holds the duty information which the standard API requires
+// for committee subnet subscriptions, but which Prysm's subscription requests do not carry.
+type subscriptionInfo struct {
+	validatorIndex   types.ValidatorIndex
+	committeesAtSlot uint64
+}
+
+// Client talks to a beacon node over the standard REST API.
+type Client struct {
+	host             string
+	httpClient       *http.Client
+	subscriptionLock sync.RWMutex
+	subscriptions    map[subscriptionKey]*subscriptionInfo
+}
+
+// NewClient creates a client for the beacon node REST API served at host, e.g. http://127.0.0.1:3500.
+func NewClient(host string, timeout time.Duration) *Client {
+	return &Client{
+		host:          strings.TrimSuffix(host, "/"),
+		httpClient:    &http.Client{Timeout: timeout},
+		subscriptions: make(map[subscriptionKey]*subscriptionInfo),
+	}
+}
+
+// get sends a GET request to the endpoint and decodes the JSON response into resp.
+func (c *Client) get(ctx context.Context, endpoint string, resp interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.host+endpoint, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not create request for %s", endpoint)
+	}
+	return c.do(req, endpoint, resp)
+}
+
+// post sends a POST request with a JSON body to the endpoint and decodes the JSON response, if any, into resp.
+func (c *Client) post(ctx context.Context, endpoint string, body, resp interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal request for %s", endpoint)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.host+endpoint, bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrapf(err, "could not create request for %s", endpoint)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, endpoint, resp)
+}
+
+func (c *Client) do(req *http.Request, endpoint string, resp interface{}) error {
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not query %s", endpoint)
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "could not read response from %s", endpoint)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		e := &errorJson{}
+		if err := json.Unmarshal(body, e); err != nil || e.Message == "" {
+			return &apiError{code: httpResp.StatusCode, message: string(body)}
+		}
+		return &apiError{code: httpResp.StatusCode, message: e.Message}
+	}
+	if resp == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return errors.Wrapf(err, "could not decode response from %s", endpoint)
+	}
+	return nil
+}
+
+// isNotFound checks whether the beacon node responded with HTTP 404.
+func isNotFound(err error) bool {
+	var e *apiError
+	return errors.As(err, &e) && e.code == http.StatusNotFound
+}
@@ -0,0 +1,464 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// setupClient starts a stand-in beacon node serving the given handlers, and returns a client connected to it.
+func setupClient(t *testing.T, handlers map[string]http.HandlerFunc) *Client {
+	mux := http.NewServeMux()
+	for path, h := range handlers {
+		mux.HandleFunc(path, h)
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL, time.Second)
+}
+
+// respond returns a handler which writes v as the JSON response.
+func respond(t *testing.T, v interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+}
+
+// decodeBody decodes the JSON request body into v.
+func decodeBody(t *testing.T, r *http.Request, v interface{}) {
+	b, err := ioutil.ReadAll(r.Body)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, v))
+}
+
+func pubKey(i byte) []byte {
+	return bytesutil.PadTo([]byte{i}, 48)
+}
+
+func validatorContainer(index uint64, pk []byte, status string) *validatorContainerJson {
+	return &validatorContainerJson{
+		Index:  uint64ToString(index),
+		Status: status,
+		Validator: &validatorJson{
+			PublicKey:       hexutil.Encode(pk),
+			ActivationEpoch: "0",
+		},
+	}
+}
+
+func TestClient_ErrorResponse(t *testing.T) {
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, err := w.Write([]byte(`{"code":503,"message":"Beacon node is currently syncing"}`))
+			require.NoError(t, err)
+		},
+	})
+	_, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	assert.ErrorContains(t, "beacon node responded with code 503: Beacon node is currently syncing", err)
+	assert.Equal(t, false, isNotFound(err))
+}
+
+func TestClient_GetSyncStatus(t *testing.T) {
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/node/syncing": respond(t, &syncingResponseJson{Data: &syncInfoJson{HeadSlot: "10", SyncDistance: "5", IsSyncing: true}}),
+	})
+	s, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, s.Syncing)
+}
+
+func TestClient_MultipleValidatorStatus(t *testing.T) {
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			assert.DeepEqual(t, []string{hexutil.Encode(pubKey(1)), hexutil.Encode(pubKey(2)), "7"}, r.URL.Query()["id"])
+			respond(t, &stateValidatorsResponseJson{Data: []*validatorContainerJson{
+				validatorContainer(3, pubKey(1), "active_ongoing"),
+				validatorContainer(7, pubKey(7), "pending_queued"),
+			}})(w, r)
+		},
+	})
+	resp, err := c.MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{pubKey(1), pubKey(2)},
+		Indices:    []int64{7},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey(1), pubKey(2), pubKey(7)}, resp.PublicKeys)
+	assert.DeepEqual(t, []types.ValidatorIndex{3, nonexistentIndex, 7}, resp.Indices)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_PENDING, resp.Statuses[2].Status)
+}
+
+func TestClient_ValidatorIndex_NotFound(t *testing.T) {
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": respond(t, &stateValidatorsResponseJson{Data: []*validatorContainerJson{}}),
+	})
+	_, err := c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey(1)})
+	assert.ErrorContains(t, "could not find validator index", err)
+}
+
+func TestClient_CheckDoppelGanger(t *testing.T) {
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": respond(t, &stateValidatorsResponseJson{Data: []*validatorContainerJson{
+			validatorContainer(3, pubKey(1), "active_ongoing"),
+			validatorContainer(5, pubKey(2), "active_ongoing"),
+		}}),
+		"/eth/v1/validator/liveness/7": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			var indices []string
+			decodeBody(t, r, &indices)
+			assert.Equal(t, 2, len(indices))
+			respond(t, &livenessResponseJson{Data: []*validatorLivenessJson{
+				{Index: "3", IsLive: true},
+				{Index: "5", IsLive: false},
+			}})(w, r)
+		},
+	})
+	resp, err := c.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		Epoch:      7,
+		PublicKeys: [][]byte{pubKey(1), pubKey(2), pubKey(3)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Responses))
+	assert.DeepEqual(t, pubKey(1), resp.Responses[0].PublicKey)
+	assert.Equal(t, true, resp.Responses[0].DuplicateExists)
+	assert.Equal(t, false, resp.Responses[1].DuplicateExists)
+	// Keys which are not in the beacon state can not have been live.
+	assert.Equal(t, false, resp.Responses[2].DuplicateExists)
+}
+
+func TestClient_GetDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": respond(t, &stateValidatorsResponseJson{Data: []*validatorContainerJson{
+			validatorContainer(3, pubKey(1), "active_ongoing"),
+		}}),
+		"/eth/v1/validator/duties/attester/": func(w http.ResponseWriter, r *http.Request) {
+			assert.DeepEqual(t, []string{"3"}, r.URL.Query()["index"])
+			var epoch uint64
+			_, err := fmt.Sscanf(r.URL.Path, "/eth/v1/validator/duties/attester/%d", &epoch)
+			require.NoError(t, err)
+			respond(t, &attesterDutiesResponseJson{Data: []*attesterDutyJson{{
+				Pubkey:                  hexutil.Encode(pubKey(1)),
+				ValidatorIndex:          "3",
+				CommitteeIndex:          "1",
+				CommitteeLength:         "2",
+				CommitteesAtSlot:        "4",
+				ValidatorCommitteeIndex: "1",
+				Slot:                    uint64ToString(epoch*slotsPerEpoch + 2),
+			}}})(w, r)
+		},
+		"/eth/v1/beacon/states/head/committees": func(w http.ResponseWriter, r *http.Request) {
+			var epoch uint64
+			_, err := fmt.Sscanf(r.URL.Query().Get("epoch"), "%d", &epoch)
+			require.NoError(t, err)
+			respond(t, &stateCommitteesResponseJson{Data: []*committeeJson{
+				{Index: "0", Slot: uint64ToString(epoch*slotsPerEpoch + 2), Validators: []string{"1", "2"}},
+				{Index: "1", Slot: uint64ToString(epoch*slotsPerEpoch + 2), Validators: []string{"5", "3"}},
+			}})(w, r)
+		},
+		"/eth/v1/validator/duties/proposer/1": respond(t, &proposerDutiesResponseJson{Data: []*proposerDutyJson{
+			{Pubkey: hexutil.Encode(pubKey(1)), ValidatorIndex: "3", Slot: "9"},
+			{Pubkey: hexutil.Encode(pubKey(9)), ValidatorIndex: "8", Slot: "10"},
+		}}),
+		"/eth/v1/validator/beacon_committee_subscriptions": func(w http.ResponseWriter, r *http.Request) {
+			var subs []*beaconCommitteeSubscribeJson
+			decodeBody(t, r, &subs)
+			assert.DeepEqual(t, []*beaconCommitteeSubscribeJson{{
+				ValidatorIndex:   "3",
+				CommitteeIndex:   "1",
+				CommitteesAtSlot: "4",
+				Slot:             "10",
+				IsAggregator:     true,
+			}}, subs)
+		},
+	})
+
+	resp, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{pubKey(1), pubKey(2)}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	duty := resp.CurrentEpochDuties[0]
+	assert.DeepEqual(t, []types.ValidatorIndex{5, 3}, duty.Committee)
+	assert.Equal(t, types.CommitteeIndex(1), duty.CommitteeIndex)
+	assert.Equal(t, types.Slot(10), duty.AttesterSlot)
+	assert.DeepEqual(t, []types.Slot{9}, duty.ProposerSlots)
+	assert.Equal(t, types.ValidatorIndex(3), duty.ValidatorIndex)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, duty.Status)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.CurrentEpochDuties[1].Status)
+	assert.Equal(t, 0, len(resp.CurrentEpochDuties[1].Committee))
+
+	require.Equal(t, 2, len(resp.NextEpochDuties))
+	nextDuty := resp.NextEpochDuties[0]
+	assert.Equal(t, types.Slot(18), nextDuty.AttesterSlot)
+	assert.Equal(t, 0, len(nextDuty.ProposerSlots))
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{10},
+		CommitteeIds: []types.CommitteeIndex{1},
+		IsAggregator: []bool{true},
+	})
+	require.NoError(t, err)
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{11},
+		CommitteeIds: []types.CommitteeIndex{1},
+		IsAggregator: []bool{true},
+	})
+	assert.ErrorContains(t, "no known duty for committee 1 at slot 11", err)
+}
+
+func TestClient_ProposeAttestation(t *testing.T) {
+	att := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}})
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/pool/attestations": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			var atts []*attestationJson
+			decodeBody(t, r, &atts)
+			require.Equal(t, 1, len(atts))
+			d := &fieldDecoder{}
+			assert.DeepEqual(t, att, attestationFromJson(d, atts[0]))
+			require.NoError(t, d.err)
+		},
+	})
+	resp, err := c.ProposeAttestation(context.Background(), att)
+	require.NoError(t, err)
+	root, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.AttestationDataRoot)
+}
+
+func TestClient_SubmitAggregateSelectionProof(t *testing.T) {
+	data := testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 5, CommitteeIndex: 2})
+	root, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	aggregate := &ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1111}, Data: data, Signature: make([]byte, 96)}
+
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": respond(t, &stateValidatorsResponseJson{Data: []*validatorContainerJson{
+			validatorContainer(3, pubKey(1), "active_ongoing"),
+		}}),
+		"/eth/v1/validator/attestation_data": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "5", r.URL.Query().Get("slot"))
+			assert.Equal(t, "2", r.URL.Query().Get("committee_index"))
+			respond(t, &attestationDataResponseJson{Data: attestationDataToJson(data)})(w, r)
+		},
+		"/eth/v1/validator/aggregate_attestation": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, hexutil.Encode(root[:]), r.URL.Query().Get("attestation_data_root"))
+			respond(t, &aggregateAttestationResponseJson{Data: attestationToJson(aggregate)})(w, r)
+		},
+	})
+	resp, err := c.SubmitAggregateSelectionProof(context.Background(), &ethpb.AggregateSelectionRequest{
+		Slot:           5,
+		CommitteeIndex: 2,
+		PublicKey:      pubKey(1),
+		SlotSignature:  []byte{'a'},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(3), resp.AggregateAndProof.AggregatorIndex)
+	assert.DeepEqual(t, []byte{'a'}, resp.AggregateAndProof.SelectionProof)
+	assert.DeepEqual(t, aggregate, resp.AggregateAndProof.Aggregate)
+}
+
+func TestClient_GetBlock_ProposeBlock(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 3
+	blk.Block.Body.Graffiti = bytesutil.PadTo([]byte("graffiti"), 32)
+	blk.Block.Body.Attestations = []*ethpb.Attestation{testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b11}})}
+	blkJson, err := signedBlockToJson(blk)
+	require.NoError(t, err)
+
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/blocks/3": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, hexutil.Encode(blk.Block.Body.RandaoReveal), r.URL.Query().Get("randao_reveal"))
+			assert.Equal(t, hexutil.Encode(blk.Block.Body.Graffiti), r.URL.Query().Get("graffiti"))
+			respond(t, &produceBlockResponseJson{Data: blkJson.Message})(w, r)
+		},
+		"/eth/v1/beacon/blocks": func(w http.ResponseWriter, r *http.Request) {
+			posted := &signedBeaconBlockJson{}
+			decodeBody(t, r, posted)
+			assert.DeepEqual(t, blkJson, posted)
+		},
+	})
+
+	got, err := c.GetBlock(context.Background(), &ethpb.BlockRequest{
+		Slot:         3,
+		RandaoReveal: blk.Block.Body.RandaoReveal,
+		Graffiti:     blk.Block.Body.Graffiti,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk.Block, got)
+
+	resp, err := c.ProposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.BlockRoot)
+}
+
+func TestClient_DomainData(t *testing.T) {
+	genesisValidatorsRoot := bytesutil.PadTo([]byte("root"), 32)
+	fork := &pb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: []byte{1, 0, 0, 0}, Epoch: 10}
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": respond(t, &genesisResponseJson{Data: &genesisJson{
+			GenesisTime:           "100",
+			GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+			GenesisForkVersion:    "0x00000000",
+		}}),
+		"/eth/v1/beacon/states/head/fork": respond(t, &stateForkResponseJson{Data: &forkJson{
+			PreviousVersion: hexutil.Encode(fork.PreviousVersion),
+			CurrentVersion:  hexutil.Encode(fork.CurrentVersion),
+			Epoch:           "10",
+		}}),
+	})
+	domainType := params.BeaconConfig().DomainBeaconAttester
+	for _, epoch := range []types.Epoch{9, 10} {
+		resp, err := c.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: epoch, Domain: domainType[:]})
+		require.NoError(t, err)
+		want, err := helpers.Domain(fork, epoch, domainType, genesisValidatorsRoot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, resp.SignatureDomain)
+	}
+}
+
+func TestClient_WaitForChainStart(t *testing.T) {
+	genesisValidatorsRoot := bytesutil.PadTo([]byte("root"), 32)
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": respond(t, &genesisResponseJson{Data: &genesisJson{
+			GenesisTime:           "100",
+			GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+			GenesisForkVersion:    "0x00000000",
+		}}),
+	})
+	stream, err := c.WaitForChainStart(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Started)
+	assert.Equal(t, uint64(100), resp.GenesisTime)
+	assert.DeepEqual(t, genesisValidatorsRoot, resp.GenesisValidatorsRoot)
+}
+
+func TestClient_WaitForChainStart_ContextCanceled(t *testing.T) {
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.WaitForChainStart(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	cancel()
+	_, err = stream.Recv()
+	assert.ErrorContains(t, "context canceled", err)
+}
+
+func TestClient_WaitForActivation(t *testing.T) {
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": respond(t, &stateValidatorsResponseJson{Data: []*validatorContainerJson{
+			validatorContainer(3, pubKey(1), "active_ongoing"),
+		}}),
+	})
+	stream, err := c.WaitForActivation(context.Background(), &ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{pubKey(1), pubKey(2)}})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Statuses))
+	assert.Equal(t, types.ValidatorIndex(3), resp.Statuses[0].Index)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status.Status)
+	assert.Equal(t, nonexistentIndex, resp.Statuses[1].Index)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status.Status)
+}
+
+func TestClient_GetChainHead(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	header := testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 20}})
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/headers/head": respond(t, &blockHeaderResponseJson{Data: &blockHeaderContainerJson{
+			Root:      hexutil.Encode(headRoot),
+			Canonical: true,
+			Header:    signedHeaderToJson(header),
+		}}),
+		"/eth/v1/beacon/states/head/finality_checkpoints": respond(t, &stateFinalityCheckpointResponseJson{Data: &finalityCheckpointsJson{
+			PreviousJustified: checkpointToJson(&ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("prev"), 32)}),
+			CurrentJustified:  checkpointToJson(&ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("just"), 32)}),
+			Finalized:         checkpointToJson(&ethpb.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("fin"), 32)}),
+		}}),
+	})
+	head, err := c.GetChainHead(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), head.HeadSlot)
+	assert.Equal(t, types.Epoch(2), head.HeadEpoch)
+	assert.DeepEqual(t, headRoot, head.HeadBlockRoot)
+	assert.Equal(t, types.Epoch(1), head.JustifiedEpoch)
+	assert.Equal(t, types.Slot(8), head.JustifiedSlot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("just"), 32), head.JustifiedBlockRoot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("prev"), 32), head.PreviousJustifiedBlockRoot)
+	assert.Equal(t, types.Epoch(0), head.FinalizedEpoch)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("fin"), 32), head.FinalizedBlockRoot)
+}
+
+func TestClient_StreamBlocks(t *testing.T) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 4
+	blkJson, err := signedBlockToJson(blk)
+	require.NoError(t, err)
+	root := hexutil.Encode(bytesutil.PadTo([]byte("root"), 32))
+
+	c := setupClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/events": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "block", r.URL.Query().Get("topics"))
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, err := fmt.Fprintf(w, "event: head\ndata: {}\n\nevent: block\ndata: {\"slot\":\"4\",\"block\":\"%s\"}\n\n", root)
+			require.NoError(t, err)
+		},
+		"/eth/v1/beacon/blocks/" + root: respond(t, &blockResponseJson{Data: blkJson}),
+	})
+	stream, err := c.StreamBlocks(context.Background(), &ethpb.StreamBlocksRequest{VerifiedOnly: true})
+	require.NoError(t, err)
+	got, err := stream.Recv()
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, got)
+	_, err = stream.Recv()
+	assert.ErrorContains(t, "could not read event stream", err)
+	require.NoError(t, stream.CloseSend())
+}
+
+func TestValidatorStatusFromJson(t *testing.T) {
+	tests := map[string]ethpb.ValidatorStatus{
+		"pending_initialized": ethpb.ValidatorStatus_DEPOSITED,
+		"pending_queued":      ethpb.ValidatorStatus_PENDING,
+		"active_ongoing":      ethpb.ValidatorStatus_ACTIVE,
+		"active_exiting":      ethpb.ValidatorStatus_EXITING,
+		"active_slashed":      ethpb.ValidatorStatus_SLASHING,
+		"exited_unslashed":    ethpb.ValidatorStatus_EXITED,
+		"withdrawal_done":     ethpb.ValidatorStatus_EXITED,
+		"foo":                 ethpb.ValidatorStatus_UNKNOWN_STATUS,
+	}
+	for s, want := range tests {
+		assert.Equal(t, want, validatorStatusFromJson(s), s)
+	}
+}
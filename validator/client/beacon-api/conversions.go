@@ -0,0 +1,369 @@
+package beaconapi
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// fieldDecoder decodes the string encoded fields of JSON responses, remembering the first error it encounters.
+// This keeps conversions of deeply nested objects readable, as the error only has to be checked once at the end.
+type fieldDecoder struct {
+	err error
+}
+
+func (d *fieldDecoder) uint64(name, s string) uint64 {
+	if d.err != nil {
+		return 0
+	}
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		d.err = errors.Wrapf(err, "could not decode %s", name)
+		return 0
+	}
+	return u
+}
+
+func (d *fieldDecoder) bytes(name, s string) []byte {
+	if d.err != nil {
+		return nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		d.err = errors.Wrapf(err, "could not decode %s", name)
+		return nil
+	}
+	return b
+}
+
+func (d *fieldDecoder) notNil(name string, isNil bool) bool {
+	if d.err == nil && isNil {
+		d.err = errors.Errorf("%s is missing", name)
+	}
+	return d.err == nil
+}
+
+func uint64ToString(u uint64) string {
+	return strconv.FormatUint(u, 10)
+}
+
+func checkpointFromJson(d *fieldDecoder, c *checkpointJson) *ethpb.Checkpoint {
+	if !d.notNil("checkpoint", c == nil) {
+		return nil
+	}
+	return &ethpb.Checkpoint{
+		Epoch: types.Epoch(d.uint64("checkpoint epoch", c.Epoch)),
+		Root:  d.bytes("checkpoint root", c.Root),
+	}
+}
+
+func attestationDataFromJson(d *fieldDecoder, a *attestationDataJson) *ethpb.AttestationData {
+	if !d.notNil("attestation data", a == nil) {
+		return nil
+	}
+	return &ethpb.AttestationData{
+		Slot:            types.Slot(d.uint64("attestation slot", a.Slot)),
+		CommitteeIndex:  types.CommitteeIndex(d.uint64("attestation committee index", a.CommitteeIndex)),
+		BeaconBlockRoot: d.bytes("attestation beacon block root", a.BeaconBlockRoot),
+		Source:          checkpointFromJson(d, a.Source),
+		Target:          checkpointFromJson(d, a.Target),
+	}
+}
+
+func attestationFromJson(d *fieldDecoder, a *attestationJson) *ethpb.Attestation {
+	if !d.notNil("attestation", a == nil) {
+		return nil
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist(d.bytes("aggregation bits", a.AggregationBits)),
+		Data:            attestationDataFromJson(d, a.Data),
+		Signature:       d.bytes("attestation signature", a.Signature),
+	}
+}
+
+func indexedAttestationFromJson(d *fieldDecoder, a *indexedAttestationJson) *ethpb.IndexedAttestation {
+	if !d.notNil("indexed attestation", a == nil) {
+		return nil
+	}
+	indices := make([]uint64, len(a.AttestingIndices))
+	for i, idx := range a.AttestingIndices {
+		indices[i] = d.uint64("attesting index", idx)
+	}
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             attestationDataFromJson(d, a.Data),
+		Signature:        d.bytes("indexed attestation signature", a.Signature),
+	}
+}
+
+func signedHeaderFromJson(d *fieldDecoder, h *signedBeaconBlockHeaderJson) *ethpb.SignedBeaconBlockHeader {
+	if !d.notNil("signed block header", h == nil || h.Message == nil) {
+		return nil
+	}
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:          types.Slot(d.uint64("header slot", h.Message.Slot)),
+			ProposerIndex: types.ValidatorIndex(d.uint64("header proposer index", h.Message.ProposerIndex)),
+			ParentRoot:    d.bytes("header parent root", h.Message.ParentRoot),
+			StateRoot:     d.bytes("header state root", h.Message.StateRoot),
+			BodyRoot:      d.bytes("header body root", h.Message.BodyRoot),
+		},
+		Signature: d.bytes("header signature", h.Signature),
+	}
+}
+
+func beaconBlockFromJson(d *fieldDecoder, b *beaconBlockJson) *ethpb.BeaconBlock {
+	if !d.notNil("block", b == nil || b.Body == nil || b.Body.Eth1Data == nil) {
+		return nil
+	}
+	body := b.Body
+	proposerSlashings := make([]*ethpb.ProposerSlashing, len(body.ProposerSlashings))
+	for i, s := range body.ProposerSlashings {
+		if !d.notNil("proposer slashing", s == nil) {
+			return nil
+		}
+		proposerSlashings[i] = &ethpb.ProposerSlashing{
+			Header_1: signedHeaderFromJson(d, s.Header_1),
+			Header_2: signedHeaderFromJson(d, s.Header_2),
+		}
+	}
+	attesterSlashings := make([]*ethpb.AttesterSlashing, len(body.AttesterSlashings))
+	for i, s := range body.AttesterSlashings {
+		if !d.notNil("attester slashing", s == nil) {
+			return nil
+		}
+		attesterSlashings[i] = &ethpb.AttesterSlashing{
+			Attestation_1: indexedAttestationFromJson(d, s.Attestation_1),
+			Attestation_2: indexedAttestationFromJson(d, s.Attestation_2),
+		}
+	}
+	atts := make([]*ethpb.Attestation, len(body.Attestations))
+	for i, a := range body.Attestations {
+		atts[i] = attestationFromJson(d, a)
+	}
+	deposits := make([]*ethpb.Deposit, len(body.Deposits))
+	for i, dep := range body.Deposits {
+		if !d.notNil("deposit", dep == nil || dep.Data == nil) {
+			return nil
+		}
+		proof := make([][]byte, len(dep.Proof))
+		for j, p := range dep.Proof {
+			proof[j] = d.bytes("deposit proof", p)
+		}
+		deposits[i] = &ethpb.Deposit{
+			Proof: proof,
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             d.bytes("deposit public key", dep.Data.PublicKey),
+				WithdrawalCredentials: d.bytes("deposit withdrawal credentials", dep.Data.WithdrawalCredentials),
+				Amount:                d.uint64("deposit amount", dep.Data.Amount),
+				Signature:             d.bytes("deposit signature", dep.Data.Signature),
+			},
+		}
+	}
+	exits := make([]*ethpb.SignedVoluntaryExit, len(body.VoluntaryExits))
+	for i, e := range body.VoluntaryExits {
+		if !d.notNil("voluntary exit", e == nil || e.Exit == nil) {
+			return nil
+		}
+		exits[i] = &ethpb.SignedVoluntaryExit{
+			Exit: &ethpb.VoluntaryExit{
+				Epoch:          types.Epoch(d.uint64("exit epoch", e.Exit.Epoch)),
+				ValidatorIndex: types.ValidatorIndex(d.uint64("exit validator index", e.Exit.ValidatorIndex)),
+			},
+			Signature: d.bytes("exit signature", e.Signature),
+		}
+	}
+	return &ethpb.BeaconBlock{
+		Slot:          types.Slot(d.uint64("block slot", b.Slot)),
+		ProposerIndex: types.ValidatorIndex(d.uint64("block proposer index", b.ProposerIndex)),
+		ParentRoot:    d.bytes("block parent root", b.ParentRoot),
+		StateRoot:     d.bytes("block state root", b.StateRoot),
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: d.bytes("randao reveal", body.RandaoReveal),
+			Eth1Data: &ethpb.Eth1Data{
+				DepositRoot:  d.bytes("eth1 deposit root", body.Eth1Data.DepositRoot),
+				DepositCount: d.uint64("eth1 deposit count", body.Eth1Data.DepositCount),
+				BlockHash:    d.bytes("eth1 block hash", body.Eth1Data.BlockHash),
+			},
+			Graffiti:          d.bytes("graffiti", body.Graffiti),
+			ProposerSlashings: proposerSlashings,
+			AttesterSlashings: attesterSlashings,
+			Attestations:      atts,
+			Deposits:          deposits,
+			VoluntaryExits:    exits,
+		},
+	}
+}
+
+func checkpointToJson(c *ethpb.Checkpoint) *checkpointJson {
+	if c == nil {
+		return nil
+	}
+	return &checkpointJson{
+		Epoch: uint64ToString(uint64(c.Epoch)),
+		Root:  hexutil.Encode(c.Root),
+	}
+}
+
+func attestationDataToJson(a *ethpb.AttestationData) *attestationDataJson {
+	if a == nil {
+		return nil
+	}
+	return &attestationDataJson{
+		Slot:            uint64ToString(uint64(a.Slot)),
+		CommitteeIndex:  uint64ToString(uint64(a.CommitteeIndex)),
+		BeaconBlockRoot: hexutil.Encode(a.BeaconBlockRoot),
+		Source:          checkpointToJson(a.Source),
+		Target:          checkpointToJson(a.Target),
+	}
+}
+
+func attestationToJson(a *ethpb.Attestation) *attestationJson {
+	if a == nil {
+		return nil
+	}
+	return &attestationJson{
+		AggregationBits: hexutil.Encode(a.AggregationBits),
+		Data:            attestationDataToJson(a.Data),
+		Signature:       hexutil.Encode(a.Signature),
+	}
+}
+
+func indexedAttestationToJson(a *ethpb.IndexedAttestation) *indexedAttestationJson {
+	if a == nil {
+		return nil
+	}
+	indices := make([]string, len(a.AttestingIndices))
+	for i, idx := range a.AttestingIndices {
+		indices[i] = uint64ToString(idx)
+	}
+	return &indexedAttestationJson{
+		AttestingIndices: indices,
+		Data:             attestationDataToJson(a.Data),
+		Signature:        hexutil.Encode(a.Signature),
+	}
+}
+
+func signedHeaderToJson(h *ethpb.SignedBeaconBlockHeader) *signedBeaconBlockHeaderJson {
+	if h == nil || h.Header == nil {
+		return nil
+	}
+	return &signedBeaconBlockHeaderJson{
+		Message: &beaconBlockHeaderJson{
+			Slot:          uint64ToString(uint64(h.Header.Slot)),
+			ProposerIndex: uint64ToString(uint64(h.Header.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(h.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Header.BodyRoot),
+		},
+		Signature: hexutil.Encode(h.Signature),
+	}
+}
+
+func signedExitToJson(e *ethpb.SignedVoluntaryExit) *signedVoluntaryExitJson {
+	if e == nil || e.Exit == nil {
+		return nil
+	}
+	return &signedVoluntaryExitJson{
+		Exit: &voluntaryExitJson{
+			Epoch:          uint64ToString(uint64(e.Exit.Epoch)),
+			ValidatorIndex: uint64ToString(uint64(e.Exit.ValidatorIndex)),
+		},
+		Signature: hexutil.Encode(e.Signature),
+	}
+}
+
+func signedBlockToJson(b *ethpb.SignedBeaconBlock) (*signedBeaconBlockJson, error) {
+	if b == nil || b.Block == nil || b.Block.Body == nil || b.Block.Body.Eth1Data == nil {
+		return nil, errors.New("block is nil")
+	}
+	body := b.Block.Body
+	proposerSlashings := make([]*proposerSlashingJson, len(body.ProposerSlashings))
+	for i, s := range body.ProposerSlashings {
+		proposerSlashings[i] = &proposerSlashingJson{
+			Header_1: signedHeaderToJson(s.Header_1),
+			Header_2: signedHeaderToJson(s.Header_2),
+		}
+	}
+	attesterSlashings := make([]*attesterSlashingJson, len(body.AttesterSlashings))
+	for i, s := range body.AttesterSlashings {
+		attesterSlashings[i] = &attesterSlashingJson{
+			Attestation_1: indexedAttestationToJson(s.Attestation_1),
+			Attestation_2: indexedAttestationToJson(s.Attestation_2),
+		}
+	}
+	atts := make([]*attestationJson, len(body.Attestations))
+	for i, a := range body.Attestations {
+		atts[i] = attestationToJson(a)
+	}
+	deposits := make([]*depositJson, len(body.Deposits))
+	for i, d := range body.Deposits {
+		if d.Data == nil {
+			return nil, errors.New("deposit data is nil")
+		}
+		proof := make([]string, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = hexutil.Encode(p)
+		}
+		deposits[i] = &depositJson{
+			Proof: proof,
+			Data: &deposit_DataJson{
+				PublicKey:             hexutil.Encode(d.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(d.Data.WithdrawalCredentials),
+				Amount:                uint64ToString(d.Data.Amount),
+				Signature:             hexutil.Encode(d.Data.Signature),
+			},
+		}
+	}
+	exits := make([]*signedVoluntaryExitJson, len(body.VoluntaryExits))
+	for i, e := range body.VoluntaryExits {
+		exits[i] = signedExitToJson(e)
+	}
+	return &signedBeaconBlockJson{
+		Message: &beaconBlockJson{
+			Slot:          uint64ToString(uint64(b.Block.Slot)),
+			ProposerIndex: uint64ToString(uint64(b.Block.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(b.Block.ParentRoot),
+			StateRoot:     hexutil.Encode(b.Block.StateRoot),
+			Body: &beaconBlockBodyJson{
+				RandaoReveal: hexutil.Encode(body.RandaoReveal),
+				Eth1Data: &eth1DataJson{
+					DepositRoot:  hexutil.Encode(body.Eth1Data.DepositRoot),
+					DepositCount: uint64ToString(body.Eth1Data.DepositCount),
+					BlockHash:    hexutil.Encode(body.Eth1Data.BlockHash),
+				},
+				Graffiti:          hexutil.Encode(body.Graffiti),
+				ProposerSlashings: proposerSlashings,
+				AttesterSlashings: attesterSlashings,
+				Attestations:      atts,
+				Deposits:          deposits,
+				VoluntaryExits:    exits,
+			},
+		},
+		Signature: hexutil.Encode(b.Signature),
+	}, nil
+}
+
+// validatorStatusFromJson maps the statuses defined by the standard API onto Prysm's validator statuses.
+func validatorStatusFromJson(s string) ethpb.ValidatorStatus {
+	switch s {
+	case "pending_initialized":
+		return ethpb.ValidatorStatus_DEPOSITED
+	case "pending_queued":
+		return ethpb.ValidatorStatus_PENDING
+	case "active_ongoing":
+		return ethpb.ValidatorStatus_ACTIVE
+	case "active_exiting":
+		return ethpb.ValidatorStatus_EXITING
+	case "active_slashed":
+		return ethpb.ValidatorStatus_SLASHING
+	case "exited_unslashed", "exited_slashed", "withdrawal_possible", "withdrawal_done":
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
@@ -0,0 +1,230 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetDuties retrieves the attester duties of the validators for the requested and the following epoch,
+// and their proposer duties for the requested epoch.
+func (c *Client) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	vals, err := c.validatorsByPubKey(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	startSlot, err := helpers.StartSlot(in.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	c.pruneSubscriptions(startSlot)
+
+	indices := url.Values{}
+	for _, v := range vals {
+		indices.Add("index", v.Index)
+	}
+
+	currentDuties, err := c.epochDuties(ctx, in.Epoch, in.PublicKeys, vals, indices, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties for epoch %d", in.Epoch)
+	}
+	nextDuties, err := c.epochDuties(ctx, in.Epoch+1, in.PublicKeys, vals, indices, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties for epoch %d", in.Epoch+1)
+	}
+	return &ethpb.DutiesResponse{
+		Duties:             currentDuties,
+		CurrentEpochDuties: currentDuties,
+		NextEpochDuties:    nextDuties,
+	}, nil
+}
+
+// epochDuties builds the duties of the validators with the given public keys for a single epoch.
+// Proposer duties can only be determined for the current epoch, so they are skipped otherwise.
+func (c *Client) epochDuties(
+	ctx context.Context,
+	epoch types.Epoch,
+	pubKeys [][]byte,
+	vals map[[48]byte]*validatorContainerJson,
+	indices url.Values,
+	withProposals bool,
+) ([]*ethpb.DutiesResponse_Duty, error) {
+	duties := make([]*ethpb.DutiesResponse_Duty, len(pubKeys))
+	byPubKey := make(map[[48]byte]*ethpb.DutiesResponse_Duty, len(pubKeys))
+	for i, pk := range pubKeys {
+		s, idx, err := validatorStatus(vals[bytesutil.ToBytes48(pk)])
+		if err != nil {
+			return nil, err
+		}
+		duties[i] = &ethpb.DutiesResponse_Duty{PublicKey: pk, Status: s.Status}
+		if idx != nonexistentIndex {
+			duties[i].ValidatorIndex = idx
+		}
+		byPubKey[bytesutil.ToBytes48(pk)] = duties[i]
+	}
+	if len(indices) == 0 {
+		return duties, nil
+	}
+
+	attesterResp := &attesterDutiesResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/duties/attester/%d?%s", epoch, indices.Encode())
+	if err := c.get(ctx, endpoint, attesterResp); err != nil {
+		return nil, errors.Wrap(err, "could not get attester duties")
+	}
+	if len(attesterResp.Data) > 0 {
+		committees, err := c.committees(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for _, ad := range attesterResp.Data {
+			if err := c.applyAttesterDuty(ad, committees, byPubKey); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !withProposals {
+		return duties, nil
+	}
+	proposerResp := &proposerDutiesResponseJson{}
+	if err := c.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), proposerResp); err != nil {
+		return nil, errors.Wrap(err, "could not get proposer duties")
+	}
+	for _, pd := range proposerResp.Data {
+		if pd == nil {
+			return nil, errors.New("proposer duty is nil")
+		}
+		d := &fieldDecoder{}
+		pk := d.bytes("proposer public key", pd.Pubkey)
+		slot := types.Slot(d.uint64("proposer slot", pd.Slot))
+		if d.err != nil {
+			return nil, d.err
+		}
+		if duty, ok := byPubKey[bytesutil.ToBytes48(pk)]; ok {
+			duty.ProposerSlots = append(duty.ProposerSlots, slot)
+		}
+	}
+	return duties, nil
+}
+
+// applyAttesterDuty fills in the attester part of the validator's duty, and remembers the committee
+// information required for subscribing to its subnet later on.
+func (c *Client) applyAttesterDuty(
+	ad *attesterDutyJson,
+	committees map[subscriptionKey][]types.ValidatorIndex,
+	byPubKey map[[48]byte]*ethpb.DutiesResponse_Duty,
+) error {
+	if ad == nil {
+		return errors.New("attester duty is nil")
+	}
+	d := &fieldDecoder{}
+	pk := d.bytes("attester public key", ad.Pubkey)
+	key := subscriptionKey{
+		slot:           types.Slot(d.uint64("attester slot", ad.Slot)),
+		committeeIndex: types.CommitteeIndex(d.uint64("committee index", ad.CommitteeIndex)),
+	}
+	info := &subscriptionInfo{
+		validatorIndex:   types.ValidatorIndex(d.uint64("validator index", ad.ValidatorIndex)),
+		committeesAtSlot: d.uint64("committees at slot", ad.CommitteesAtSlot),
+	}
+	if d.err != nil {
+		return d.err
+	}
+	duty, ok := byPubKey[bytesutil.ToBytes48(pk)]
+	if !ok {
+		return nil
+	}
+	committee, ok := committees[key]
+	if !ok {
+		return errors.Errorf("no committee %d at slot %d", key.committeeIndex, key.slot)
+	}
+	duty.AttesterSlot = key.slot
+	duty.CommitteeIndex = key.committeeIndex
+	duty.Committee = committee
+
+	c.subscriptionLock.Lock()
+	c.subscriptions[key] = info
+	c.subscriptionLock.Unlock()
+	return nil
+}
+
+// committees retrieves all beacon committees of the epoch, keyed by their slot and index.
+func (c *Client) committees(ctx context.Context, epoch types.Epoch) (map[subscriptionKey][]types.ValidatorIndex, error) {
+	resp := &stateCommitteesResponseJson{}
+	if err := c.get(ctx, fmt.Sprintf("/eth/v1/beacon/states/head/committees?epoch=%d", epoch), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get committees")
+	}
+	committees := make(map[subscriptionKey][]types.ValidatorIndex, len(resp.Data))
+	d := &fieldDecoder{}
+	for _, cj := range resp.Data {
+		if !d.notNil("committee", cj == nil) {
+			break
+		}
+		key := subscriptionKey{
+			slot:           types.Slot(d.uint64("committee slot", cj.Slot)),
+			committeeIndex: types.CommitteeIndex(d.uint64("committee index", cj.Index)),
+		}
+		committee := make([]types.ValidatorIndex, len(cj.Validators))
+		for i, v := range cj.Validators {
+			committee[i] = types.ValidatorIndex(d.uint64("committee member", v))
+		}
+		committees[key] = committee
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return committees, nil
+}
+
+// SubscribeCommitteeSubnets subscribes the beacon node to the subnets of the given committees.
+// The committees must have been part of a prior GetDuties response.
+func (c *Client) SubscribeCommitteeSubnets(
+	ctx context.Context,
+	in *ethpb.CommitteeSubnetsSubscribeRequest,
+	_ ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	if len(in.Slots) != len(in.CommitteeIds) || len(in.Slots) != len(in.IsAggregator) {
+		return nil, errors.New("subscription request fields have different lengths")
+	}
+	subscriptions := make([]*beaconCommitteeSubscribeJson, 0, len(in.Slots))
+	c.subscriptionLock.RLock()
+	for i, slot := range in.Slots {
+		info, ok := c.subscriptions[subscriptionKey{slot: slot, committeeIndex: in.CommitteeIds[i]}]
+		if !ok {
+			c.subscriptionLock.RUnlock()
+			return nil, errors.Errorf("no known duty for committee %d at slot %d", in.CommitteeIds[i], slot)
+		}
+		subscriptions = append(subscriptions, &beaconCommitteeSubscribeJson{
+			ValidatorIndex:   uint64ToString(uint64(info.validatorIndex)),
+			CommitteeIndex:   uint64ToString(uint64(in.CommitteeIds[i])),
+			CommitteesAtSlot: uint64ToString(info.committeesAtSlot),
+			Slot:             uint64ToString(uint64(slot)),
+			IsAggregator:     in.IsAggregator[i],
+		})
+	}
+	c.subscriptionLock.RUnlock()
+
+	if err := c.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", subscriptions, nil); err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to committee subnets")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// pruneSubscriptions removes the remembered committee information for slots before the given one.
+func (c *Client) pruneSubscriptions(before types.Slot) {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+	for k := range c.subscriptions {
+		if k.slot < before {
+			delete(c.subscriptions, k)
+		}
+	}
+}
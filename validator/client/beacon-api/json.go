@@ -0,0 +1,313 @@
+package beaconapi
+
+// genesisResponseJson is used in /beacon/genesis API endpoint.
+type genesisResponseJson struct {
+	Data *genesisJson `json:"data"`
+}
+
+// genesisJson is a JSON representation of the genesis information.
+type genesisJson struct {
+	GenesisTime           string `json:"genesis_time"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	GenesisForkVersion    string `json:"genesis_fork_version"`
+}
+
+// stateForkResponseJson is used in /beacon/states/{state_id}/fork API endpoint.
+type stateForkResponseJson struct {
+	Data *forkJson `json:"data"`
+}
+
+// forkJson is a JSON representation of a fork.
+type forkJson struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// stateValidatorsResponseJson is used in /beacon/states/{state_id}/validators API endpoint.
+type stateValidatorsResponseJson struct {
+	Data []*validatorContainerJson `json:"data"`
+}
+
+// validatorContainerJson is a JSON representation of a validator container.
+type validatorContainerJson struct {
+	Index     string         `json:"index"`
+	Balance   string         `json:"balance"`
+	Status    string         `json:"status"`
+	Validator *validatorJson `json:"validator"`
+}
+
+// validatorJson is a JSON representation of a validator.
+type validatorJson struct {
+	PublicKey                  string `json:"pubkey"`
+	WithdrawalCredentials      string `json:"withdrawal_credentials"`
+	EffectiveBalance           string `json:"effective_balance"`
+	Slashed                    bool   `json:"slashed"`
+	ActivationEligibilityEpoch string `json:"activation_eligibility_epoch"`
+	ActivationEpoch            string `json:"activation_epoch"`
+	ExitEpoch                  string `json:"exit_epoch"`
+	WithdrawableEpoch          string `json:"withdrawable_epoch"`
+}
+
+// stateCommitteesResponseJson is used in /beacon/states/{state_id}/committees API endpoint.
+type stateCommitteesResponseJson struct {
+	Data []*committeeJson `json:"data"`
+}
+
+// committeeJson is a JSON representation of a committee.
+type committeeJson struct {
+	Index      string   `json:"index"`
+	Slot       string   `json:"slot"`
+	Validators []string `json:"validators"`
+}
+
+// stateFinalityCheckpointResponseJson is used in /beacon/states/{state_id}/finality_checkpoints API endpoint.
+type stateFinalityCheckpointResponseJson struct {
+	Data *finalityCheckpointsJson `json:"data"`
+}
+
+// finalityCheckpointsJson is a JSON representation of the finality checkpoints of a state.
+type finalityCheckpointsJson struct {
+	PreviousJustified *checkpointJson `json:"previous_justified"`
+	CurrentJustified  *checkpointJson `json:"current_justified"`
+	Finalized         *checkpointJson `json:"finalized"`
+}
+
+// blockHeaderResponseJson is used in /beacon/headers/{block_id} API endpoint.
+type blockHeaderResponseJson struct {
+	Data *blockHeaderContainerJson `json:"data"`
+}
+
+// blockHeaderContainerJson is a JSON representation of a block header container.
+type blockHeaderContainerJson struct {
+	Root      string                       `json:"root"`
+	Canonical bool                         `json:"canonical"`
+	Header    *signedBeaconBlockHeaderJson `json:"header"`
+}
+
+// blockResponseJson is used in /beacon/blocks/{block_id} API endpoint.
+type blockResponseJson struct {
+	Data *signedBeaconBlockJson `json:"data"`
+}
+
+// syncingResponseJson is used in /node/syncing API endpoint.
+type syncingResponseJson struct {
+	Data *syncInfoJson `json:"data"`
+}
+
+// syncInfoJson is a JSON representation of the sync info.
+type syncInfoJson struct {
+	HeadSlot     string `json:"head_slot"`
+	SyncDistance string `json:"sync_distance"`
+	IsSyncing    bool   `json:"is_syncing"`
+}
+
+// attesterDutiesResponseJson is used in /validator/duties/attester/{epoch} API endpoint.
+type attesterDutiesResponseJson struct {
+	DependentRoot string              `json:"dependent_root"`
+	Data          []*attesterDutyJson `json:"data"`
+}
+
+// attesterDutyJson is a JSON representation of an attester duty.
+type attesterDutyJson struct {
+	Pubkey                  string `json:"pubkey"`
+	ValidatorIndex          string `json:"validator_index"`
+	CommitteeIndex          string `json:"committee_index"`
+	CommitteeLength         string `json:"committee_length"`
+	CommitteesAtSlot        string `json:"committees_at_slot"`
+	ValidatorCommitteeIndex string `json:"validator_committee_index"`
+	Slot                    string `json:"slot"`
+}
+
+// proposerDutiesResponseJson is used in /validator/duties/proposer/{epoch} API endpoint.
+type proposerDutiesResponseJson struct {
+	DependentRoot string              `json:"dependent_root"`
+	Data          []*proposerDutyJson `json:"data"`
+}
+
+// proposerDutyJson is a JSON representation of a proposer duty.
+type proposerDutyJson struct {
+	Pubkey         string `json:"pubkey"`
+	ValidatorIndex string `json:"validator_index"`
+	Slot           string `json:"slot"`
+}
+
+// produceBlockResponseJson is used in /validator/blocks/{slot} API endpoint.
+type produceBlockResponseJson struct {
+	Data *beaconBlockJson `json:"data"`
+}
+
+// attestationDataResponseJson is used in /validator/attestation_data API endpoint.
+type attestationDataResponseJson struct {
+	Data *attestationDataJson `json:"data"`
+}
+
+// aggregateAttestationResponseJson is used in /validator/aggregate_attestation API endpoint.
+type aggregateAttestationResponseJson struct {
+	Data *attestationJson `json:"data"`
+}
+
+// beaconCommitteeSubscribeJson is a JSON representation of a beacon committee subscription.
+type beaconCommitteeSubscribeJson struct {
+	ValidatorIndex   string `json:"validator_index"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+	Slot             string `json:"slot"`
+	IsAggregator     bool   `json:"is_aggregator"`
+}
+
+// blockEventJson is a JSON representation of the data of a block event.
+type blockEventJson struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block"`
+}
+
+// errorJson is a JSON representation of an error returned by the beacon node.
+type errorJson struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+//----------------
+// Reusable types.
+//----------------
+
+// checkpointJson is a JSON representation of a checkpoint.
+type checkpointJson struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// signedBeaconBlockJson is a JSON representation of a signed beacon block.
+type signedBeaconBlockJson struct {
+	Message   *beaconBlockJson `json:"message"`
+	Signature string           `json:"signature"`
+}
+
+// beaconBlockJson is a JSON representation of a beacon block.
+type beaconBlockJson struct {
+	Slot          string               `json:"slot"`
+	ProposerIndex string               `json:"proposer_index"`
+	ParentRoot    string               `json:"parent_root"`
+	StateRoot     string               `json:"state_root"`
+	Body          *beaconBlockBodyJson `json:"body"`
+}
+
+// beaconBlockBodyJson is a JSON representation of a beacon block body.
+type beaconBlockBodyJson struct {
+	RandaoReveal      string                     `json:"randao_reveal"`
+	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Graffiti          string                     `json:"graffiti"`
+	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*attestationJson         `json:"attestations"`
+	Deposits          []*depositJson             `json:"deposits"`
+	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
+}
+
+// signedBeaconBlockHeaderJson is a JSON representation of a signed beacon block header.
+type signedBeaconBlockHeaderJson struct {
+	Message   *beaconBlockHeaderJson `json:"message"`
+	Signature string                 `json:"signature"`
+}
+
+// beaconBlockHeaderJson is a JSON representation of a beacon block header.
+type beaconBlockHeaderJson struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+// eth1DataJson is a JSON representation of eth1data.
+type eth1DataJson struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+// proposerSlashingJson is a JSON representation of a proposer slashing.
+type proposerSlashingJson struct {
+	Header_1 *signedBeaconBlockHeaderJson `json:"signed_header_1"`
+	Header_2 *signedBeaconBlockHeaderJson `json:"signed_header_2"`
+}
+
+// attesterSlashingJson is a JSON representation of an attester slashing.
+type attesterSlashingJson struct {
+	Attestation_1 *indexedAttestationJson `json:"attestation_1"`
+	Attestation_2 *indexedAttestationJson `json:"attestation_2"`
+}
+
+// indexedAttestationJson is a JSON representation of an indexed attestation.
+type indexedAttestationJson struct {
+	AttestingIndices []string             `json:"attesting_indices"`
+	Data             *attestationDataJson `json:"data"`
+	Signature        string               `json:"signature"`
+}
+
+// attestationJson is a JSON representation of an attestation.
+type attestationJson struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Data            *attestationDataJson `json:"data"`
+	Signature       string               `json:"signature"`
+}
+
+// attestationDataJson is a JSON representation of attestation data.
+type attestationDataJson struct {
+	Slot            string          `json:"slot"`
+	CommitteeIndex  string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJson `json:"source"`
+	Target          *checkpointJson `json:"target"`
+}
+
+// depositJson is a JSON representation of a deposit.
+type depositJson struct {
+	Proof []string          `json:"proof"`
+	Data  *deposit_DataJson `json:"data"`
+}
+
+// deposit_DataJson is a JSON representation of deposit data.
+type deposit_DataJson struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+// signedVoluntaryExitJson is a JSON representation of a signed voluntary exit.
+type signedVoluntaryExitJson struct {
+	Exit      *voluntaryExitJson `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// voluntaryExitJson is a JSON representation of a voluntary exit.
+type voluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// signedAggregateAttestationAndProofJson is a JSON representation of a signed aggregate attestation and proof.
+type signedAggregateAttestationAndProofJson struct {
+	Message   *aggregateAttestationAndProofJson `json:"message"`
+	Signature string                            `json:"signature"`
+}
+
+// aggregateAttestationAndProofJson is a JSON representation of an aggregate attestation and proof.
+type aggregateAttestationAndProofJson struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJson `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof"`
+}
+
+// livenessResponseJson is used in the /validator/liveness/{epoch} API endpoint.
+type livenessResponseJson struct {
+	Data []*validatorLivenessJson `json:"data"`
+}
+
+// validatorLivenessJson is a JSON representation of a validator's liveness in an epoch.
+type validatorLivenessJson struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
@@ -0,0 +1,5 @@
+package beaconapi
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "beacon-api")
@@ -0,0 +1,60 @@
+package beaconapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// GetBlock retrieves an unsigned block to be proposed at the requested slot.
+func (c *Client) GetBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	resp := &produceBlockResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/blocks/%d?randao_reveal=%s", in.Slot, hexutil.Encode(in.RandaoReveal))
+	if len(in.Graffiti) > 0 {
+		endpoint += "&graffiti=" + hexutil.Encode(in.Graffiti)
+	}
+	if err := c.get(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce block")
+	}
+	d := &fieldDecoder{}
+	blk := beaconBlockFromJson(d, resp.Data)
+	if d.err != nil {
+		return nil, d.err
+	}
+	return blk, nil
+}
+
+// ProposeBlock publishes a signed block.
+func (c *Client) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	blk, err := signedBlockToJson(in)
+	if err != nil {
+		return nil, err
+	}
+	root, err := in.Block.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/blocks", blk, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish block")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// ProposeExit submits a signed voluntary exit to the beacon node's pool.
+func (c *Client) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	if in == nil || in.Exit == nil {
+		return nil, errors.New("voluntary exit is nil")
+	}
+	root, err := in.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute exit root")
+	}
+	if err := c.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", signedExitToJson(in), nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit voluntary exit")
+	}
+	return &ethpb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
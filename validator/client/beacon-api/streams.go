@@ -0,0 +1,248 @@
+package beaconapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// clientStream implements the parts of grpc.ClientStream which are shared by all streams
+// emulated on top of the REST API. Messages are only ever received through the typed Recv methods.
+type clientStream struct {
+	ctx context.Context
+}
+
+// Header returns no metadata, as there is no gRPC header.
+func (s *clientStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+// Trailer returns no metadata, as there is no gRPC trailer.
+func (s *clientStream) Trailer() metadata.MD {
+	return nil
+}
+
+// CloseSend does nothing, as requests are never sent over the stream.
+func (s *clientStream) CloseSend() error {
+	return nil
+}
+
+// Context returns the context of the stream.
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg is not supported.
+func (s *clientStream) SendMsg(_ interface{}) error {
+	return ErrNotSupported
+}
+
+// RecvMsg is not supported, use the typed Recv method instead.
+func (s *clientStream) RecvMsg(_ interface{}) error {
+	return ErrNotSupported
+}
+
+// waitForSlot blocks for the duration of a slot, or until the context is done.
+func waitForSlot(ctx context.Context) error {
+	select {
+	case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// chainStartStream polls the genesis endpoint until the chain has started.
+type chainStartStream struct {
+	clientStream
+	client *Client
+}
+
+// WaitForChainStart returns a stream which receives the genesis information once the chain has started.
+func (c *Client) WaitForChainStart(
+	ctx context.Context,
+	_ *emptypb.Empty,
+	_ ...grpc.CallOption,
+) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &chainStartStream{clientStream: clientStream{ctx: ctx}, client: c}, nil
+}
+
+// Recv blocks until the chain has started.
+func (s *chainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	for {
+		genesis, err := s.client.genesis(s.ctx)
+		if err == nil {
+			d := &fieldDecoder{}
+			resp := &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisTime:           d.uint64("genesis time", genesis.GenesisTime),
+				GenesisValidatorsRoot: d.bytes("genesis validators root", genesis.GenesisValidatorsRoot),
+			}
+			if d.err != nil {
+				return nil, d.err
+			}
+			return resp, nil
+		}
+		if !isNotFound(err) {
+			return nil, errors.Wrap(err, "could not get genesis")
+		}
+		log.Debug("Chain has not started yet")
+		if err := waitForSlot(s.ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// activationStream polls the statuses of the requested validators once per slot.
+type activationStream struct {
+	clientStream
+	client   *Client
+	pubKeys  [][]byte
+	received bool
+}
+
+// WaitForActivation returns a stream which receives the statuses of the requested validators.
+// The first response is received right away, and each following one a slot later.
+func (c *Client) WaitForActivation(
+	ctx context.Context,
+	in *ethpb.ValidatorActivationRequest,
+	_ ...grpc.CallOption,
+) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &activationStream{clientStream: clientStream{ctx: ctx}, client: c, pubKeys: in.PublicKeys}, nil
+}
+
+// Recv retrieves the current statuses of the validators.
+func (s *activationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.received {
+		if err := waitForSlot(s.ctx); err != nil {
+			return nil, err
+		}
+	}
+	s.received = true
+
+	vals, err := s.client.validatorsByPubKey(s.ctx, s.pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpb.ValidatorActivationResponse{
+		Statuses: make([]*ethpb.ValidatorActivationResponse_Status, len(s.pubKeys)),
+	}
+	for i, pk := range s.pubKeys {
+		status, idx, err := validatorStatus(vals[bytesutil.ToBytes48(pk)])
+		if err != nil {
+			return nil, err
+		}
+		resp.Statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pk,
+			Status:    status,
+			Index:     idx,
+		}
+	}
+	return resp, nil
+}
+
+// blockStream receives block events from the beacon node's event stream,
+// and retrieves the corresponding blocks.
+type blockStream struct {
+	clientStream
+	client *Client
+	body   io.ReadCloser
+	reader *bufio.Reader
+}
+
+// StreamBlocks subscribes to the block events of the beacon node. The standard API only
+// emits events for blocks which have been fully verified, so all blocks are considered verified.
+func (c *Client) StreamBlocks(
+	ctx context.Context,
+	_ *ethpb.StreamBlocksRequest,
+	_ ...grpc.CallOption,
+) (ethpb.BeaconChain_StreamBlocksClient, error) {
+	const endpoint = "/eth/v1/events?topics=block"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.host+endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create request for %s", endpoint)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	// The event stream is long-lived, so the client's request timeout must not apply.
+	httpResp, err := (&http.Client{Transport: c.httpClient.Transport}).Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not query %s", endpoint)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+		return nil, &apiError{code: httpResp.StatusCode, message: "could not subscribe to block events"}
+	}
+	return &blockStream{
+		clientStream: clientStream{ctx: ctx},
+		client:       c,
+		body:         httpResp.Body,
+		reader:       bufio.NewReader(httpResp.Body),
+	}, nil
+}
+
+// Recv blocks until the next block event is received, and returns the block.
+func (s *blockStream) Recv() (*ethpb.SignedBeaconBlock, error) {
+	var event, data string
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read event stream")
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		case line == "":
+			// An empty line dispatches the event.
+			if event == "block" && data != "" {
+				return s.block(data)
+			}
+			event, data = "", ""
+		}
+	}
+}
+
+// block retrieves the block announced by the event.
+func (s *blockStream) block(data string) (*ethpb.SignedBeaconBlock, error) {
+	e := &blockEventJson{}
+	if err := json.Unmarshal([]byte(data), e); err != nil {
+		return nil, errors.Wrap(err, "could not decode block event")
+	}
+	resp := &blockResponseJson{}
+	if err := s.client.get(s.ctx, "/eth/v1/beacon/blocks/"+e.Block, resp); err != nil {
+		return nil, errors.Wrapf(err, "could not get block %s", e.Block)
+	}
+	d := &fieldDecoder{}
+	if !d.notNil("signed block", resp.Data == nil) {
+		return nil, d.err
+	}
+	blk := &ethpb.SignedBeaconBlock{
+		Block:     beaconBlockFromJson(d, resp.Data.Message),
+		Signature: d.bytes("block signature", resp.Data.Signature),
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return blk, nil
+}
+
+// CloseSend closes the event stream.
+func (s *blockStream) CloseSend() error {
+	return s.body.Close()
+}
@@ -0,0 +1,144 @@
+package beaconapi
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+)
+
+// nonexistentIndex is reported as the index of validators which are not yet in the beacon state.
+const nonexistentIndex = types.ValidatorIndex(^uint64(0))
+
+// headValidators retrieves validators from the head state by their public keys and indices.
+func (c *Client) headValidators(ctx context.Context, pubKeys [][]byte, indices []types.ValidatorIndex) ([]*validatorContainerJson, error) {
+	// The endpoint returns all validators when no ids are given.
+	if len(pubKeys) == 0 && len(indices) == 0 {
+		return nil, nil
+	}
+	params := url.Values{}
+	for _, pk := range pubKeys {
+		params.Add("id", hexutil.Encode(pk))
+	}
+	for _, idx := range indices {
+		params.Add("id", uint64ToString(uint64(idx)))
+	}
+	resp := &stateValidatorsResponseJson{}
+	if err := c.get(ctx, "/eth/v1/beacon/states/head/validators?"+params.Encode(), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	for _, v := range resp.Data {
+		if v == nil || v.Validator == nil {
+			return nil, errors.New("validator container is nil")
+		}
+	}
+	return resp.Data, nil
+}
+
+// validatorsByPubKey retrieves validators from the head state, keyed by their public key.
+func (c *Client) validatorsByPubKey(ctx context.Context, pubKeys [][]byte) (map[[48]byte]*validatorContainerJson, error) {
+	vals, err := c.headValidators(ctx, pubKeys, nil)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[[48]byte]*validatorContainerJson, len(vals))
+	for _, v := range vals {
+		pk, err := hexutil.Decode(v.Validator.PublicKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode validator public key")
+		}
+		m[bytesutil.ToBytes48(pk)] = v
+	}
+	return m, nil
+}
+
+// validatorStatus converts a validator container into Prysm's status response and validator index.
+func validatorStatus(v *validatorContainerJson) (*ethpb.ValidatorStatusResponse, types.ValidatorIndex, error) {
+	if v == nil {
+		return &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}, nonexistentIndex, nil
+	}
+	d := &fieldDecoder{}
+	index := types.ValidatorIndex(d.uint64("validator index", v.Index))
+	activationEpoch := types.Epoch(d.uint64("activation epoch", v.Validator.ActivationEpoch))
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	return &ethpb.ValidatorStatusResponse{
+		Status:          validatorStatusFromJson(strings.ToLower(v.Status)),
+		ActivationEpoch: activationEpoch,
+	}, index, nil
+}
+
+// ValidatorIndex retrieves a validator's index from its public key.
+func (c *Client) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	vals, err := c.headValidators(ctx, [][]byte{in.PublicKey}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
+		return nil, errors.Errorf("could not find validator index for public key %#x", in.PublicKey)
+	}
+	d := &fieldDecoder{}
+	index := types.ValidatorIndex(d.uint64("validator index", vals[0].Index))
+	if d.err != nil {
+		return nil, d.err
+	}
+	return &ethpb.ValidatorIndexResponse{Index: index}, nil
+}
+
+// MultipleValidatorStatus retrieves the statuses of the validators with the given public keys and indices.
+// Public keys which are not found in the beacon state are reported with an unknown status.
+func (c *Client) MultipleValidatorStatus(
+	ctx context.Context,
+	in *ethpb.MultipleValidatorStatusRequest,
+	_ ...grpc.CallOption,
+) (*ethpb.MultipleValidatorStatusResponse, error) {
+	indices := make([]types.ValidatorIndex, len(in.Indices))
+	for i, idx := range in.Indices {
+		indices[i] = types.ValidatorIndex(idx)
+	}
+	vals, err := c.headValidators(ctx, in.PublicKeys, indices)
+	if err != nil {
+		return nil, err
+	}
+	byPubKey := make(map[[48]byte]*validatorContainerJson, len(vals))
+	pubKeys := make([][]byte, 0, len(in.PublicKeys)+len(in.Indices))
+	for _, pk := range in.PublicKeys {
+		if _, ok := byPubKey[bytesutil.ToBytes48(pk)]; ok {
+			continue
+		}
+		byPubKey[bytesutil.ToBytes48(pk)] = nil
+		pubKeys = append(pubKeys, pk)
+	}
+	for _, v := range vals {
+		pk, err := hexutil.Decode(v.Validator.PublicKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode validator public key")
+		}
+		if _, ok := byPubKey[bytesutil.ToBytes48(pk)]; !ok {
+			pubKeys = append(pubKeys, pk)
+		}
+		byPubKey[bytesutil.ToBytes48(pk)] = v
+	}
+
+	resp := &ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: pubKeys,
+		Statuses:   make([]*ethpb.ValidatorStatusResponse, len(pubKeys)),
+		Indices:    make([]types.ValidatorIndex, len(pubKeys)),
+	}
+	for i, pk := range pubKeys {
+		s, idx, err := validatorStatus(byPubKey[bytesutil.ToBytes48(pk)])
+		if err != nil {
+			return nil, err
+		}
+		resp.Statuses[i] = s
+		resp.Indices[i] = idx
+	}
+	return resp, nil
+}
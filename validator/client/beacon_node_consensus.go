@@ -0,0 +1,208 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// consensusNodeTimeout bounds each request to a beacon node when attestation data consensus is enabled, so
+// that a single unresponsive node does not delay the duty past its deadline.
+var consensusNodeTimeout = 2 * time.Second
+
+// consensusBeaconNode is one of the beacon nodes the attestation data is requested from when attestation
+// data consensus is enabled, and through which proposed blocks are broadcast.
+type consensusBeaconNode struct {
+	endpoint        string
+	validatorClient iface.ValidatorClient
+	beaconClient    iface.BeaconChainClient
+}
+
+// attestationDataResponse is the attestation data returned by a beacon node, along with its root.
+type attestationDataResponse struct {
+	node *consensusBeaconNode
+	data *ethpb.AttestationData
+	root [32]byte
+	err  error
+}
+
+// attestationData requests the attestation data to sign from the beacon node. If attestation data consensus
+// is enabled, the data is requested from all configured beacon nodes, and the data backed by most of them is
+// returned.
+func (v *validator) attestationData(ctx context.Context, req *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	if len(v.consensusNodes) == 0 {
+		return v.validatorClient.GetAttestationData(ctx, req)
+	}
+
+	responses := make([]*attestationDataResponse, len(v.consensusNodes))
+	var wg sync.WaitGroup
+	for i, node := range v.consensusNodes {
+		wg.Add(1)
+		go func(i int, node *consensusBeaconNode) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, consensusNodeTimeout)
+			defer cancel()
+			r := &attestationDataResponse{node: node}
+			r.data, r.err = node.validatorClient.GetAttestationData(ctx, req)
+			if r.err == nil {
+				r.root, r.err = r.data.HashTreeRoot()
+			}
+			responses[i] = r
+		}(i, node)
+	}
+	wg.Wait()
+	return selectAttestationData(ctx, req, responses)
+}
+
+// selectAttestationData returns the attestation data returned by a majority of the beacon nodes. Without
+// a majority, the data with the highest-slot head among the data with the target returned by most nodes
+// is returned.
+func selectAttestationData(
+	ctx context.Context,
+	req *ethpb.AttestationDataRequest,
+	responses []*attestationDataResponse,
+) (*ethpb.AttestationData, error) {
+	votes := make(map[[32]byte]int, len(responses))
+	valid := make([]*attestationDataResponse, 0, len(responses))
+	for _, r := range responses {
+		if r.err != nil {
+			log.WithError(r.err).WithField("endpoint", r.node.endpoint).Warn("Could not request attestation data from beacon node")
+			AttestationDataRequestFailuresVec.WithLabelValues(r.node.endpoint).Inc()
+			continue
+		}
+		votes[r.root]++
+		valid = append(valid, r)
+	}
+	if len(valid) == 0 {
+		return nil, errors.New("no beacon node returned attestation data")
+	}
+
+	var selected *attestationDataResponse
+	for _, r := range valid {
+		if 2*votes[r.root] > len(responses) {
+			selected = r
+			break
+		}
+	}
+	if selected == nil {
+		selected = highestHeadWithConsistentTarget(ctx, valid)
+	}
+
+	if len(votes) > 1 {
+		AttestationDataDisagreementsCounter.Inc()
+		log.WithFields(logrus.Fields{
+			"slot":            req.Slot,
+			"committeeIndex":  req.CommitteeIndex,
+			"beaconBlockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(selected.data.BeaconBlockRoot)),
+			"targetEpoch":     selected.data.Target.Epoch,
+			"agreeing":        votes[selected.root],
+			"responses":       len(valid),
+		}).Warn("Beacon nodes disagree on attestation data")
+		for _, r := range valid {
+			if r.root == selected.root {
+				continue
+			}
+			AttestationDataDissentVec.WithLabelValues(r.node.endpoint).Inc()
+			log.WithFields(logrus.Fields{
+				"endpoint":        r.node.endpoint,
+				"beaconBlockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(r.data.BeaconBlockRoot)),
+				"targetEpoch":     r.data.Target.Epoch,
+				"targetRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(r.data.Target.Root)),
+			}).Debug("Beacon node returned attestation data which was not selected")
+		}
+	}
+	return selected.data, nil
+}
+
+// highestHeadWithConsistentTarget returns the attestation data with the highest-slot head among the data with
+// the target returned by most beacon nodes, preferring earlier configured nodes on ties.
+func highestHeadWithConsistentTarget(ctx context.Context, responses []*attestationDataResponse) *attestationDataResponse {
+	var target *ethpb.Checkpoint
+	targetVotes := make(map[string]int, len(responses))
+	for _, r := range responses {
+		key := fmt.Sprintf("%d-%#x", r.data.Target.Epoch, r.data.Target.Root)
+		targetVotes[key]++
+		if target == nil || targetVotes[key] > targetVotes[fmt.Sprintf("%d-%#x", target.Epoch, target.Root)] {
+			target = r.data.Target
+		}
+	}
+
+	var selected *attestationDataResponse
+	var selectedSlot types.Slot
+	for _, r := range responses {
+		if r.data.Target.Epoch != target.Epoch || !bytes.Equal(r.data.Target.Root, target.Root) {
+			continue
+		}
+		slot := headSlot(ctx, r)
+		if selected == nil || slot > selectedSlot {
+			selected = r
+			selectedSlot = slot
+		}
+	}
+	return selected
+}
+
+// headSlot returns the slot of the head block of the attestation data, as reported by the beacon node which
+// returned it. Zero is returned if the node's head has changed since, or if it cannot be retrieved.
+func headSlot(ctx context.Context, r *attestationDataResponse) types.Slot {
+	ctx, cancel := context.WithTimeout(ctx, consensusNodeTimeout)
+	defer cancel()
+	head, err := r.node.beaconClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", r.node.endpoint).Debug("Could not get chain head from beacon node")
+		return 0
+	}
+	if !bytes.Equal(head.HeadBlockRoot, r.data.BeaconBlockRoot) {
+		return 0
+	}
+	return head.HeadSlot
+}
+
+// proposeBlock sends the block to the beacon node for broadcasting. If attestation data consensus is enabled,
+// the block is broadcast through all configured beacon nodes, and the response of the first node which
+// accepted it is returned.
+func (v *validator) proposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	if len(v.consensusNodes) == 0 {
+		return v.validatorClient.ProposeBlock(ctx, blk)
+	}
+
+	responses := make([]*ethpb.ProposeResponse, len(v.consensusNodes))
+	errs := make([]error, len(v.consensusNodes))
+	var wg sync.WaitGroup
+	for i, node := range v.consensusNodes {
+		wg.Add(1)
+		go func(i int, node *consensusBeaconNode) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, consensusNodeTimeout)
+			defer cancel()
+			responses[i], errs[i] = node.validatorClient.ProposeBlock(ctx, blk)
+		}(i, node)
+	}
+	wg.Wait()
+
+	var resp *ethpb.ProposeResponse
+	for i, node := range v.consensusNodes {
+		if errs[i] != nil {
+			log.WithError(errs[i]).WithField("endpoint", node.endpoint).Warn("Could not broadcast block through beacon node")
+			BlockBroadcastFailuresVec.WithLabelValues(node.endpoint).Inc()
+			continue
+		}
+		if resp == nil {
+			resp = responses[i]
+		}
+	}
+	if resp == nil {
+		return nil, errors.Wrap(errs[0], "could not broadcast block through any beacon node")
+	}
+	return resp, nil
+}
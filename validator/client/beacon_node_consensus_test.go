@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+type consensusNodeMocks struct {
+	validatorClient *mock.MockBeaconNodeValidatorClient
+	beaconClient    *mock.MockBeaconChainClient
+}
+
+func setupConsensusNodes(t *testing.T, n int) (*validator, []*consensusNodeMocks) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	v := &validator{}
+	m := make([]*consensusNodeMocks, n)
+	for i := 0; i < n; i++ {
+		m[i] = &consensusNodeMocks{
+			validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+			beaconClient:    mock.NewMockBeaconChainClient(ctrl),
+		}
+		v.consensusNodes = append(v.consensusNodes, &consensusBeaconNode{
+			endpoint:        string(rune('a' + i)),
+			validatorClient: m[i].validatorClient,
+			beaconClient:    m[i].beaconClient,
+		})
+	}
+	return v, m
+}
+
+func testAttestationData(head string, targetEpoch types.Epoch, target string) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:            10,
+		BeaconBlockRoot: bytesutil.PadTo([]byte(head), 32),
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: targetEpoch, Root: bytesutil.PadTo([]byte(target), 32)},
+	}
+}
+
+func TestAttestationData_SingleBeaconNode(t *testing.T) {
+	validator, m, _, finish := setup(t)
+	defer finish()
+	data := testAttestationData("head", 1, "target")
+	m.validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(data, nil)
+
+	got, err := validator.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, got)
+}
+
+func TestAttestationData_Majority(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, m := setupConsensusNodes(t, 3)
+	majority := testAttestationData("head", 1, "target")
+	minority := testAttestationData("other head", 1, "target")
+	m[0].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(minority, nil)
+	m[1].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(majority, nil)
+	m[2].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(majority, nil)
+
+	got, err := v.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.DeepEqual(t, majority, got)
+	assert.LogsContain(t, hook, "Beacon nodes disagree on attestation data")
+}
+
+func TestAttestationData_Agreement(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, m := setupConsensusNodes(t, 2)
+	data := testAttestationData("head", 1, "target")
+	m[0].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(data, nil)
+	m[1].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(data, nil)
+
+	got, err := v.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, got)
+	assert.LogsDoNotContain(t, hook, "disagree")
+}
+
+func TestAttestationData_HighestHeadWithConsistentTarget(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, m := setupConsensusNodes(t, 4)
+	low := testAttestationData("low head", 1, "target")
+	high := testAttestationData("high head", 1, "target")
+	otherTarget := testAttestationData("highest head", 1, "other target")
+	m[0].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(low, nil)
+	m[1].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(high, nil)
+	m[2].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(otherTarget, nil)
+	m[3].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	m[0].beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{
+		HeadSlot:      9,
+		HeadBlockRoot: low.BeaconBlockRoot,
+	}, nil)
+	m[1].beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{
+		HeadSlot:      10,
+		HeadBlockRoot: high.BeaconBlockRoot,
+	}, nil)
+
+	got, err := v.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.DeepEqual(t, high, got)
+	assert.LogsContain(t, hook, "Could not request attestation data from beacon node")
+	assert.LogsContain(t, hook, "Beacon nodes disagree on attestation data")
+}
+
+func setConsensusNodeTimeout(t *testing.T, timeout time.Duration) {
+	prev := consensusNodeTimeout
+	consensusNodeTimeout = timeout
+	t.Cleanup(func() { consensusNodeTimeout = prev })
+}
+
+func TestAttestationData_UnresponsiveBeaconNode(t *testing.T) {
+	setConsensusNodeTimeout(t, 100*time.Millisecond)
+	hook := logTest.NewGlobal()
+	v, m := setupConsensusNodes(t, 3)
+	data := testAttestationData("head", 1, "target")
+	m[0].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	m[1].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(data, nil)
+	m[2].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(data, nil)
+
+	start := time.Now()
+	got, err := v.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 10})
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, got)
+	assert.Equal(t, true, time.Since(start) < time.Second, "Request was not bounded by the node timeout")
+	assert.LogsContain(t, hook, "Could not request attestation data from beacon node")
+}
+
+func TestAttestationData_AllBeaconNodesFail(t *testing.T) {
+	v, m := setupConsensusNodes(t, 2)
+	for _, nodeMocks := range m {
+		nodeMocks.validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	}
+
+	_, err := v.attestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 10})
+	require.ErrorContains(t, "no beacon node returned attestation data", err)
+}
+
+func TestProposeBlock_BroadcastsThroughAllBeaconNodes(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, m := setupConsensusNodes(t, 3)
+	blk := testutil.NewBeaconBlock()
+	m[0].validatorClient.EXPECT().ProposeBlock(gomock.Any(), blk).Return(nil, errors.New("unavailable"))
+	m[1].validatorClient.EXPECT().ProposeBlock(gomock.Any(), blk).Return(&ethpb.ProposeResponse{BlockRoot: []byte("root")}, nil)
+	m[2].validatorClient.EXPECT().ProposeBlock(gomock.Any(), blk).Return(&ethpb.ProposeResponse{BlockRoot: []byte("root")}, nil)
+
+	resp, err := v.proposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("root"), resp.BlockRoot)
+	assert.LogsContain(t, hook, "Could not broadcast block through beacon node")
+}
+
+func TestProposeBlock_UnresponsiveBeaconNode(t *testing.T) {
+	setConsensusNodeTimeout(t, 100*time.Millisecond)
+	v, m := setupConsensusNodes(t, 2)
+	blk := testutil.NewBeaconBlock()
+	m[0].validatorClient.EXPECT().ProposeBlock(gomock.Any(), blk).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.SignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	m[1].validatorClient.EXPECT().ProposeBlock(gomock.Any(), blk).Return(&ethpb.ProposeResponse{BlockRoot: []byte("root")}, nil)
+
+	start := time.Now()
+	resp, err := v.proposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("root"), resp.BlockRoot)
+	assert.Equal(t, true, time.Since(start) < time.Second, "Broadcast was not bounded by the node timeout")
+}
+
+func TestProposeBlock_AllBeaconNodesFail(t *testing.T) {
+	v, m := setupConsensusNodes(t, 2)
+	blk := testutil.NewBeaconBlock()
+	for _, nodeMocks := range m {
+		nodeMocks.validatorClient.EXPECT().ProposeBlock(gomock.Any(), blk).Return(nil, errors.New("unavailable"))
+	}
+
+	_, err := v.proposeBlock(context.Background(), blk)
+	require.ErrorContains(t, "could not broadcast block through any beacon node", err)
+}
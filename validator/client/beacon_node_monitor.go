@@ -0,0 +1,325 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// defaultMaxHeadSlotLag is the number of slots the head of a beacon node may be behind the highest
+// head among the other nodes before the node is considered to have fallen behind.
+const defaultMaxHeadSlotLag = types.Slot(2)
+
+// beaconNodeHealth is the outcome of a health check of a beacon node.
+type beaconNodeHealth struct {
+	endpoint              string
+	err                   error
+	syncing               bool
+	headSlot              types.Slot
+	finalizedEpoch        types.Epoch
+	finalizedRoot         []byte
+	genesisValidatorsRoot []byte
+	// problem describes why the node is unhealthy, it is empty for healthy nodes.
+	problem string
+}
+
+// beaconNodeMonitor periodically checks the health of the configured beacon nodes and selects the node
+// the validator client sends its requests to. A node is healthy if it is reachable, synced, on the chain
+// of the expected genesis, agrees with most nodes on the finalized checkpoint and its head is not behind
+// the other nodes. The current node is kept for as long as it is healthy, otherwise the healthy node with
+// the highest head is selected and the subscribers, such as the resolver of the validator client's gRPC
+// connection, are notified.
+type beaconNodeMonitor struct {
+	endpoints      []string
+	conns          map[string]*grpc.ClientConn
+	interval       time.Duration
+	probeTimeout   time.Duration
+	maxHeadSlotLag types.Slot
+
+	lock sync.Mutex
+	// active is the endpoint of the selected beacon node.
+	active string
+	// genesisValidatorsRoot is pinned to the genesis of the first reachable node, so that nodes of
+	// another chain are never selected.
+	genesisValidatorsRoot []byte
+	healthy               map[string]bool
+	noneHealthy           bool
+	subscribers           map[int]func(endpoint string)
+	nextSubscriberID      int
+}
+
+// newBeaconNodeMonitor creates a monitor of the beacon nodes at the endpoints, which checks their health at
+// every interval through a dedicated connection to each node. The first endpoint is selected until the
+// first health check completes.
+func newBeaconNodeMonitor(endpoints []string, interval time.Duration, dialOpts ...grpc.DialOption) (*beaconNodeMonitor, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no beacon node endpoints to monitor")
+	}
+	m := &beaconNodeMonitor{
+		endpoints:      endpoints,
+		conns:          make(map[string]*grpc.ClientConn, len(endpoints)),
+		interval:       interval,
+		probeTimeout:   interval / 2,
+		maxHeadSlotLag: defaultMaxHeadSlotLag,
+		active:         endpoints[0],
+		healthy:        make(map[string]bool, len(endpoints)),
+		subscribers:    make(map[int]func(string)),
+	}
+	for _, endpoint := range endpoints {
+		conn, err := grpc.Dial(endpoint, dialOpts...)
+		if err != nil {
+			m.close()
+			return nil, errors.Wrapf(err, "could not dial beacon node %s", endpoint)
+		}
+		m.conns[endpoint] = conn
+		BeaconNodeActiveGaugeVec.WithLabelValues(endpoint).Set(0)
+	}
+	BeaconNodeActiveGaugeVec.WithLabelValues(m.active).Set(1)
+	return m, nil
+}
+
+// run checks the health of the beacon nodes at every interval until the context is canceled.
+func (m *beaconNodeMonitor) run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.check(ctx)
+		case <-ctx.Done():
+			m.close()
+			return
+		}
+	}
+}
+
+// activeEndpoint returns the endpoint of the selected beacon node.
+func (m *beaconNodeMonitor) activeEndpoint() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.active
+}
+
+// subscribe registers a function which is called with the endpoint of the selected beacon node right away,
+// and again whenever another node is selected. The returned function cancels the subscription.
+func (m *beaconNodeMonitor) subscribe(f func(endpoint string)) (unsubscribe func()) {
+	m.lock.Lock()
+	id := m.nextSubscriberID
+	m.nextSubscriberID++
+	m.subscribers[id] = f
+	active := m.active
+	m.lock.Unlock()
+
+	f(active)
+	return func() {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		delete(m.subscribers, id)
+	}
+}
+
+// check probes all beacon nodes concurrently and selects the node to use.
+func (m *beaconNodeMonitor) check(ctx context.Context) {
+	results := make([]*beaconNodeHealth, len(m.endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range m.endpoints {
+		wg.Add(1)
+		go func(i int, endpoint string) {
+			defer wg.Done()
+			results[i] = m.probe(ctx, endpoint)
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	selected, notify := m.update(results)
+	for _, f := range notify {
+		f(selected)
+	}
+}
+
+// probe retrieves the genesis, sync status and chain head of a beacon node.
+func (m *beaconNodeMonitor) probe(ctx context.Context, endpoint string) *beaconNodeHealth {
+	ctx, cancel := context.WithTimeout(ctx, m.probeTimeout)
+	defer cancel()
+
+	h := &beaconNodeHealth{endpoint: endpoint}
+	nodeClient := ethpb.NewNodeClient(m.conns[endpoint])
+	genesis, err := nodeClient.GetGenesis(ctx, &emptypb.Empty{})
+	if err != nil {
+		h.err = errors.Wrap(err, "could not get genesis")
+		return h
+	}
+	h.genesisValidatorsRoot = genesis.GenesisValidatorsRoot
+	syncStatus, err := nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
+	if err != nil {
+		h.err = errors.Wrap(err, "could not get sync status")
+		return h
+	}
+	h.syncing = syncStatus.Syncing
+	head, err := ethpb.NewBeaconChainClient(m.conns[endpoint]).GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		h.err = errors.Wrap(err, "could not get chain head")
+		return h
+	}
+	h.headSlot = head.HeadSlot
+	h.finalizedEpoch = head.FinalizedEpoch
+	h.finalizedRoot = head.FinalizedBlockRoot
+	return h
+}
+
+// update assesses the health check results, reports them and selects the beacon node to use. If another
+// node than the current one is selected, the subscribers to notify are returned along with it.
+func (m *beaconNodeMonitor) update(results []*beaconNodeHealth) (string, []func(string)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.assess(results)
+	for _, h := range results {
+		m.report(h)
+	}
+
+	selected := m.selectNode(results)
+	if selected == "" {
+		if !m.noneHealthy {
+			log.WithField("endpoint", m.active).Warn("No healthy beacon node, keeping the current one")
+		}
+		m.noneHealthy = true
+		return m.active, nil
+	}
+	m.noneHealthy = false
+	if selected == m.active {
+		return m.active, nil
+	}
+
+	log.WithFields(logrus.Fields{
+		"previous": m.active,
+		"endpoint": selected,
+	}).Warn("Switching to another beacon node")
+	BeaconNodeActiveGaugeVec.WithLabelValues(m.active).Set(0)
+	BeaconNodeActiveGaugeVec.WithLabelValues(selected).Set(1)
+	BeaconNodeSwitchesCounter.Inc()
+	m.active = selected
+	notify := make([]func(string), 0, len(m.subscribers))
+	for _, f := range m.subscribers {
+		notify = append(notify, f)
+	}
+	return selected, notify
+}
+
+// assess records in the health check results why each unhealthy beacon node is unhealthy.
+func (m *beaconNodeMonitor) assess(results []*beaconNodeHealth) {
+	reachable := make([]*beaconNodeHealth, 0, len(results))
+	candidates := make([]*beaconNodeHealth, 0, len(results))
+	for _, h := range results {
+		if h.err != nil {
+			h.problem = h.err.Error()
+			continue
+		}
+		if m.genesisValidatorsRoot == nil {
+			m.genesisValidatorsRoot = h.genesisValidatorsRoot
+		}
+		if !bytes.Equal(h.genesisValidatorsRoot, m.genesisValidatorsRoot) {
+			h.problem = fmt.Sprintf("genesis validators root %#x does not match %#x", h.genesisValidatorsRoot, m.genesisValidatorsRoot)
+			continue
+		}
+		reachable = append(reachable, h)
+		if h.syncing {
+			h.problem = "syncing"
+			continue
+		}
+		candidates = append(candidates, h)
+	}
+
+	// Nodes which finalized another block than most reachable nodes of the chain, including the syncing
+	// ones, at the same epoch are on another fork.
+	var majority *beaconNodeHealth
+	votes := make(map[string]int, len(reachable))
+	for _, h := range reachable {
+		key := fmt.Sprintf("%d-%#x", h.finalizedEpoch, h.finalizedRoot)
+		votes[key]++
+		if majority == nil || votes[key] > votes[fmt.Sprintf("%d-%#x", majority.finalizedEpoch, majority.finalizedRoot)] {
+			majority = h
+		}
+	}
+	var highestHead types.Slot
+	remaining := candidates[:0]
+	for _, h := range candidates {
+		if h.finalizedEpoch == majority.finalizedEpoch && !bytes.Equal(h.finalizedRoot, majority.finalizedRoot) {
+			h.problem = fmt.Sprintf("finalized block %#x at epoch %d is on another fork", h.finalizedRoot, h.finalizedEpoch)
+			continue
+		}
+		if h.headSlot > highestHead {
+			highestHead = h.headSlot
+		}
+		remaining = append(remaining, h)
+	}
+
+	for _, h := range remaining {
+		if h.headSlot+m.maxHeadSlotLag < highestHead {
+			h.problem = fmt.Sprintf("head slot %d is %d slots behind", h.headSlot, highestHead-h.headSlot)
+		}
+	}
+}
+
+// report logs changes of the health of a beacon node and updates its metrics.
+func (m *beaconNodeMonitor) report(h *beaconNodeHealth) {
+	wasHealthy, known := m.healthy[h.endpoint]
+	isHealthy := h.problem == ""
+	m.healthy[h.endpoint] = isHealthy
+	if isHealthy {
+		BeaconNodeHealthyGaugeVec.WithLabelValues(h.endpoint).Set(1)
+		BeaconNodeHeadSlotGaugeVec.WithLabelValues(h.endpoint).Set(float64(h.headSlot))
+		if known && !wasHealthy {
+			log.WithField("endpoint", h.endpoint).Info("Beacon node is healthy again")
+		}
+		return
+	}
+	BeaconNodeHealthyGaugeVec.WithLabelValues(h.endpoint).Set(0)
+	if h.err == nil {
+		BeaconNodeHeadSlotGaugeVec.WithLabelValues(h.endpoint).Set(float64(h.headSlot))
+	}
+	if !known || wasHealthy {
+		log.WithFields(logrus.Fields{
+			"endpoint": h.endpoint,
+			"reason":   h.problem,
+		}).Warn("Beacon node is unhealthy")
+	}
+}
+
+// selectNode returns the current beacon node if it is healthy, otherwise the healthy node with the highest
+// head, preferring earlier configured nodes on ties. An empty endpoint is returned if no node is healthy.
+func (m *beaconNodeMonitor) selectNode(results []*beaconNodeHealth) string {
+	var best *beaconNodeHealth
+	for _, h := range results {
+		if h.problem != "" {
+			continue
+		}
+		if h.endpoint == m.active {
+			return m.active
+		}
+		if best == nil || h.headSlot > best.headSlot {
+			best = h
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	return best.endpoint
+}
+
+func (m *beaconNodeMonitor) close() {
+	for endpoint, conn := range m.conns {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).WithField("endpoint", endpoint).Debug("Could not close beacon node connection")
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// mockBeaconNode is an in-process beacon node serving the endpoints used by the beacon node monitor.
+type mockBeaconNode struct {
+	ethpb.UnimplementedNodeServer
+	ethpb.UnimplementedBeaconChainServer
+
+	endpoint string
+	server   *grpc.Server
+
+	lock                  sync.Mutex
+	syncing               bool
+	headSlot              types.Slot
+	finalizedEpoch        types.Epoch
+	finalizedRoot         []byte
+	genesisValidatorsRoot []byte
+	versionCalls          int
+}
+
+func newMockBeaconNode(t *testing.T, headSlot types.Slot) *mockBeaconNode {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	n := &mockBeaconNode{
+		endpoint:              lis.Addr().String(),
+		server:                grpc.NewServer(),
+		headSlot:              headSlot,
+		finalizedRoot:         []byte("finalized"),
+		genesisValidatorsRoot: []byte("genesis"),
+	}
+	ethpb.RegisterNodeServer(n.server, n)
+	ethpb.RegisterBeaconChainServer(n.server, n)
+	go func() {
+		_ = n.server.Serve(lis)
+	}()
+	t.Cleanup(n.server.Stop)
+	return n
+}
+
+func (n *mockBeaconNode) set(f func(n *mockBeaconNode)) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	f(n)
+}
+
+func (n *mockBeaconNode) GetGenesis(_ context.Context, _ *emptypb.Empty) (*ethpb.Genesis, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return &ethpb.Genesis{GenesisValidatorsRoot: n.genesisValidatorsRoot}, nil
+}
+
+func (n *mockBeaconNode) GetSyncStatus(_ context.Context, _ *emptypb.Empty) (*ethpb.SyncStatus, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return &ethpb.SyncStatus{Syncing: n.syncing}, nil
+}
+
+func (n *mockBeaconNode) GetVersion(_ context.Context, _ *emptypb.Empty) (*ethpb.Version, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.versionCalls++
+	return &ethpb.Version{Version: n.endpoint}, nil
+}
+
+func (n *mockBeaconNode) GetChainHead(_ context.Context, _ *emptypb.Empty) (*ethpb.ChainHead, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return &ethpb.ChainHead{
+		HeadSlot:           n.headSlot,
+		FinalizedEpoch:     n.finalizedEpoch,
+		FinalizedBlockRoot: n.finalizedRoot,
+	}, nil
+}
+
+func newTestBeaconNodeMonitor(t *testing.T, nodes ...*mockBeaconNode) *beaconNodeMonitor {
+	endpoints := make([]string, len(nodes))
+	for i, n := range nodes {
+		endpoints[i] = n.endpoint
+	}
+	m, err := newBeaconNodeMonitor(endpoints, time.Second, grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(m.close)
+	return m
+}
+
+func TestBeaconNodeMonitor_SelectsHealthyNode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	syncing := newMockBeaconNode(t, 100)
+	syncing.syncing = true
+	behind := newMockBeaconNode(t, 90)
+	healthy := newMockBeaconNode(t, 100)
+	m := newTestBeaconNodeMonitor(t, syncing, behind, healthy)
+
+	m.check(context.Background())
+	assert.Equal(t, healthy.endpoint, m.activeEndpoint())
+	assert.LogsContain(t, hook, "syncing")
+	assert.LogsContain(t, hook, "head slot 90 is 10 slots behind")
+	assert.LogsContain(t, hook, "Switching to another beacon node")
+}
+
+func TestBeaconNodeMonitor_KeepsHealthyActiveNode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	first := newMockBeaconNode(t, 100)
+	second := newMockBeaconNode(t, 101)
+	m := newTestBeaconNodeMonitor(t, first, second)
+
+	m.check(context.Background())
+	assert.Equal(t, first.endpoint, m.activeEndpoint())
+	assert.LogsDoNotContain(t, hook, "Switching to another beacon node")
+}
+
+func TestBeaconNodeMonitor_SwitchesWhenActiveNodeFallsBehind(t *testing.T) {
+	first := newMockBeaconNode(t, 100)
+	second := newMockBeaconNode(t, 100)
+	m := newTestBeaconNodeMonitor(t, first, second)
+	var selected []string
+	unsubscribe := m.subscribe(func(endpoint string) {
+		selected = append(selected, endpoint)
+	})
+	defer unsubscribe()
+
+	m.check(context.Background())
+	assert.Equal(t, first.endpoint, m.activeEndpoint())
+
+	hook := logTest.NewGlobal()
+	second.set(func(n *mockBeaconNode) {
+		n.headSlot = 110
+	})
+	m.check(context.Background())
+	assert.Equal(t, second.endpoint, m.activeEndpoint())
+	assert.DeepEqual(t, []string{first.endpoint, second.endpoint}, selected)
+	assert.LogsContain(t, hook, "Switching to another beacon node")
+	assert.LogsContain(t, hook, "head slot 100 is 10 slots behind")
+
+	// The previous node is kept as a fallback once it catches up, without switching back.
+	first.set(func(n *mockBeaconNode) {
+		n.headSlot = 110
+	})
+	m.check(context.Background())
+	assert.Equal(t, second.endpoint, m.activeEndpoint())
+	assert.LogsContain(t, hook, "Beacon node is healthy again")
+	assert.Equal(t, 2, len(selected))
+}
+
+func TestBeaconNodeMonitor_GenesisAndForkMismatch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	first := newMockBeaconNode(t, 100)
+	otherChain := newMockBeaconNode(t, 120)
+	otherChain.genesisValidatorsRoot = []byte("other genesis")
+	otherFork := newMockBeaconNode(t, 120)
+	otherFork.finalizedRoot = []byte("other finalized")
+	agreeing := newMockBeaconNode(t, 100)
+	m := newTestBeaconNodeMonitor(t, first, otherChain, otherFork, agreeing)
+
+	m.check(context.Background())
+	assert.Equal(t, first.endpoint, m.activeEndpoint())
+	assert.LogsContain(t, hook, "does not match")
+	assert.LogsContain(t, hook, "is on another fork")
+
+	// Nodes of another chain or fork are never selected, even if the active node is unhealthy.
+	first.set(func(n *mockBeaconNode) {
+		n.syncing = true
+	})
+	m.check(context.Background())
+	assert.Equal(t, agreeing.endpoint, m.activeEndpoint())
+}
+
+func TestBeaconNodeMonitor_UnreachableNode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	first := newMockBeaconNode(t, 100)
+	second := newMockBeaconNode(t, 100)
+	m := newTestBeaconNodeMonitor(t, first, second)
+	m.probeTimeout = 500 * time.Millisecond
+
+	first.server.Stop()
+	m.check(context.Background())
+	assert.Equal(t, second.endpoint, m.activeEndpoint())
+	assert.LogsContain(t, hook, "could not get genesis")
+
+	// If no node is healthy, the current one is kept.
+	second.server.Stop()
+	m.check(context.Background())
+	assert.Equal(t, second.endpoint, m.activeEndpoint())
+	assert.LogsContain(t, hook, "No healthy beacon node")
+}
+
+func TestBeaconNodeMonitor_RoutesConnectionToSelectedNode(t *testing.T) {
+	first := newMockBeaconNode(t, 100)
+	second := newMockBeaconNode(t, 100)
+	m := newTestBeaconNodeMonitor(t, first, second)
+	m.check(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(
+		ctx,
+		strings.Join([]string{first.endpoint, second.endpoint}, ","),
+		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{monitor: m}),
+		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	client := ethpb.NewNodeClient(conn)
+
+	version, err := client.GetVersion(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, first.endpoint, version.Version)
+
+	first.set(func(n *mockBeaconNode) {
+		n.syncing = true
+	})
+	m.check(ctx)
+	require.Equal(t, second.endpoint, m.activeEndpoint())
+	// The connection moves to the selected node asynchronously.
+	for version.Version != second.endpoint {
+		time.Sleep(10 * time.Millisecond)
+		version, err = client.GetVersion(ctx, &emptypb.Empty{}, grpc.WaitForReady(true))
+		require.NoError(t, err)
+	}
+	second.set(func(n *mockBeaconNode) {
+		assert.NotEqual(t, 0, n.versionCalls)
+	})
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"go.opencensus.io/trace"
+)
+
+// ErrDoppelGangerFound is returned when another instance of the validator's keys is detected on the network.
+var ErrDoppelGangerFound = errors.New("doppelganger detected")
+
+// CheckDoppelGanger watches the network for activity of the validator's keys for the configured number of
+// epochs, before the validator signs anything. The epoch preceding startup is checked right away, and each
+// following epoch once its last attestations have had a slot to propagate. An error wrapping
+// ErrDoppelGangerFound is returned if any of the keys was seen attesting or proposing.
+func (v *validator) CheckDoppelGanger(ctx context.Context) error {
+	if v.doppelGangerEpochs == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelGanger")
+	defer span.End()
+
+	pubKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	if len(pubKeys) == 0 {
+		return nil
+	}
+
+	currentSlot := slotutil.SlotsSinceGenesis(time.Unix(int64(v.genesisTime), 0))
+	startEpoch := helpers.SlotToEpoch(currentSlot)
+	lastEpoch := startEpoch + types.Epoch(v.doppelGangerEpochs) - 1
+	log.WithField("epochs", v.doppelGangerEpochs).Info("Checking for doppelgangers before signing, " +
+		"the validator will not perform its duties until the check is complete")
+
+	if startEpoch > 0 {
+		if err := v.checkDoppelGangerAtEpoch(ctx, pubKeys, startEpoch-1); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "context canceled, not checking for doppelgangers anymore")
+		case slot := <-v.NextSlot():
+			// The second slot of an epoch is the first at which all attestations of the previous epoch
+			// should have reached the beacon node.
+			if slot%params.BeaconConfig().SlotsPerEpoch != 1 {
+				continue
+			}
+			epoch := helpers.SlotToEpoch(slot) - 1
+			if epoch < startEpoch {
+				continue
+			}
+			if err := v.checkDoppelGangerAtEpoch(ctx, pubKeys, epoch); err != nil {
+				return err
+			}
+			if epoch >= lastEpoch {
+				log.Info("No doppelgangers found, starting to perform duties")
+				return nil
+			}
+		}
+	}
+}
+
+// checkDoppelGangerAtEpoch checks whether any of the keys was active in the epoch. Keys for which
+// the slashing protection database shows that this client itself signed in the epoch are skipped,
+// as their activity would be its own from before a restart.
+func (v *validator) checkDoppelGangerAtEpoch(ctx context.Context, pubKeys [][48]byte, epoch types.Epoch) error {
+	req := &ethpb.DoppelGangerRequest{Epoch: epoch}
+	for _, pk := range pubKeys {
+		signed, err := v.signedInEpoch(ctx, pk, epoch)
+		if err != nil {
+			return err
+		}
+		if !signed {
+			req.PublicKeys = append(req.PublicKeys, pk[:])
+		}
+	}
+	if len(req.PublicKeys) == 0 {
+		return nil
+	}
+
+	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
+	if err != nil {
+		return errors.Wrap(iface.ErrConnectionIssue, errors.Wrap(err, "could not check for doppelgangers").Error())
+	}
+	var duplicates []string
+	for _, r := range resp.Responses {
+		if r.DuplicateExists {
+			duplicates = append(duplicates, fmt.Sprintf("%#x", bytesutil.Trunc(r.PublicKey)))
+		}
+	}
+	if len(duplicates) > 0 {
+		return errors.Wrapf(
+			ErrDoppelGangerFound,
+			"validator keys %s were active in epoch %d while this client was not signing, another "+
+				"instance of them is likely running. Refusing to sign with these keys",
+			strings.Join(duplicates, ", "),
+			epoch,
+		)
+	}
+	log.WithField("epoch", epoch).Debug("No doppelgangers found")
+	return nil
+}
+
+// signedInEpoch checks the slashing protection database for an attestation targeting the epoch,
+// or a block proposal in or after it.
+func (v *validator) signedInEpoch(ctx context.Context, pubKey [48]byte, epoch types.Epoch) (bool, error) {
+	signingRoot, err := v.db.SigningRootAtTargetEpoch(ctx, pubKey, epoch)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get attestation signing root")
+	}
+	if signingRoot != params.BeaconConfig().ZeroHash {
+		return true, nil
+	}
+	slot, exists, err := v.db.HighestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get highest signed proposal")
+	}
+	return exists && helpers.SlotToEpoch(slot) >= epoch, nil
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	slotutilmock "github.com/prysmaticlabs/prysm/shared/slotutil/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+)
+
+// setupDoppelGanger returns a validator whose clock is in the third slot of the epoch, and its public key.
+func setupDoppelGanger(t *testing.T, epoch types.Epoch, epochs uint64) (*validator, *mocks, [48]byte, chan types.Slot, func()) {
+	v, m, validatorKey, finish := setup(t)
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch)) + 2
+	v.genesisTime = uint64(time.Now().Unix()) - uint64(slot)*params.BeaconConfig().SecondsPerSlot
+	v.doppelGangerEpochs = epochs
+	ticker := make(chan types.Slot)
+	v.ticker = &slotutilmock.MockTicker{Channel: ticker}
+	return v, m, pubKey, ticker, finish
+}
+
+// expectDoppelGangerCheck expects a check of the key at the epoch, and responds with the result.
+func expectDoppelGangerCheck(t *testing.T, m *mocks, pubKey [48]byte, epoch types.Epoch, duplicate bool) *gomock.Call {
+	return m.validatorClient.EXPECT().CheckDoppelGanger(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+	).DoAndReturn(func(_ context.Context, req *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+		assert.Equal(t, epoch, req.Epoch)
+		require.Equal(t, 1, len(req.PublicKeys))
+		assert.DeepEqual(t, pubKey[:], req.PublicKeys[0])
+		return &ethpb.DoppelGangerResponse{
+			Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{
+				{PublicKey: pubKey[:], DuplicateExists: duplicate},
+			},
+		}, nil
+	})
+}
+
+func TestCheckDoppelGanger_Disabled(t *testing.T) {
+	v, _, _, _, finish := setupDoppelGanger(t, 3, 0)
+	defer finish()
+
+	// No calls to the beacon node are expected.
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestCheckDoppelGanger_NoDuplicates(t *testing.T) {
+	v, m, pubKey, ticker, finish := setupDoppelGanger(t, 3, 2)
+	defer finish()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	gomock.InOrder(
+		expectDoppelGangerCheck(t, m, pubKey, 2, false),
+		expectDoppelGangerCheck(t, m, pubKey, 3, false),
+		expectDoppelGangerCheck(t, m, pubKey, 4, false),
+	)
+	go func() {
+		// Only the second slot of each epoch triggers a check.
+		ticker <- slotsPerEpoch.Mul(4)
+		ticker <- slotsPerEpoch.Mul(4) + 1
+		ticker <- slotsPerEpoch.Mul(5) + 1
+	}()
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestCheckDoppelGanger_DuplicateFound(t *testing.T) {
+	v, m, pubKey, ticker, finish := setupDoppelGanger(t, 3, 2)
+	defer finish()
+
+	gomock.InOrder(
+		expectDoppelGangerCheck(t, m, pubKey, 2, false),
+		expectDoppelGangerCheck(t, m, pubKey, 3, true),
+	)
+	go func() {
+		ticker <- params.BeaconConfig().SlotsPerEpoch.Mul(4) + 1
+	}()
+	err := v.CheckDoppelGanger(context.Background())
+	require.ErrorContains(t, "were active in epoch 3", err)
+	assert.Equal(t, true, errors.Is(err, ErrDoppelGangerFound))
+}
+
+func TestCheckDoppelGanger_SkipsKeysSignedByItself(t *testing.T) {
+	ctx := context.Background()
+	v, m, pubKey, ticker, finish := setupDoppelGanger(t, 3, 1)
+	defer finish()
+	require.NoError(t, v.db.SaveProposalHistoryForSlot(ctx, pubKey, params.BeaconConfig().SlotsPerEpoch.Mul(2), []byte{1}))
+
+	// The proposal in epoch 2 was signed by this client before a restart, so only epoch 3 is checked.
+	expectDoppelGangerCheck(t, m, pubKey, 3, false)
+	go func() {
+		ticker <- params.BeaconConfig().SlotsPerEpoch.Mul(4) + 1
+	}()
+	require.NoError(t, v.CheckDoppelGanger(ctx))
+}
+
+func TestCheckDoppelGanger_ConnectionError(t *testing.T) {
+	v, m, _, _, finish := setupDoppelGanger(t, 3, 1)
+	defer finish()
+
+	m.validatorClient.EXPECT().CheckDoppelGanger(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+	).Return(nil, errors.New("connection refused"))
+	err := v.CheckDoppelGanger(context.Background())
+	assert.Equal(t, true, errors.Is(err, iface.ErrConnectionIssue))
+}
+
+func TestCheckDoppelGanger_ContextCanceled(t *testing.T) {
+	v, m, pubKey, _, finish := setupDoppelGanger(t, 3, 1)
+	defer finish()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	expectDoppelGangerCheck(t, m, pubKey, 2, false).Do(func(_ context.Context, _ *ethpb.DoppelGangerRequest) {
+		cancel()
+	})
+	err := v.CheckDoppelGanger(ctx)
+	require.ErrorContains(t, "context canceled", err)
+}
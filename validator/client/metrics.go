@@ -9,6 +9,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -194,6 +195,88 @@ var (
 			"pubkey",
 		},
 	)
+	// BeaconNodeHealthyGaugeVec used to track whether the configured beacon nodes are healthy.
+	BeaconNodeHealthyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "1 if the beacon node is reachable, synced, on the expected chain and not behind the other nodes, 0 otherwise",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodeHeadSlotGaugeVec used to track the head slot reported by the configured beacon nodes.
+	BeaconNodeHeadSlotGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_head_slot",
+			Help:      "the head slot reported by the beacon node at the latest health check",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodeActiveGaugeVec used to track which beacon node the validator client uses.
+	BeaconNodeActiveGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_active",
+			Help:      "1 for the beacon node the validator client currently sends its requests to, 0 otherwise",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodeSwitchesCounter used to count the failovers from one beacon node to another.
+	BeaconNodeSwitchesCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_switches_total",
+			Help:      "the number of times the validator client switched to another beacon node",
+		},
+	)
+	// AttestationDataDisagreementsCounter used to count the attestation data requests the beacon nodes disagreed on.
+	AttestationDataDisagreementsCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "attestation_data_disagreements_total",
+			Help:      "the number of attestation data requests for which the beacon nodes returned different data",
+		},
+	)
+	// AttestationDataDissentVec used to count the attestation data of each beacon node which was not selected.
+	AttestationDataDissentVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "attestation_data_dissents_total",
+			Help:      "the number of times the attestation data returned by the beacon node differed from the selected data",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// AttestationDataRequestFailuresVec used to count the failed attestation data requests to each beacon node.
+	AttestationDataRequestFailuresVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "attestation_data_request_failures_total",
+			Help:      "the number of attestation data requests to the beacon node which failed",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BlockBroadcastFailuresVec used to count the blocks which could not be broadcast through each beacon node.
+	BlockBroadcastFailuresVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "block_broadcast_failures_total",
+			Help:      "the number of proposed blocks which could not be broadcast through the beacon node",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -250,6 +333,17 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.S
 			v.startBalances[pubKeyBytes] = resp.BalancesBeforeEpochTransition[i]
 		}
 
+		v.recordPerformance(pubKeyBytes, prevEpoch, func(p *validatorpb.EpochPerformance) {
+			p.PerformanceReported = true
+			p.AttestationIncluded = uint64(resp.InclusionSlots[i]) != ^uint64(0)
+			p.InclusionDistance = resp.InclusionDistances[i]
+			p.CorrectlyVotedSource = resp.CorrectlyVotedSource[i]
+			p.CorrectlyVotedTarget = resp.CorrectlyVotedTarget[i]
+			p.CorrectlyVotedHead = resp.CorrectlyVotedHead[i]
+			p.BalanceBefore = resp.BalancesBeforeEpochTransition[i]
+			p.BalanceAfter = resp.BalancesAfterEpochTransition[i]
+		})
+
 		fmtKey := fmt.Sprintf("%#x", pubKey)
 		truncatedKey := fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))
 		if v.prevBalance[pubKeyBytes] > 0 {
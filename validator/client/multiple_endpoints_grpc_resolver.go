@@ -12,13 +12,18 @@ import (
 // It can be used with any grpc load balancer (pick_first, round_robin). Default is pick_first.
 // Round robin can be used by adding the following option:
 // grpc.WithDefaultServiceConfig("{\"loadBalancingConfig\":[{\"round_robin\":{}}]}")
-type multipleEndpointsGrpcResolverBuilder struct{}
+// When a beacon node monitor is set, the target is ignored and only the address of the beacon node
+// selected by the monitor is resolved, which is updated whenever the monitor switches to another node.
+type multipleEndpointsGrpcResolverBuilder struct {
+	monitor *beaconNodeMonitor
+}
 
 // Build creates and starts multiple endpoints resolver.
-func (*multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+func (b *multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
 	r := &multipleEndpointsGrpcResolver{
-		target: target,
-		cc:     cc,
+		target:  target,
+		cc:      cc,
+		monitor: b.monitor,
 	}
 	r.start()
 	return r, nil
@@ -30,11 +35,19 @@ func (*multipleEndpointsGrpcResolverBuilder) Scheme() string {
 }
 
 type multipleEndpointsGrpcResolver struct {
-	target resolver.Target
-	cc     resolver.ClientConn
+	target      resolver.Target
+	cc          resolver.ClientConn
+	monitor     *beaconNodeMonitor
+	unsubscribe func()
 }
 
 func (r *multipleEndpointsGrpcResolver) start() {
+	if r.monitor != nil {
+		r.unsubscribe = r.monitor.subscribe(func(endpoint string) {
+			r.cc.UpdateState(resolver.State{Addresses: []resolver.Address{{Addr: endpoint}}})
+		})
+		return
+	}
 	endpoints := strings.Split(r.target.Endpoint, ",")
 	var addrs []resolver.Address
 	for _, endpoint := range endpoints {
@@ -46,5 +59,9 @@ func (r *multipleEndpointsGrpcResolver) start() {
 // ResolveNow --
 func (*multipleEndpointsGrpcResolver) ResolveNow(_ resolver.ResolveNowOptions) {}
 
-// Close --
-func (*multipleEndpointsGrpcResolver) Close() {}
+// Close stops following the beacon node monitor, if any.
+func (r *multipleEndpointsGrpcResolver) Close() {
+	if r.unsubscribe != nil {
+		r.unsubscribe()
+	}
+}
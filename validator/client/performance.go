@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+)
+
+// recordPerformance applies an update to the performance record of a validator key in an epoch. The records
+// are kept in memory until they are written to the database by SavePerformanceHistory.
+func (v *validator) recordPerformance(pubKey [48]byte, epoch types.Epoch, update func(p *validatorpb.EpochPerformance)) {
+	v.performanceLock.Lock()
+	defer v.performanceLock.Unlock()
+	if v.performance == nil {
+		v.performance = make(map[types.Epoch]map[[48]byte]*validatorpb.EpochPerformance)
+	}
+	records, ok := v.performance[epoch]
+	if !ok {
+		records = make(map[[48]byte]*validatorpb.EpochPerformance)
+		v.performance[epoch] = records
+	}
+	p, ok := records[pubKey]
+	if !ok {
+		p = &validatorpb.EpochPerformance{Epoch: epoch}
+		records[pubKey] = p
+	}
+	update(p)
+}
+
+// SavePerformanceHistory writes the performance records of the epochs before the current one to the database
+// at the end of each epoch. By then, the duties of these epochs are done and, unless the performance logging is
+// disabled, the performance of the previous epoch has been retrieved from the beacon node.
+func (v *validator) SavePerformanceHistory(ctx context.Context, slot types.Slot) error {
+	if !helpers.IsEpochEnd(slot) {
+		return nil
+	}
+	currentEpoch := helpers.SlotToEpoch(slot)
+
+	v.performanceLock.Lock()
+	history := make(map[[48]byte][]*validatorpb.EpochPerformance)
+	for epoch, records := range v.performance {
+		if epoch >= currentEpoch {
+			continue
+		}
+		for pubKey, p := range records {
+			history[pubKey] = append(history[pubKey], p)
+		}
+		delete(v.performance, epoch)
+	}
+	v.performanceLock.Unlock()
+
+	if len(history) == 0 {
+		return nil
+	}
+	return v.db.SavePerformanceHistory(ctx, history)
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSavePerformanceHistory(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	validator.beaconClient = beaconClient
+	validator.logValidatorBalances = true
+	validator.prevBalance = make(map[[48]byte]uint64)
+	validator.startBalances = make(map[[48]byte]uint64)
+	validator.voteStats = voteStats{startEpoch: types.Epoch(^uint64(0))}
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	ctx := context.Background()
+
+	// Duties of epochs 1 and 2, the attestation of epoch 1 was missed.
+	validator.recordPerformance(pubKey, 1, func(p *validatorpb.EpochPerformance) {
+		p.AttestationAssigned = true
+		p.ProposalsAssigned++
+		p.ProposalsSubmitted++
+	})
+	validator.recordPerformance(pubKey, 2, func(p *validatorpb.EpochPerformance) {
+		p.AttestationAssigned = true
+		p.AttestationSubmitted = true
+	})
+
+	// At the end of epoch 2, the performance of epoch 1 is retrieved and the records of epoch 1 are saved.
+	endOfEpoch2 := 3*params.BeaconConfig().SlotsPerEpoch - 1
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                    [][]byte{pubKey[:]},
+		InclusionSlots:                []types.Slot{types.Slot(^uint64(0))},
+		InclusionDistances:            []types.Slot{0},
+		CorrectlyVotedSource:          []bool{false},
+		CorrectlyVotedTarget:          []bool{false},
+		CorrectlyVotedHead:            []bool{false},
+		BalancesBeforeEpochTransition: []uint64{32000000000},
+		BalancesAfterEpochTransition:  []uint64{31999990000},
+	}, nil)
+	require.NoError(t, validator.LogValidatorGainsAndLosses(ctx, endOfEpoch2))
+	require.NoError(t, validator.SavePerformanceHistory(ctx, endOfEpoch2))
+
+	history, err := validator.db.PerformanceHistory(ctx, pubKey, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.DeepEqual(t, &validatorpb.EpochPerformance{
+		Epoch:               1,
+		AttestationAssigned: true,
+		PerformanceReported: true,
+		BalanceBefore:       32000000000,
+		BalanceAfter:        31999990000,
+		ProposalsAssigned:   1,
+		ProposalsSubmitted:  1,
+	}, history[0])
+
+	// The records of the current epoch are kept in memory until the end of the next epoch.
+	require.Equal(t, 1, len(validator.performance))
+	require.NoError(t, validator.SavePerformanceHistory(ctx, endOfEpoch2+1))
+	require.Equal(t, 1, len(validator.performance))
+	require.NoError(t, validator.SavePerformanceHistory(ctx, endOfEpoch2+params.BeaconConfig().SlotsPerEpoch))
+	assert.Equal(t, 0, len(validator.performance))
+	history, err = validator.db.PerformanceHistory(ctx, pubKey, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, true, history[0].AttestationSubmitted)
+	assert.Equal(t, false, history[0].PerformanceReported)
+}
@@ -51,6 +51,9 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 
 	// Sign randao reveal, it's used to request block from beacon node
 	epoch := types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
+	v.recordPerformance(pubKey, epoch, func(p *validatorpb.EpochPerformance) {
+		p.ProposalsAssigned++
+	})
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign randao reveal")
@@ -120,7 +123,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	}
 
 	// Propose and broadcast block via beacon node
-	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
+	blkResp, err := v.proposeBlock(ctx, blk)
 	if err != nil {
 		log.WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
@@ -128,6 +131,9 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 		}
 		return
 	}
+	v.recordPerformance(pubKey, epoch, func(p *validatorpb.EpochPerformance) {
+		p.ProposalsSubmitted++
+	})
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -92,6 +92,17 @@ func run(ctx context.Context, v iface.Validator) {
 		if err != nil {
 			log.Fatalf("Could not get current canonical head slot: %v", err)
 		}
+		err = v.CheckDoppelGanger(ctx)
+		if err != nil && ctx.Err() != nil {
+			continue // The loop exits on the canceled context.
+		}
+		if isConnectionError(err) {
+			log.Warnf("Could not check for doppelgangers: %v", err)
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Doppelganger protection stopped the validator: %v", err)
+		}
 		break
 	}
 
@@ -209,6 +220,9 @@ func run(ctx context.Context, v iface.Validator) {
 				if err := v.LogValidatorGainsAndLosses(slotCtx, slot); err != nil {
 					log.WithError(err).Error("Could not report validator's rewards/penalties")
 				}
+				if err := v.SavePerformanceHistory(slotCtx, slot); err != nil {
+					log.WithError(err).Error("Could not save validator performance history")
+				}
 				if err := v.LogNextDutyTimeLeft(slot); err != nil {
 					log.WithError(err).Error("Could not report next count down")
 				}
@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	beaconapi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
@@ -52,6 +53,8 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
+	doppelGangerEpochs    uint64
+	attDataConsensus      bool
 	conn                  *grpc.ClientConn
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
@@ -69,6 +72,7 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	beaconRESTApiClient   *beaconapi.Client
 }
 
 // Config for the validator service.
@@ -91,12 +95,25 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	EnableBeaconRESTApi        bool
+	BeaconRESTApiEndpoint      string
+	DoppelGangerEpochs         uint64
+	AttestationDataConsensus   bool
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	var restClient *beaconapi.Client
+	if cfg.EnableBeaconRESTApi {
+		endpoint := cfg.BeaconRESTApiEndpoint
+		if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+			endpoint = "http://" + endpoint
+		}
+		// Requests which take longer than a slot are of no use to the validator.
+		restClient = beaconapi.NewClient(endpoint, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second)
+	}
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
@@ -118,6 +135,9 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		beaconRESTApiClient:   restClient,
+		doppelGangerEpochs:    cfg.DoppelGangerEpochs,
+		attDataConsensus:      cfg.AttestationDataConsensus,
 	}, nil
 }
 
@@ -136,6 +156,20 @@ func (v *ValidatorService) Start() {
 
 	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
 
+	var monitor *beaconNodeMonitor
+	if endpoints := strings.Split(v.endpoint, ","); len(endpoints) > 1 && v.beaconRESTApiClient == nil {
+		var err error
+		monitor, err = newBeaconNodeMonitor(endpoints, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not monitor beacon nodes: %v", err)
+			return
+		}
+		monitor.check(v.ctx)
+		go monitor.run(v.ctx)
+		// gRPC uses the first resolver registered for a scheme, so the monitored resolver takes precedence.
+		dialOpts = append([]grpc.DialOption{grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{monitor: monitor})}, dialOpts...)
+	}
+
 	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
@@ -177,14 +211,43 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	var validatorClient iface.ValidatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+	var beaconClient iface.BeaconChainClient = ethpb.NewBeaconChainClient(v.conn)
+	var nodeClient iface.NodeClient = ethpb.NewNodeClient(v.conn)
+	var consensusNodes []*consensusBeaconNode
+	if v.attDataConsensus {
+		if monitor == nil {
+			log.Warn("Attestation data consensus requires several beacon node gRPC endpoints, requesting a single beacon node")
+		} else {
+			// The connections of the beacon node monitor are reused to reach every node directly.
+			for _, endpoint := range monitor.endpoints {
+				consensusNodes = append(consensusNodes, &consensusBeaconNode{
+					endpoint:        endpoint,
+					validatorClient: ethpb.NewBeaconNodeValidatorClient(monitor.conns[endpoint]),
+					beaconClient:    ethpb.NewBeaconChainClient(monitor.conns[endpoint]),
+				})
+			}
+			log.WithField("endpoints", monitor.endpoints).Info("Requesting attestation data from all beacon nodes")
+		}
+	}
+	logValidatorBalances := v.logValidatorBalances
+	if v.beaconRESTApiClient != nil {
+		log.Info("Using the standard beacon node REST API")
+		validatorClient = v.beaconRESTApiClient
+		beaconClient = v.beaconRESTApiClient
+		nodeClient = v.beaconRESTApiClient
+		// The standard API has no equivalent of the validator performance report.
+		logValidatorBalances = false
+	}
+
 	v.validator = &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                validatorClient,
+		beaconClient:                   beaconClient,
+		node:                           nodeClient,
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
-		logValidatorBalances:           v.logValidatorBalances,
+		logValidatorBalances:           logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
 		startBalances:                  make(map[[48]byte]uint64),
 		prevBalance:                    make(map[[48]byte]uint64),
@@ -200,6 +263,8 @@ func (v *ValidatorService) Start() {
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		doppelGangerEpochs:             v.doppelGangerEpochs,
+		consensusNodes:                 consensusNodes,
 	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
@@ -311,7 +376,10 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
+	var nc iface.NodeClient = ethpb.NewNodeClient(v.conn)
+	if v.beaconRESTApiClient != nil {
+		nc = v.beaconRESTApiClient
+	}
 	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
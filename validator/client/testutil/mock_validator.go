@@ -26,6 +26,7 @@ type FakeValidator struct {
 	AttestToBlockHeadCalled           bool
 	ProposeBlockCalled                bool
 	LogValidatorGainsAndLossesCalled  bool
+	SavePerformanceHistoryCalled      bool
 	SaveProtectionsCalled             bool
 	DeleteProtectionCalled            bool
 	SlotDeadlineCalled                bool
@@ -34,6 +35,7 @@ type FakeValidator struct {
 	WaitForSyncCalled                 int
 	WaitForActivationCalled           int
 	CanonicalHeadSlotCalled           int
+	CheckDoppelGangerCalled           int
 	ReceiveBlocksCalled               int
 	RetryTillSuccess                  int
 	ProposeBlockArg1                  uint64
@@ -109,6 +111,12 @@ func (fv *FakeValidator) CanonicalHeadSlot(_ context.Context) (types.Slot, error
 	return 0, nil
 }
 
+// CheckDoppelGanger for mocking.
+func (fv *FakeValidator) CheckDoppelGanger(_ context.Context) error {
+	fv.CheckDoppelGangerCalled++
+	return nil
+}
+
 // SlotDeadline for mocking.
 func (fv *FakeValidator) SlotDeadline(_ types.Slot) time.Time {
 	fv.SlotDeadlineCalled = true
@@ -140,6 +148,12 @@ func (fv *FakeValidator) LogValidatorGainsAndLosses(_ context.Context, _ types.S
 	return nil
 }
 
+// SavePerformanceHistory for mocking.
+func (fv *FakeValidator) SavePerformanceHistory(_ context.Context, _ types.Slot) error {
+	fv.SavePerformanceHistoryCalled = true
+	return nil
+}
+
 // ResetAttesterProtectionData for mocking.
 func (fv *FakeValidator) ResetAttesterProtectionData() {
 	fv.DeleteProtectionCalled = true
@@ -20,6 +20,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -57,6 +58,7 @@ type validator struct {
 	useWeb                             bool
 	emitAccountMetrics                 bool
 	logDutyCountDown                   bool
+	doppelGangerEpochs                 uint64
 	domainDataLock                     sync.Mutex
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
@@ -73,10 +75,11 @@ type validator struct {
 	duties                             *ethpb.DutiesResponse
 	startBalances                      map[[48]byte]uint64
 	attLogs                            map[[32]byte]*attSubmitted
-	node                               ethpb.NodeClient
+	node                               iface.NodeClient
 	keyManager                         keymanager.IKeymanager
-	beaconClient                       ethpb.BeaconChainClient
-	validatorClient                    ethpb.BeaconNodeValidatorClient
+	beaconClient                       iface.BeaconChainClient
+	validatorClient                    iface.ValidatorClient
+	consensusNodes                     []*consensusBeaconNode
 	protector                          slashingiface.Protector
 	db                                 vdb.Database
 	graffiti                           []byte
@@ -84,6 +87,8 @@ type validator struct {
 	graffitiStruct                     *graffiti.Graffiti
 	graffitiOrderedIndex               uint64
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
+	performanceLock                    sync.Mutex
+	performance                        map[types.Epoch]map[[48]byte]*validatorpb.EpochPerformance
 }
 
 type validatorStatus struct {
@@ -7,6 +7,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 )
@@ -61,4 +62,9 @@ type ValidatorDB interface {
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
+
+	// Performance history related methods.
+	SavePerformanceHistory(ctx context.Context, history map[[48]byte][]*pb.EpochPerformance) error
+	PerformanceHistory(ctx context.Context, pubKey [48]byte, startEpoch, endEpoch types.Epoch) ([]*pb.EpochPerformance, error)
+	PerformanceHistoryPublicKeys(ctx context.Context) ([][48]byte, error)
 }
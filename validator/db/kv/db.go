@@ -48,6 +48,7 @@ var blockedBuckets = [][]byte{
 	attestationSigningRootsBucket,
 	attestationSourceEpochsBucket,
 	attestationTargetEpochsBucket,
+	performanceBucket,
 }
 
 // Config represents store's config object.
@@ -150,6 +151,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			performanceBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -0,0 +1,103 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// PerformanceHistoryEpochs defines the number of epochs of performance history kept for each
+// validator key, which amounts to about 90 days.
+const PerformanceHistoryEpochs = types.Epoch(20250)
+
+// SavePerformanceHistory writes the performance records of validator keys, each of which is
+// stored under the public key and the epoch it describes, replacing any record of the same epoch.
+// The records of a key which fall outside of the kept history relative to its latest record are pruned.
+func (s *Store) SavePerformanceHistory(ctx context.Context, history map[[48]byte][]*pb.EpochPerformance) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SavePerformanceHistory")
+	defer span.End()
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(performanceBucket)
+		for pubKey, records := range history {
+			if len(records) == 0 {
+				continue
+			}
+			pkBucket, err := bucket.CreateBucketIfNotExists(pubKey[:])
+			if err != nil {
+				return errors.Wrapf(err, "could not create performance bucket for public key %#x", pubKey)
+			}
+			for _, record := range records {
+				enc, err := proto.Marshal(record)
+				if err != nil {
+					return errors.Wrap(err, "could not marshal performance record")
+				}
+				if err := pkBucket.Put(bytesutil.EpochToBytesBigEndian(record.Epoch), enc); err != nil {
+					return err
+				}
+			}
+			lastKey, _ := pkBucket.Cursor().Last()
+			latest := bytesutil.BytesToEpochBigEndian(lastKey)
+			if latest < PerformanceHistoryEpochs {
+				continue
+			}
+			oldest := latest - PerformanceHistoryEpochs
+			var pruned [][]byte
+			c := pkBucket.Cursor()
+			for k, _ := c.First(); k != nil && bytesutil.BytesToEpochBigEndian(k) <= oldest; k, _ = c.Next() {
+				pruned = append(pruned, k)
+			}
+			for _, k := range pruned {
+				if err := pkBucket.Delete(k); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// PerformanceHistory returns the performance records of a validator key for the epochs between
+// the start and end epochs, inclusive, in ascending epoch order.
+func (s *Store) PerformanceHistory(
+	ctx context.Context, pubKey [48]byte, startEpoch, endEpoch types.Epoch,
+) ([]*pb.EpochPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PerformanceHistory")
+	defer span.End()
+	history := make([]*pb.EpochPerformance, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(performanceBucket).Bucket(pubKey[:])
+		if pkBucket == nil {
+			return nil
+		}
+		c := pkBucket.Cursor()
+		for k, v := c.Seek(bytesutil.EpochToBytesBigEndian(startEpoch)); k != nil && bytesutil.BytesToEpochBigEndian(k) <= endEpoch; k, v = c.Next() {
+			record := &pb.EpochPerformance{}
+			if err := proto.Unmarshal(v, record); err != nil {
+				return errors.Wrap(err, "could not unmarshal performance record")
+			}
+			history = append(history, record)
+		}
+		return nil
+	})
+	return history, err
+}
+
+// PerformanceHistoryPublicKeys returns the public keys for which a performance history is recorded.
+func (s *Store) PerformanceHistoryPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PerformanceHistoryPublicKeys")
+	defer span.End()
+	publicKeys := make([][48]byte, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(performanceBucket).ForEach(func(key []byte, _ []byte) error {
+			publicKeys = append(publicKeys, bytesutil.ToBytes48(key))
+			return nil
+		})
+	})
+	return publicKeys, err
+}
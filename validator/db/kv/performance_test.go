@@ -0,0 +1,75 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_PerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+	pubKey1 := [48]byte{1}
+	pubKey2 := [48]byte{2}
+
+	history, err := db.PerformanceHistory(ctx, pubKey1, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(history))
+
+	require.NoError(t, db.SavePerformanceHistory(ctx, map[[48]byte][]*pb.EpochPerformance{
+		pubKey1: {
+			{Epoch: 1, AttestationAssigned: true, AttestationSubmitted: true},
+			{Epoch: 2, AttestationAssigned: true},
+			{Epoch: 3, AttestationAssigned: true, AttestationSubmitted: true, ProposalsAssigned: 1},
+		},
+		pubKey2: {
+			{Epoch: 2, ProposalsAssigned: 1, ProposalsSubmitted: 1},
+		},
+	}))
+	// Records of the same epoch are replaced.
+	require.NoError(t, db.SavePerformanceHistory(ctx, map[[48]byte][]*pb.EpochPerformance{
+		pubKey1: {
+			{Epoch: 3, AttestationAssigned: true, AttestationSubmitted: true, ProposalsAssigned: 1, ProposalsSubmitted: 1},
+		},
+	}))
+
+	history, err = db.PerformanceHistory(ctx, pubKey1, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, types.Epoch(2), history[0].Epoch)
+	assert.Equal(t, false, history[0].AttestationSubmitted)
+	assert.Equal(t, types.Epoch(3), history[1].Epoch)
+	assert.Equal(t, uint64(1), history[1].ProposalsSubmitted)
+
+	history, err = db.PerformanceHistory(ctx, pubKey2, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, uint64(1), history[0].ProposalsSubmitted)
+
+	pubKeys, err := db.PerformanceHistoryPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKey1, pubKey2}, pubKeys)
+}
+
+func TestStore_SavePerformanceHistory_Prunes(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+	pubKey := [48]byte{1}
+
+	require.NoError(t, db.SavePerformanceHistory(ctx, map[[48]byte][]*pb.EpochPerformance{
+		pubKey: {{Epoch: 1}, {Epoch: 2}, {Epoch: 3}},
+	}))
+	require.NoError(t, db.SavePerformanceHistory(ctx, map[[48]byte][]*pb.EpochPerformance{
+		pubKey: {{Epoch: PerformanceHistoryEpochs + 2}},
+	}))
+
+	history, err := db.PerformanceHistory(ctx, pubKey, 0, PerformanceHistoryEpochs+2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, types.Epoch(3), history[0].Epoch)
+	assert.Equal(t, PerformanceHistoryEpochs+2, history[1].Epoch)
+}
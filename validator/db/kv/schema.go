@@ -37,4 +37,7 @@ var (
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
+
+	// Performance history of each validator key, in nested buckets keyed by public key.
+	performanceBucket = []byte("performance-bucket")
 )
@@ -86,6 +86,11 @@ func (km *Keymanager) ExtractKeystores(
 	return km.importedKM.ExtractKeystores(ctx, publicKeys, password)
 }
 
+// ImportKeypairs directly into the keymanager.
+func (km *Keymanager) ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]byte) error {
+	return km.importedKM.ImportKeypairs(ctx, privKeys, pubKeys)
+}
+
 // ValidatingAccountNames for the derived keymanager.
 func (km *Keymanager) ValidatingAccountNames(_ context.Context) ([]string, error) {
 	return km.importedKM.ValidatingAccountNames()
@@ -58,7 +58,11 @@ func (km *Keymanager) ImportKeystores(
 	if err != nil {
 		return err
 	}
-	return km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts)
+	if err := km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts); err != nil {
+		return err
+	}
+	km.notifyAccountsChanged(ctx)
+	return nil
 }
 
 // ImportKeypairs directly into the keymanager.
@@ -72,7 +76,11 @@ func (km *Keymanager) ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]
 	if err != nil {
 		return errors.Wrap(err, "could not marshal accounts keystore into JSON")
 	}
-	return km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts)
+	if err := km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts); err != nil {
+		return err
+	}
+	km.notifyAccountsChanged(ctx)
+	return nil
 }
 
 // Retrieves the private key and public key from an EIP-2335 keystore file
@@ -200,6 +200,7 @@ func (km *Keymanager) DeleteAccounts(ctx context.Context, publicKeys [][]byte) e
 			return errors.Wrap(err, "failed to initialize keys caches")
 		}
 	}
+	km.notifyAccountsChanged(ctx)
 	return nil
 }
 
@@ -253,6 +254,20 @@ func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (b
 	return secretKey.Sign(req.SigningRoot), nil
 }
 
+// Notifies subscribers of the keys in the keys cache, so that a running validator client picks
+// up accounts changed by the keymanager itself even if no file watcher is listening for changes.
+func (km *Keymanager) notifyAccountsChanged(ctx context.Context) {
+	if km.accountsChangedFeed == nil {
+		return
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not fetch validating public keys")
+		return
+	}
+	km.accountsChangedFeed.Send(pubKeys)
+}
+
 func (km *Keymanager) initializeAccountKeystore(ctx context.Context) error {
 	encoded, err := km.wallet.ReadFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName)
 	if err != nil && strings.Contains(err.Error(), "no files found") {
@@ -0,0 +1,33 @@
+/*
+Package web3signer defines a keymanager implementation which connects to a remote
+signer server exposing the Web3Signer eth2 HTTP signing API, such as a Web3Signer
+instance in front of HSM-backed keys. The available signing public keys are retrieved from
+
+	GET /api/v1/eth2/publicKeys
+
+and each sign request is submitted as a JSON object to
+
+	POST /api/v1/eth2/sign/{public key}
+
+The request contains the type of the object being signed, the object itself in the
+JSON representation of the standard beacon node API, the fork info of the epoch
+the object belongs to and the signing root computed by the validator client. This
+allows the remote signer to recompute the signing root and to apply its own slashing
+protection. The remote signer responds with HTTP 412 if it refused to sign.
+
+As sign requests of the validator client carry no fork info, the fork is determined
+from the fork schedule of the beacon chain config, and the genesis validators root
+is taken from the keymanager configuration. Each sign request is checked against the
+signature domain computed by the validator client, so that a misconfigured genesis
+validators root is detected before anything is sent to the remote signer.
+
+The Web3Signer keymanager can be customized via a keymanageropts.json file
+which requires the following schema:
+
+	{
+	  "url": "https://signer.example.com:9000",   // Base URL of the remote signer.
+	  "genesis_validators_root": "0x4b36...fe95", // Genesis validators root of the chain.
+	  "ca_crt_path": "/home/eth2/certs/ca.crt",   // Optional certificate authority cert path.
+	}
+*/
+package web3signer
@@ -0,0 +1,179 @@
+package web3signer
+
+// signRequestJson is a JSON representation of a Web3Signer sign request.
+// Exactly one of the objects is set, according to the type.
+type signRequestJson struct {
+	Type              string                            `json:"type"`
+	ForkInfo          *forkInfoJson                     `json:"fork_info"`
+	SigningRoot       string                            `json:"signingRoot"`
+	Block             *beaconBlockJson                  `json:"block,omitempty"`
+	BeaconBlock       *versionedBlockJson               `json:"beacon_block,omitempty"`
+	Attestation       *attestationDataJson              `json:"attestation,omitempty"`
+	AggregateAndProof *aggregateAttestationAndProofJson `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot   *aggregationSlotJson              `json:"aggregation_slot,omitempty"`
+	RandaoReveal      *randaoRevealJson                 `json:"randao_reveal,omitempty"`
+	VoluntaryExit     *voluntaryExitJson                `json:"voluntary_exit,omitempty"`
+}
+
+// signResponseJson is a JSON representation of a Web3Signer sign response.
+type signResponseJson struct {
+	Signature string `json:"signature"`
+}
+
+// forkInfoJson is a JSON representation of the fork info of a sign request.
+type forkInfoJson struct {
+	Fork                  *forkJson `json:"fork"`
+	GenesisValidatorsRoot string    `json:"genesis_validators_root"`
+}
+
+// forkJson is a JSON representation of a fork.
+type forkJson struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// versionedBlockJson is a JSON representation of a beacon block along with its fork version.
+type versionedBlockJson struct {
+	Version string           `json:"version"`
+	Block   *beaconBlockJson `json:"block"`
+}
+
+// aggregationSlotJson is a JSON representation of the slot signed for aggregator selection.
+type aggregationSlotJson struct {
+	Slot string `json:"slot"`
+}
+
+// randaoRevealJson is a JSON representation of the epoch signed for a RANDAO reveal.
+type randaoRevealJson struct {
+	Epoch string `json:"epoch"`
+}
+
+//----------------
+// Reusable types.
+//----------------
+
+// checkpointJson is a JSON representation of a checkpoint.
+type checkpointJson struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// beaconBlockJson is a JSON representation of a beacon block.
+type beaconBlockJson struct {
+	Slot          string               `json:"slot"`
+	ProposerIndex string               `json:"proposer_index"`
+	ParentRoot    string               `json:"parent_root"`
+	StateRoot     string               `json:"state_root"`
+	Body          *beaconBlockBodyJson `json:"body"`
+}
+
+// beaconBlockBodyJson is a JSON representation of a beacon block body.
+// The sync aggregate is only set for Altair blocks.
+type beaconBlockBodyJson struct {
+	RandaoReveal      string                     `json:"randao_reveal"`
+	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Graffiti          string                     `json:"graffiti"`
+	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*attestationJson         `json:"attestations"`
+	Deposits          []*depositJson             `json:"deposits"`
+	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
+	SyncAggregate     *syncAggregateJson         `json:"sync_aggregate,omitempty"`
+}
+
+// signedBeaconBlockHeaderJson is a JSON representation of a signed beacon block header.
+type signedBeaconBlockHeaderJson struct {
+	Message   *beaconBlockHeaderJson `json:"message"`
+	Signature string                 `json:"signature"`
+}
+
+// beaconBlockHeaderJson is a JSON representation of a beacon block header.
+type beaconBlockHeaderJson struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+// eth1DataJson is a JSON representation of eth1data.
+type eth1DataJson struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+// proposerSlashingJson is a JSON representation of a proposer slashing.
+type proposerSlashingJson struct {
+	Header_1 *signedBeaconBlockHeaderJson `json:"signed_header_1"`
+	Header_2 *signedBeaconBlockHeaderJson `json:"signed_header_2"`
+}
+
+// attesterSlashingJson is a JSON representation of an attester slashing.
+type attesterSlashingJson struct {
+	Attestation_1 *indexedAttestationJson `json:"attestation_1"`
+	Attestation_2 *indexedAttestationJson `json:"attestation_2"`
+}
+
+// indexedAttestationJson is a JSON representation of an indexed attestation.
+type indexedAttestationJson struct {
+	AttestingIndices []string             `json:"attesting_indices"`
+	Data             *attestationDataJson `json:"data"`
+	Signature        string               `json:"signature"`
+}
+
+// attestationJson is a JSON representation of an attestation.
+type attestationJson struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Data            *attestationDataJson `json:"data"`
+	Signature       string               `json:"signature"`
+}
+
+// attestationDataJson is a JSON representation of attestation data.
+type attestationDataJson struct {
+	Slot            string          `json:"slot"`
+	CommitteeIndex  string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJson `json:"source"`
+	Target          *checkpointJson `json:"target"`
+}
+
+// depositJson is a JSON representation of a deposit.
+type depositJson struct {
+	Proof []string          `json:"proof"`
+	Data  *deposit_DataJson `json:"data"`
+}
+
+// deposit_DataJson is a JSON representation of deposit data.
+type deposit_DataJson struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+// signedVoluntaryExitJson is a JSON representation of a signed voluntary exit.
+type signedVoluntaryExitJson struct {
+	Exit      *voluntaryExitJson `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// voluntaryExitJson is a JSON representation of a voluntary exit.
+type voluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// aggregateAttestationAndProofJson is a JSON representation of an aggregate attestation and proof.
+type aggregateAttestationAndProofJson struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJson `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof"`
+}
+
+// syncAggregateJson is a JSON representation of a sync aggregate.
+type syncAggregateJson struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"`
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
@@ -0,0 +1,303 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	// Web3Signer signals that it refused to sign, for example due to its slashing protection.
+	statusSigningDenied = http.StatusPreconditionFailed
+	defaultTimeout      = 10 * time.Second
+)
+
+var (
+	// ErrSigningFailed defines a failure from the remote server
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote server")
+	// ErrSigningDenied defines a failure from the remote server when
+	// performing a signing operation was denied by a remote server.
+	ErrSigningDenied = errors.New("signing request was denied by remote server")
+)
+
+// KeymanagerOpts for a Web3Signer keymanager.
+type KeymanagerOpts struct {
+	URL                   string `json:"url"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	CACertPath            string `json:"ca_crt_path,omitempty"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as the options and the request timeout.
+type SetupConfig struct {
+	Opts    *KeymanagerOpts
+	Timeout time.Duration
+}
+
+// Keymanager implementation using remote signing keys via the Web3Signer HTTP API.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	baseURL               string
+	genesisValidatorsRoot []byte
+	client                *http.Client
+	orderedPubKeys        [][48]byte
+	accountsChangedFeed   *event.Feed
+}
+
+// NewKeymanager instantiates a new Web3Signer keymanager from configuration options.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil || cfg.Opts.URL == "" {
+		return nil, errors.New("remote signer URL is required")
+	}
+	if !strings.HasPrefix(cfg.Opts.URL, "http://") && !strings.HasPrefix(cfg.Opts.URL, "https://") {
+		return nil, fmt.Errorf("remote signer URL %s must start with http:// or https://", cfg.Opts.URL)
+	}
+	genesisValidatorsRoot, err := hexutil.Decode(cfg.Opts.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode genesis validators root")
+	}
+	if len(genesisValidatorsRoot) != 32 {
+		return nil, fmt.Errorf("genesis validators root must be 32 bytes, got %d", len(genesisValidatorsRoot))
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.Opts.CACertPath != "" {
+		// Load the CA for the server certificate.
+		serverCA, err := ioutil.ReadFile(cfg.Opts.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(serverCA) {
+			return nil, errors.New("failed to add server's CA certificate to pool")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    cp,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &Keymanager{
+		opts:                  cfg.Opts,
+		baseURL:               strings.TrimRight(cfg.Opts.URL, "/"),
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		client:                &http.Client{Transport: transport, Timeout: timeout},
+		orderedPubKeys:        make([][48]byte, 0),
+		accountsChangedFeed:   new(event.Feed),
+	}, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a Web3Signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	strURL := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Remote signer URL"), opts.URL)
+	if _, err := b.WriteString(strURL); err != nil {
+		log.Error(err)
+		return ""
+	}
+	strRoot := fmt.Sprintf(
+		"%s: %s\n", au.BrightMagenta("Genesis validators root"), opts.GenesisValidatorsRoot,
+	)
+	if _, err := b.WriteString(strRoot); err != nil {
+		log.Error(err)
+		return ""
+	}
+	strCa := fmt.Sprintf(
+		"%s: %s\n", au.BrightMagenta("CA cert path"), opts.CACertPath,
+	)
+	if _, err := b.WriteString(strCa); err != nil {
+		log.Error(err)
+		return ""
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the Web3Signer keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// ReloadPublicKeys reloads public keys.
+func (km *Keymanager) ReloadPublicKeys(ctx context.Context) ([][48]byte, error) {
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reload public keys")
+	}
+
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+	if len(km.orderedPubKeys) != len(pubKeys) {
+		log.Info(keymanager.KeysReloaded)
+		km.accountsChangedFeed.Send(pubKeys)
+	} else {
+		for i := range km.orderedPubKeys {
+			if !bytes.Equal(km.orderedPubKeys[i][:], pubKeys[i][:]) {
+				log.Info(keymanager.KeysReloaded)
+				km.accountsChangedFeed.Send(pubKeys)
+				break
+			}
+		}
+	}
+
+	km.orderedPubKeys = pubKeys
+	return km.orderedPubKeys, nil
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, km.baseURL+publicKeysPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	body, code, err := km.do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts from remote server")
+	}
+	if code != http.StatusOK {
+		return nil, fmt.Errorf("could not list accounts from remote server: HTTP %d: %s", code, body)
+	}
+	var hexKeys []string
+	if err := json.Unmarshal(body, &hexKeys); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys")
+	}
+	pubKeys := make([][48]byte, len(hexKeys))
+	for i, k := range hexKeys {
+		pk, err := hexutil.Decode(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", k)
+		}
+		if len(pk) != 48 {
+			return nil, fmt.Errorf("public key %s must be 48 bytes, got %d", k, len(pk))
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pk)
+	}
+	return pubKeys, nil
+}
+
+// Sign signs a message for a validator key via an HTTP request to the remote signer.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	signReq, err := km.signRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := json.Marshal(signReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode sign request")
+	}
+	httpReq, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, km.baseURL+signPath+hexutil.Encode(req.PublicKey), bytes.NewReader(enc),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	body, code, err := km.do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send sign request to remote server")
+	}
+	switch code {
+	case http.StatusOK:
+	case statusSigningDenied:
+		return nil, ErrSigningDenied
+	default:
+		return nil, errors.Wrapf(ErrSigningFailed, "HTTP %d: %s", code, body)
+	}
+	sig, err := signatureFromResponse(body)
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when new validator accounts
+// are added to the remote signer while the validator process is running.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// do sends the request and returns the response body along with the HTTP status code.
+func (km *Keymanager) do(req *http.Request) ([]byte, int, error) {
+	resp, err := km.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not read response body")
+	}
+	return bytes.TrimSpace(body), resp.StatusCode, nil
+}
+
+// signatureFromResponse decodes the signature, which Web3Signer returns either as a JSON
+// object or as plain text depending on the version.
+func signatureFromResponse(body []byte) ([]byte, error) {
+	s := string(body)
+	if strings.HasPrefix(s, "{") {
+		resp := &signResponseJson{}
+		if err := json.Unmarshal(body, resp); err != nil {
+			return nil, errors.Wrap(err, "could not decode sign response")
+		}
+		s = resp.Signature
+	}
+	sig, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	return sig, nil
+}
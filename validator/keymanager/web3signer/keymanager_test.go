@@ -0,0 +1,367 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var genesisValidatorsRoot = bytesutil.PadTo([]byte("genesis validators root"), 32)
+
+// setupKeymanager returns a keymanager connected to a test server which responds with the handler.
+func setupKeymanager(t *testing.T, handler http.HandlerFunc) *Keymanager {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Opts: &KeymanagerOpts{
+			URL:                   srv.URL,
+			GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+		},
+	})
+	require.NoError(t, err)
+	return km
+}
+
+// signatureDomain computes the signature domain of the epoch, as the validator client does.
+func signatureDomain(t *testing.T, epoch types.Epoch, domainType [4]byte) []byte {
+	fork, err := p2putils.Fork(epoch)
+	require.NoError(t, err)
+	domain, err := helpers.Domain(fork, epoch, domainType, genesisValidatorsRoot)
+	require.NoError(t, err)
+	return domain
+}
+
+func TestNewKeymanager_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *KeymanagerOpts
+		wantErr string
+	}{
+		{
+			name:    "missing URL",
+			opts:    &KeymanagerOpts{GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot)},
+			wantErr: "remote signer URL is required",
+		},
+		{
+			name:    "URL without scheme",
+			opts:    &KeymanagerOpts{URL: "signer:9000", GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot)},
+			wantErr: "must start with http:// or https://",
+		},
+		{
+			name:    "invalid genesis validators root",
+			opts:    &KeymanagerOpts{URL: "http://signer:9000", GenesisValidatorsRoot: "0x1234"},
+			wantErr: "genesis validators root must be 32 bytes",
+		},
+		{
+			name: "missing CA cert",
+			opts: &KeymanagerOpts{
+				URL:                   "https://signer:9000",
+				GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+				CACertPath:            "/nonexistent/ca.crt",
+			},
+			wantErr: "failed to obtain server's CA certificate",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: tt.opts})
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestKeymanager_OptionsFile(t *testing.T) {
+	opts := &KeymanagerOpts{
+		URL:                   "https://signer.example.com:9000",
+		GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+		CACertPath:            "/home/eth2/certs/ca.crt",
+	}
+	enc, err := MarshalOptionsFile(context.Background(), opts)
+	require.NoError(t, err)
+	decoded, err := UnmarshalOptionsFile(ioutil.NopCloser(strings.NewReader(string(enc))))
+	require.NoError(t, err)
+	assert.DeepEqual(t, opts, decoded)
+	assert.Equal(t, true, strings.Contains(opts.String(), opts.URL))
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	key1 := bytesutil.PadTo([]byte("key1"), 48)
+	key2 := bytesutil.PadTo([]byte("key2"), 48)
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, publicKeysPath, r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode([]string{hexutil.Encode(key1), hexutil.Encode(key2)}))
+	})
+
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(key1), bytesutil.ToBytes48(key2)}, keys)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys_Error(t *testing.T) {
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	assert.ErrorContains(t, "HTTP 500", err)
+}
+
+func TestKeymanager_ReloadPublicKeys(t *testing.T) {
+	keys := []string{hexutil.Encode(bytesutil.PadTo([]byte("key1"), 48))}
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(keys))
+	})
+	ch := make(chan [][48]byte, 2)
+	sub := km.SubscribeAccountChanges(ch)
+	defer sub.Unsubscribe()
+
+	reloaded, err := km.ReloadPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(reloaded))
+	require.Equal(t, 1, len(<-ch))
+
+	// Unchanged keys are not sent to subscribers.
+	_, err = km.ReloadPublicKeys(context.Background())
+	require.NoError(t, err)
+	keys = append(keys, hexutil.Encode(bytesutil.PadTo([]byte("key2"), 48)))
+	_, err = km.ReloadPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(<-ch))
+	assert.Equal(t, 0, len(ch))
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := secretKey.PublicKey().Marshal()
+	signingRoot := bytesutil.PadTo([]byte("signing root"), 32)
+	sig := secretKey.Sign(signingRoot)
+	cfg := params.BeaconConfig()
+	slot := types.Slot(100)
+	epoch := helpers.SlotToEpoch(slot)
+
+	block := testutil.NewBeaconBlock().Block
+	block.Slot = slot
+	altairBlock := &prysmv2.BeaconBlockAltair{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &prysmv2.BeaconBlockBodyAltair{
+			RandaoReveal: make([]byte, 96),
+			Eth1Data: &ethpb.Eth1Data{
+				DepositRoot: make([]byte, 32),
+				BlockHash:   make([]byte, 32),
+			},
+			Graffiti: make([]byte, 32),
+			SyncAggregate: &prysmv2.SyncAggregate{
+				SyncCommitteeBits:      bitfield.NewBitvector512(),
+				SyncCommitteeSignature: make([]byte, 96),
+			},
+		},
+	}
+	attData := testutil.HydrateAttestationData(&ethpb.AttestationData{
+		Slot:   slot,
+		Target: &ethpb.Checkpoint{Epoch: epoch},
+	})
+	aggregate := &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: 3,
+		Aggregate:       testutil.HydrateAttestation(&ethpb.Attestation{Data: attData}),
+		SelectionProof:  make([]byte, 96),
+	}
+
+	tests := []struct {
+		name       string
+		req        *validatorpb.SignRequest
+		domainType [4]byte
+		wantType   string
+		check      func(t *testing.T, req *signRequestJson)
+	}{
+		{
+			name:       "block",
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Block{Block: block}},
+			domainType: cfg.DomainBeaconProposer,
+			wantType:   blockType,
+			check: func(t *testing.T, req *signRequestJson) {
+				require.NotNil(t, req.Block)
+				assert.Equal(t, "100", req.Block.Slot)
+			},
+		},
+		{
+			name:       "altair block",
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlockV2{BlockV2: altairBlock}},
+			domainType: cfg.DomainBeaconProposer,
+			wantType:   blockV2Type,
+			check: func(t *testing.T, req *signRequestJson) {
+				require.NotNil(t, req.BeaconBlock)
+				assert.Equal(t, altairVersion, req.BeaconBlock.Version)
+				require.NotNil(t, req.BeaconBlock.Block.Body.SyncAggregate)
+			},
+		},
+		{
+			name:       "attestation",
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{AttestationData: attData}},
+			domainType: cfg.DomainBeaconAttester,
+			wantType:   attestationType,
+			check: func(t *testing.T, req *signRequestJson) {
+				require.NotNil(t, req.Attestation)
+				assert.Equal(t, "3", req.Attestation.Target.Epoch)
+			},
+		},
+		{
+			name:       "aggregate and proof",
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: aggregate}},
+			domainType: cfg.DomainAggregateAndProof,
+			wantType:   aggregateAndProofType,
+			check: func(t *testing.T, req *signRequestJson) {
+				require.NotNil(t, req.AggregateAndProof)
+				assert.Equal(t, "3", req.AggregateAndProof.AggregatorIndex)
+			},
+		},
+		{
+			name:       "aggregation slot",
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: slot}},
+			domainType: cfg.DomainSelectionProof,
+			wantType:   aggregationSlotType,
+			check: func(t *testing.T, req *signRequestJson) {
+				require.NotNil(t, req.AggregationSlot)
+				assert.Equal(t, "100", req.AggregationSlot.Slot)
+			},
+		},
+		{
+			name:       "randao reveal",
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: epoch}},
+			domainType: cfg.DomainRandao,
+			wantType:   randaoRevealType,
+			check: func(t *testing.T, req *signRequestJson) {
+				require.NotNil(t, req.RandaoReveal)
+				assert.Equal(t, "3", req.RandaoReveal.Epoch)
+			},
+		},
+		{
+			name:       "voluntary exit",
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: 5}}},
+			domainType: cfg.DomainVoluntaryExit,
+			wantType:   voluntaryExitType,
+			check: func(t *testing.T, req *signRequestJson) {
+				require.NotNil(t, req.VoluntaryExit)
+				assert.Equal(t, "5", req.VoluntaryExit.ValidatorIndex)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, signPath+hexutil.Encode(pubKey), r.URL.Path)
+				req := &signRequestJson{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+				assert.Equal(t, tt.wantType, req.Type)
+				assert.Equal(t, hexutil.Encode(signingRoot), req.SigningRoot)
+				require.NotNil(t, req.ForkInfo)
+				assert.Equal(t, hexutil.Encode(genesisValidatorsRoot), req.ForkInfo.GenesisValidatorsRoot)
+				assert.Equal(t, hexutil.Encode(cfg.GenesisForkVersion), req.ForkInfo.Fork.CurrentVersion)
+				tt.check(t, req)
+				require.NoError(t, json.NewEncoder(w).Encode(&signResponseJson{Signature: hexutil.Encode(sig.Marshal())}))
+			})
+
+			req := tt.req
+			req.PublicKey = pubKey
+			req.SigningRoot = signingRoot
+			req.SignatureDomain = signatureDomain(t, epoch, tt.domainType)
+			signed, err := km.Sign(context.Background(), req)
+			require.NoError(t, err)
+			assert.DeepEqual(t, sig.Marshal(), signed.Marshal())
+		})
+	}
+}
+
+func TestKeymanager_Sign_PlainTextResponse(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := secretKey.Sign([]byte("data"))
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, err := w.Write([]byte(hexutil.Encode(sig.Marshal())))
+		require.NoError(t, err)
+	})
+
+	signed, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:       secretKey.PublicKey().Marshal(),
+		SignatureDomain: signatureDomain(t, 1, params.BeaconConfig().DomainRandao),
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig.Marshal(), signed.Marshal())
+}
+
+func TestKeymanager_Sign_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int
+		wantErr error
+	}{
+		{name: "denied", code: http.StatusPreconditionFailed, wantErr: ErrSigningDenied},
+		{name: "failed", code: http.StatusInternalServerError, wantErr: ErrSigningFailed},
+		{name: "unknown key", code: http.StatusNotFound, wantErr: ErrSigningFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.code)
+			})
+
+			_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+				PublicKey:       make([]byte, 48),
+				SignatureDomain: signatureDomain(t, 1, params.BeaconConfig().DomainRandao),
+				Object:          &validatorpb.SignRequest_Epoch{Epoch: 1},
+			})
+			assert.ErrorContains(t, tt.wantErr.Error(), err)
+		})
+	}
+}
+
+func TestKeymanager_Sign_DomainMismatch(t *testing.T) {
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request should be sent to the remote signer")
+	})
+	fork, err := p2putils.Fork(1)
+	require.NoError(t, err)
+	otherRoot := bytesutil.PadTo([]byte("other chain"), 32)
+	domain, err := helpers.Domain(fork, 1, params.BeaconConfig().DomainRandao, otherRoot)
+	require.NoError(t, err)
+
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:       make([]byte, 48),
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, "does not match the fork info", err)
+}
+
+func TestKeymanager_Sign_UnsupportedObject(t *testing.T) {
+	km := setupKeymanager(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request should be sent to the remote signer")
+	})
+
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{PublicKey: make([]byte, 48)})
+	assert.ErrorContains(t, "unsupported sign request object type", err)
+}
@@ -0,0 +1,310 @@
+package web3signer
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	prysmv2 "github.com/prysmaticlabs/prysm/proto/prysm/v2"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+)
+
+// Types of the objects to sign, as defined by the Web3Signer API.
+const (
+	blockType             = "BLOCK"
+	blockV2Type           = "BLOCK_V2"
+	attestationType       = "ATTESTATION"
+	aggregationSlotType   = "AGGREGATION_SLOT"
+	aggregateAndProofType = "AGGREGATE_AND_PROOF"
+	randaoRevealType      = "RANDAO_REVEAL"
+	voluntaryExitType     = "VOLUNTARY_EXIT"
+	altairVersion         = "ALTAIR"
+)
+
+// signRequest converts a sign request of the validator client into a Web3Signer sign request.
+// The fork info is derived from the epoch of the object, and verified against the signature
+// domain of the request.
+func (km *Keymanager) signRequest(req *validatorpb.SignRequest) (*signRequestJson, error) {
+	signReq := &signRequestJson{SigningRoot: hexutil.Encode(req.SigningRoot)}
+	var epoch types.Epoch
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		if obj.Block == nil || obj.Block.Body == nil {
+			return nil, errors.New("block is nil")
+		}
+		blk, err := beaconBlockToJson(obj.Block)
+		if err != nil {
+			return nil, err
+		}
+		signReq.Type = blockType
+		signReq.Block = blk
+		epoch = helpers.SlotToEpoch(obj.Block.Slot)
+	case *validatorpb.SignRequest_BlockV2:
+		if obj.BlockV2 == nil || obj.BlockV2.Body == nil {
+			return nil, errors.New("block is nil")
+		}
+		blk, err := beaconBlockAltairToJson(obj.BlockV2)
+		if err != nil {
+			return nil, err
+		}
+		signReq.Type = blockV2Type
+		signReq.BeaconBlock = &versionedBlockJson{Version: altairVersion, Block: blk}
+		epoch = helpers.SlotToEpoch(obj.BlockV2.Slot)
+	case *validatorpb.SignRequest_AttestationData:
+		if obj.AttestationData == nil || obj.AttestationData.Target == nil {
+			return nil, errors.New("attestation data is nil")
+		}
+		signReq.Type = attestationType
+		signReq.Attestation = attestationDataToJson(obj.AttestationData)
+		epoch = obj.AttestationData.Target.Epoch
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		agg := obj.AggregateAttestationAndProof
+		if agg == nil || agg.Aggregate == nil || agg.Aggregate.Data == nil {
+			return nil, errors.New("aggregate attestation is nil")
+		}
+		signReq.Type = aggregateAndProofType
+		signReq.AggregateAndProof = &aggregateAttestationAndProofJson{
+			AggregatorIndex: uint64ToString(uint64(agg.AggregatorIndex)),
+			Aggregate:       attestationToJson(agg.Aggregate),
+			SelectionProof:  hexutil.Encode(agg.SelectionProof),
+		}
+		epoch = helpers.SlotToEpoch(agg.Aggregate.Data.Slot)
+	case *validatorpb.SignRequest_Exit:
+		if obj.Exit == nil {
+			return nil, errors.New("voluntary exit is nil")
+		}
+		signReq.Type = voluntaryExitType
+		signReq.VoluntaryExit = voluntaryExitToJson(obj.Exit)
+		epoch = obj.Exit.Epoch
+	case *validatorpb.SignRequest_Slot:
+		signReq.Type = aggregationSlotType
+		signReq.AggregationSlot = &aggregationSlotJson{Slot: uint64ToString(uint64(obj.Slot))}
+		epoch = helpers.SlotToEpoch(obj.Slot)
+	case *validatorpb.SignRequest_Epoch:
+		signReq.Type = randaoRevealType
+		signReq.RandaoReveal = &randaoRevealJson{Epoch: uint64ToString(uint64(obj.Epoch))}
+		epoch = obj.Epoch
+	default:
+		return nil, fmt.Errorf("unsupported sign request object type %T", req.Object)
+	}
+
+	fork, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine fork")
+	}
+	if len(req.SignatureDomain) != 32 {
+		return nil, fmt.Errorf("signature domain must be 32 bytes, got %d", len(req.SignatureDomain))
+	}
+	domain, err := helpers.Domain(fork, epoch, bytesutil.ToBytes4(req.SignatureDomain), km.genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute domain")
+	}
+	if !bytes.Equal(domain, req.SignatureDomain) {
+		return nil, errors.New("signature domain of the request does not match the fork info, " +
+			"please check that the configured genesis validators root is the one of the chain")
+	}
+	signReq.ForkInfo = &forkInfoJson{
+		Fork: &forkJson{
+			PreviousVersion: hexutil.Encode(fork.PreviousVersion),
+			CurrentVersion:  hexutil.Encode(fork.CurrentVersion),
+			Epoch:           uint64ToString(uint64(fork.Epoch)),
+		},
+		GenesisValidatorsRoot: hexutil.Encode(km.genesisValidatorsRoot),
+	}
+	return signReq, nil
+}
+
+func uint64ToString(u uint64) string {
+	return strconv.FormatUint(u, 10)
+}
+
+func checkpointToJson(c *ethpb.Checkpoint) *checkpointJson {
+	if c == nil {
+		return nil
+	}
+	return &checkpointJson{
+		Epoch: uint64ToString(uint64(c.Epoch)),
+		Root:  hexutil.Encode(c.Root),
+	}
+}
+
+func attestationDataToJson(a *ethpb.AttestationData) *attestationDataJson {
+	if a == nil {
+		return nil
+	}
+	return &attestationDataJson{
+		Slot:            uint64ToString(uint64(a.Slot)),
+		CommitteeIndex:  uint64ToString(uint64(a.CommitteeIndex)),
+		BeaconBlockRoot: hexutil.Encode(a.BeaconBlockRoot),
+		Source:          checkpointToJson(a.Source),
+		Target:          checkpointToJson(a.Target),
+	}
+}
+
+func attestationToJson(a *ethpb.Attestation) *attestationJson {
+	if a == nil {
+		return nil
+	}
+	return &attestationJson{
+		AggregationBits: hexutil.Encode(a.AggregationBits),
+		Data:            attestationDataToJson(a.Data),
+		Signature:       hexutil.Encode(a.Signature),
+	}
+}
+
+func indexedAttestationToJson(a *ethpb.IndexedAttestation) *indexedAttestationJson {
+	if a == nil {
+		return nil
+	}
+	indices := make([]string, len(a.AttestingIndices))
+	for i, idx := range a.AttestingIndices {
+		indices[i] = uint64ToString(idx)
+	}
+	return &indexedAttestationJson{
+		AttestingIndices: indices,
+		Data:             attestationDataToJson(a.Data),
+		Signature:        hexutil.Encode(a.Signature),
+	}
+}
+
+func signedHeaderToJson(h *ethpb.SignedBeaconBlockHeader) *signedBeaconBlockHeaderJson {
+	if h == nil || h.Header == nil {
+		return nil
+	}
+	return &signedBeaconBlockHeaderJson{
+		Message: &beaconBlockHeaderJson{
+			Slot:          uint64ToString(uint64(h.Header.Slot)),
+			ProposerIndex: uint64ToString(uint64(h.Header.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(h.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Header.BodyRoot),
+		},
+		Signature: hexutil.Encode(h.Signature),
+	}
+}
+
+func voluntaryExitToJson(e *ethpb.VoluntaryExit) *voluntaryExitJson {
+	if e == nil {
+		return nil
+	}
+	return &voluntaryExitJson{
+		Epoch:          uint64ToString(uint64(e.Epoch)),
+		ValidatorIndex: uint64ToString(uint64(e.ValidatorIndex)),
+	}
+}
+
+func beaconBlockToJson(b *ethpb.BeaconBlock) (*beaconBlockJson, error) {
+	body, err := beaconBlockBodyToJson(b.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &beaconBlockJson{
+		Slot:          uint64ToString(uint64(b.Slot)),
+		ProposerIndex: uint64ToString(uint64(b.ProposerIndex)),
+		ParentRoot:    hexutil.Encode(b.ParentRoot),
+		StateRoot:     hexutil.Encode(b.StateRoot),
+		Body:          body,
+	}, nil
+}
+
+func beaconBlockAltairToJson(b *prysmv2.BeaconBlockAltair) (*beaconBlockJson, error) {
+	if b.Body.SyncAggregate == nil {
+		return nil, errors.New("sync aggregate is nil")
+	}
+	// Apart from the sync aggregate, the body is the same as a phase 0 body.
+	body, err := beaconBlockBodyToJson(&ethpb.BeaconBlockBody{
+		RandaoReveal:      b.Body.RandaoReveal,
+		Eth1Data:          b.Body.Eth1Data,
+		Graffiti:          b.Body.Graffiti,
+		ProposerSlashings: b.Body.ProposerSlashings,
+		AttesterSlashings: b.Body.AttesterSlashings,
+		Attestations:      b.Body.Attestations,
+		Deposits:          b.Body.Deposits,
+		VoluntaryExits:    b.Body.VoluntaryExits,
+	})
+	if err != nil {
+		return nil, err
+	}
+	body.SyncAggregate = &syncAggregateJson{
+		SyncCommitteeBits:      hexutil.Encode(b.Body.SyncAggregate.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(b.Body.SyncAggregate.SyncCommitteeSignature),
+	}
+	return &beaconBlockJson{
+		Slot:          uint64ToString(uint64(b.Slot)),
+		ProposerIndex: uint64ToString(uint64(b.ProposerIndex)),
+		ParentRoot:    hexutil.Encode(b.ParentRoot),
+		StateRoot:     hexutil.Encode(b.StateRoot),
+		Body:          body,
+	}, nil
+}
+
+func beaconBlockBodyToJson(body *ethpb.BeaconBlockBody) (*beaconBlockBodyJson, error) {
+	if body.Eth1Data == nil {
+		return nil, errors.New("eth1 data is nil")
+	}
+	proposerSlashings := make([]*proposerSlashingJson, len(body.ProposerSlashings))
+	for i, s := range body.ProposerSlashings {
+		proposerSlashings[i] = &proposerSlashingJson{
+			Header_1: signedHeaderToJson(s.Header_1),
+			Header_2: signedHeaderToJson(s.Header_2),
+		}
+	}
+	attesterSlashings := make([]*attesterSlashingJson, len(body.AttesterSlashings))
+	for i, s := range body.AttesterSlashings {
+		attesterSlashings[i] = &attesterSlashingJson{
+			Attestation_1: indexedAttestationToJson(s.Attestation_1),
+			Attestation_2: indexedAttestationToJson(s.Attestation_2),
+		}
+	}
+	atts := make([]*attestationJson, len(body.Attestations))
+	for i, a := range body.Attestations {
+		atts[i] = attestationToJson(a)
+	}
+	deposits := make([]*depositJson, len(body.Deposits))
+	for i, d := range body.Deposits {
+		if d.Data == nil {
+			return nil, errors.New("deposit data is nil")
+		}
+		proof := make([]string, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = hexutil.Encode(p)
+		}
+		deposits[i] = &depositJson{
+			Proof: proof,
+			Data: &deposit_DataJson{
+				PublicKey:             hexutil.Encode(d.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(d.Data.WithdrawalCredentials),
+				Amount:                uint64ToString(d.Data.Amount),
+				Signature:             hexutil.Encode(d.Data.Signature),
+			},
+		}
+	}
+	exits := make([]*signedVoluntaryExitJson, len(body.VoluntaryExits))
+	for i, e := range body.VoluntaryExits {
+		exits[i] = &signedVoluntaryExitJson{
+			Exit:      voluntaryExitToJson(e.Exit),
+			Signature: hexutil.Encode(e.Signature),
+		}
+	}
+	return &beaconBlockBodyJson{
+		RandaoReveal: hexutil.Encode(body.RandaoReveal),
+		Eth1Data: &eth1DataJson{
+			DepositRoot:  hexutil.Encode(body.Eth1Data.DepositRoot),
+			DepositCount: uint64ToString(body.Eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(body.Eth1Data.BlockHash),
+		},
+		Graffiti:          hexutil.Encode(body.Graffiti),
+		ProposerSlashings: proposerSlashings,
+		AttesterSlashings: attesterSlashings,
+		Attestations:      atts,
+		Deposits:          deposits,
+		VoluntaryExits:    exits,
+	}, nil
+}
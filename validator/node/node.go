@@ -17,6 +17,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
@@ -407,6 +408,11 @@ func (c *ValidatorClient) registerValidatorService(
 		}
 	}
 
+	var doppelGangerEpochs uint64
+	if c.cliCtx.Bool(flags.EnableDoppelGangerFlag.Name) {
+		doppelGangerEpochs = c.cliCtx.Uint64(flags.DoppelGangerEpochsFlag.Name)
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -425,6 +431,10 @@ func (c *ValidatorClient) registerValidatorService(
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		EnableBeaconRESTApi:        c.cliCtx.Bool(flags.EnableBeaconRESTApiFlag.Name),
+		BeaconRESTApiEndpoint:      c.cliCtx.String(flags.BeaconRPCGatewayProviderFlag.Name),
+		DoppelGangerEpochs:         doppelGangerEpochs,
+		AttestationDataConsensus:   c.cliCtx.Bool(flags.EnableAttestationDataConsensusFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -524,6 +534,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		pb.RegisterAccountsHandler,
 		pb.RegisterBeaconHandler,
 		pb.RegisterSlashingProtectionHandler,
+		pb.RegisterPerformanceHandler,
 	}
 	mux := gwruntime.NewServeMux(
 		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
@@ -553,10 +564,29 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		Patterns:      []string{"/accounts/", "/v2/"},
 		Mux:           mux,
 	}
+	// The standard key management API uses the field names of the protobuf definitions.
+	keyManagementMux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
+			Marshaler: &gwruntime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					UseProtoNames:   true,
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		}),
+	)
+	keyManagementHandler := gateway.PbMux{
+		Registrations: []gateway.PbHandlerRegistration{ethpbv1.RegisterKeyManagementHandler},
+		Patterns:      []string{"/eth/v1/keystores"},
+		Mux:           keyManagementMux,
+	}
 
 	gw := gateway.New(
 		cliCtx.Context,
-		[]gateway.PbMux{pbHandler},
+		[]gateway.PbMux{pbHandler, keyManagementHandler},
 		muxHandler,
 		rpcAddr,
 		gatewayAddress,
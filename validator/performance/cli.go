@@ -0,0 +1,102 @@
+package performance
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+// HistoryCli prints the performance history of validator keys recorded in the validator database at the
+// data directory. The validator client must not be running, as it holds a lock on the database.
+func HistoryCli(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	found, _, err := fileutil.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	if err != nil {
+		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found {
+		return errors.Errorf("validator database not found at path %s", dataDir)
+	}
+
+	req := &pb.PerformanceHistoryRequest{
+		StartEpoch: types.Epoch(cliCtx.Uint64(flags.PerformanceStartEpochFlag.Name)),
+		EndEpoch:   types.Epoch(cliCtx.Uint64(flags.PerformanceEndEpochFlag.Name)),
+	}
+	for _, pubKey := range cliCtx.StringSlice(flags.PerformancePublicKeysFlag.Name) {
+		decoded, err := hex.DecodeString(strings.TrimPrefix(pubKey, "0x"))
+		if err != nil {
+			return errors.Wrapf(err, "could not decode public key %s", pubKey)
+		}
+		req.PublicKeys = append(req.PublicKeys, decoded)
+	}
+
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+	resp, err := History(cliCtx.Context, validatorDB, req)
+	if err != nil {
+		return errors.Wrap(err, "could not get performance history")
+	}
+
+	showEpochs := cliCtx.Bool(flags.PerformanceShowEpochsFlag.Name)
+	for _, history := range resp.Histories {
+		fmt.Println(formatSummary(history))
+		if !showEpochs {
+			continue
+		}
+		fmt.Printf("  %-8s %-10s %-9s %-8s %-9s %-6s %-6s %-6s %-14s %s\n",
+			"epoch", "attested", "included", "distance", "source", "target", "head", "blocks", "balance (gwei)", "change (gwei)")
+		for _, r := range history.Epochs {
+			fmt.Println(formatEpoch(r))
+		}
+	}
+	return nil
+}
+
+func formatSummary(history *pb.PerformanceHistory) string {
+	s := history.Summary
+	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
+	return fmt.Sprintf(
+		"%#x: %d epochs, attestations submitted %d/%d, included %d/%d (average distance %.2f), "+
+			"correct source %d, target %d, head %d, blocks submitted %d/%d, balance change %.9f ETH",
+		history.PublicKey,
+		s.Epochs,
+		s.AttestationsSubmitted, s.AttestationsAssigned,
+		s.AttestationsIncluded, s.AttestationsReported, s.AverageInclusionDistance,
+		s.CorrectlyVotedSource, s.CorrectlyVotedTarget, s.CorrectlyVotedHead,
+		s.ProposalsSubmitted, s.ProposalsAssigned,
+		float64(s.BalanceChange)/gweiPerEth,
+	)
+}
+
+func formatEpoch(r *pb.EpochPerformance) string {
+	attested := "-"
+	if r.AttestationAssigned {
+		attested = fmt.Sprint(r.AttestationSubmitted)
+	}
+	if !r.PerformanceReported {
+		return fmt.Sprintf("  %-8d %-10s %-9s %-8s %-9s %-6s %-6s %d/%-4d",
+			r.Epoch, attested, "?", "?", "?", "?", "?", r.ProposalsSubmitted, r.ProposalsAssigned)
+	}
+	return fmt.Sprintf("  %-8d %-10s %-9t %-8d %-9t %-6t %-6t %d/%-4d %-14d %d",
+		r.Epoch, attested, r.AttestationIncluded, r.InclusionDistance,
+		r.CorrectlyVotedSource, r.CorrectlyVotedTarget, r.CorrectlyVotedHead,
+		r.ProposalsSubmitted, r.ProposalsAssigned,
+		r.BalanceAfter, int64(r.BalanceAfter)-int64(r.BalanceBefore))
+}
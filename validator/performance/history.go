@@ -0,0 +1,99 @@
+// Package performance queries the performance history of validator keys recorded by the validator client,
+// for the validator web API and command line.
+package performance
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/db"
+)
+
+// ErrInvalidRequest is returned by History for requests with an invalid epoch range or public key.
+var ErrInvalidRequest = errors.New("invalid performance history request")
+
+// History returns the performance of the validator keys over the range of epochs of the request, along with
+// a summary of the performance of each key over the range. The history of all keys with a recorded history
+// is returned if the request has no public keys, and the range ends at the latest recorded epoch if the
+// request has no end epoch.
+func History(ctx context.Context, valDB db.Database, req *pb.PerformanceHistoryRequest) (*pb.PerformanceHistoryResponse, error) {
+	endEpoch := req.EndEpoch
+	if endEpoch == 0 {
+		endEpoch = types.Epoch(math.MaxUint64)
+	}
+	if endEpoch < req.StartEpoch {
+		return nil, errors.Wrapf(ErrInvalidRequest, "end epoch %d is before start epoch %d", endEpoch, req.StartEpoch)
+	}
+
+	var pubKeys [][48]byte
+	if len(req.PublicKeys) == 0 {
+		var err error
+		pubKeys, err = valDB.PerformanceHistoryPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get public keys with a performance history")
+		}
+	}
+	for _, pubKey := range req.PublicKeys {
+		if len(pubKey) != 48 {
+			return nil, errors.Wrapf(ErrInvalidRequest, "public key %#x is not 48 bytes long", pubKey)
+		}
+		var pk [48]byte
+		copy(pk[:], pubKey)
+		pubKeys = append(pubKeys, pk)
+	}
+
+	histories := make([]*pb.PerformanceHistory, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		records, err := valDB.PerformanceHistory(ctx, pubKey, req.StartEpoch, endEpoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get performance history of public key %#x", pubKey)
+		}
+		histories[i] = &pb.PerformanceHistory{
+			PublicKey: pubKeys[i][:],
+			Epochs:    records,
+			Summary:   Summarize(records),
+		}
+	}
+	return &pb.PerformanceHistoryResponse{Histories: histories}, nil
+}
+
+// Summarize aggregates the performance of a validator key over epochs.
+func Summarize(records []*pb.EpochPerformance) *pb.PerformanceSummary {
+	summary := &pb.PerformanceSummary{Epochs: uint64(len(records))}
+	var totalInclusionDistance uint64
+	for _, r := range records {
+		if r.AttestationAssigned {
+			summary.AttestationsAssigned++
+		}
+		if r.AttestationSubmitted {
+			summary.AttestationsSubmitted++
+		}
+		summary.ProposalsAssigned += r.ProposalsAssigned
+		summary.ProposalsSubmitted += r.ProposalsSubmitted
+		if !r.PerformanceReported {
+			continue
+		}
+		summary.AttestationsReported++
+		if r.AttestationIncluded {
+			summary.AttestationsIncluded++
+			totalInclusionDistance += uint64(r.InclusionDistance)
+		}
+		if r.CorrectlyVotedSource {
+			summary.CorrectlyVotedSource++
+		}
+		if r.CorrectlyVotedTarget {
+			summary.CorrectlyVotedTarget++
+		}
+		if r.CorrectlyVotedHead {
+			summary.CorrectlyVotedHead++
+		}
+		summary.BalanceChange += int64(r.BalanceAfter) - int64(r.BalanceBefore)
+	}
+	if summary.AttestationsIncluded > 0 {
+		summary.AverageInclusionDistance = float64(totalInclusionDistance) / float64(summary.AttestationsIncluded)
+	}
+	return summary
+}
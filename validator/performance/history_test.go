@@ -0,0 +1,105 @@
+package performance
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestHistory(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t, [][48]byte{})
+	pubKey1 := [48]byte{1}
+	pubKey2 := [48]byte{2}
+	require.NoError(t, db.SavePerformanceHistory(ctx, map[[48]byte][]*pb.EpochPerformance{
+		pubKey1: {
+			{Epoch: 1, AttestationAssigned: true, AttestationSubmitted: true},
+			{Epoch: 2, AttestationAssigned: true, AttestationSubmitted: true},
+			{Epoch: 3, AttestationAssigned: true},
+		},
+		pubKey2: {
+			{Epoch: 2, AttestationAssigned: true, AttestationSubmitted: true},
+		},
+	}))
+
+	resp, err := History(ctx, db, &pb.PerformanceHistoryRequest{StartEpoch: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Histories))
+	assert.DeepEqual(t, pubKey1[:], resp.Histories[0].PublicKey)
+	assert.Equal(t, 2, len(resp.Histories[0].Epochs))
+	assert.Equal(t, uint64(1), resp.Histories[0].Summary.AttestationsSubmitted)
+	assert.DeepEqual(t, pubKey2[:], resp.Histories[1].PublicKey)
+	assert.Equal(t, 1, len(resp.Histories[1].Epochs))
+
+	resp, err = History(ctx, db, &pb.PerformanceHistoryRequest{PublicKeys: [][]byte{pubKey1[:]}, EndEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Histories))
+	require.Equal(t, 1, len(resp.Histories[0].Epochs))
+	assert.Equal(t, types.Epoch(1), resp.Histories[0].Epochs[0].Epoch)
+
+	_, err = History(ctx, db, &pb.PerformanceHistoryRequest{StartEpoch: 3, EndEpoch: 2})
+	assert.ErrorContains(t, "end epoch 2 is before start epoch 3", err)
+	_, err = History(ctx, db, &pb.PerformanceHistoryRequest{PublicKeys: [][]byte{{1}}})
+	assert.ErrorContains(t, "is not 48 bytes long", err)
+}
+
+func TestSummarize(t *testing.T) {
+	summary := Summarize([]*pb.EpochPerformance{
+		{
+			AttestationAssigned:  true,
+			AttestationSubmitted: true,
+			PerformanceReported:  true,
+			AttestationIncluded:  true,
+			InclusionDistance:    1,
+			CorrectlyVotedSource: true,
+			CorrectlyVotedTarget: true,
+			CorrectlyVotedHead:   true,
+			BalanceBefore:        32000000000,
+			BalanceAfter:         32000010000,
+			ProposalsAssigned:    1,
+			ProposalsSubmitted:   1,
+		},
+		{
+			AttestationAssigned:  true,
+			AttestationSubmitted: true,
+			PerformanceReported:  true,
+			AttestationIncluded:  true,
+			InclusionDistance:    2,
+			CorrectlyVotedSource: true,
+			CorrectlyVotedTarget: true,
+			BalanceBefore:        32000010000,
+			BalanceAfter:         32000015000,
+		},
+		{
+			AttestationAssigned: true,
+			PerformanceReported: true,
+			BalanceBefore:       32000015000,
+			BalanceAfter:        32000005000,
+			ProposalsAssigned:   1,
+		},
+		{
+			// The performance of the epoch was not retrieved from the beacon node.
+			AttestationAssigned:  true,
+			AttestationSubmitted: true,
+		},
+	})
+	assert.DeepEqual(t, &pb.PerformanceSummary{
+		Epochs:                   4,
+		AttestationsAssigned:     4,
+		AttestationsSubmitted:    3,
+		AttestationsIncluded:     2,
+		AttestationsReported:     3,
+		AverageInclusionDistance: 1.5,
+		CorrectlyVotedSource:     2,
+		CorrectlyVotedTarget:     2,
+		CorrectlyVotedHead:       1,
+		ProposalsAssigned:        2,
+		ProposalsSubmitted:       1,
+		BalanceChange:            5000,
+	}, summary)
+}
@@ -2,7 +2,10 @@ package rpc
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/form3tech-oss/jwt-go"
@@ -25,7 +28,10 @@ var (
 
 const (
 	// HashedRPCPassword for the validator RPC access.
-	HashedRPCPassword       = "rpc-password-hash"
+	HashedRPCPassword = "rpc-password-hash"
+	// AuthTokenFileName is the file in the wallet directory holding the bearer
+	// token of the key management API.
+	AuthTokenFileName       = "auth-token"
 	checkUserSignupInterval = time.Second * 30
 )
 
@@ -202,3 +208,39 @@ func (s *Server) createTokenString() (string, uint64, error) {
 	}
 	return tokenString, uint64(expirationTime.Unix()), nil
 }
+
+// Loads the bearer token of the key management API from the wallet directory,
+// creating a new, random token if there is none yet.
+func (s *Server) initializeAuthToken() error {
+	tokenPath := filepath.Join(s.walletDir, AuthTokenFileName)
+	if fileutil.FileExists(tokenPath) {
+		enc, err := fileutil.ReadFileAsBytes(tokenPath)
+		if err != nil {
+			return errors.Wrap(err, "could not read auth token from disk")
+		}
+		s.authToken = strings.TrimSpace(string(enc))
+		if s.authToken == "" {
+			return fmt.Errorf("auth token file %s is empty", tokenPath)
+		}
+		return nil
+	}
+	secret, err := createRandomJWTKey()
+	if err != nil {
+		return errors.Wrap(err, "could not generate auth token")
+	}
+	token := hex.EncodeToString(secret)
+	hasDir, err := fileutil.HasDir(s.walletDir)
+	if err != nil {
+		return errors.Wrap(err, "could not check if wallet directory exists")
+	}
+	if !hasDir {
+		if err := fileutil.MkdirAll(s.walletDir); err != nil {
+			return errors.Wrapf(err, "could not create directory %s", s.walletDir)
+		}
+	}
+	if err := fileutil.WriteFile(tokenPath, []byte(token)); err != nil {
+		return errors.Wrap(err, "could not write auth token to disk")
+	}
+	s.authToken = token
+	return nil
+}
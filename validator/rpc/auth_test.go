@@ -137,3 +137,16 @@ func TestServer_ChangePassword_OK(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestServer_InitializeAuthToken(t *testing.T) {
+	walletDir := setupWalletDir(t)
+	s := &Server{walletDir: walletDir}
+	require.NoError(t, s.initializeAuthToken())
+	require.NotEqual(t, "", s.authToken)
+	assert.Equal(t, true, fileutil.FileExists(filepath.Join(walletDir, AuthTokenFileName)))
+
+	// The token is kept across restarts.
+	restarted := &Server{walletDir: walletDir}
+	require.NoError(t, restarted.initializeAuthToken())
+	assert.Equal(t, s.authToken, restarted.authToken)
+}
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"strings"
 	"sync"
@@ -47,7 +48,13 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 		authLock.RLock()
 		shouldAuthenticate := !noAuthPaths[info.FullMethod]
 		authLock.RUnlock()
-		if shouldAuthenticate {
+		// The key management API is authorized with a static bearer token
+		// instead of the JWT issued to the web UI.
+		if strings.HasPrefix(info.FullMethod, keyManagementServicePath) {
+			if err := s.authorizeToken(ctx); err != nil {
+				return nil, err
+			}
+		} else if shouldAuthenticate {
 			if err := s.authorize(ctx); err != nil {
 				return nil, err
 			}
@@ -81,6 +88,26 @@ func (s *Server) authorize(ctx context.Context) error {
 	return nil
 }
 
+// Authorize the bearer token received is the token of the key management API.
+func (s *Server) authorizeToken(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "Retrieving metadata failed")
+	}
+	authHeader, ok := md["authorization"]
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Authorization token could not be found")
+	}
+	if len(authHeader) < 1 || !strings.HasPrefix(authHeader[0], "Bearer ") {
+		return status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
+	}
+	token := strings.TrimPrefix(authHeader[0], "Bearer ")
+	if s.authToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.authToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "Invalid auth token")
+	}
+	return nil
+}
+
 func (s *Server) validateJWT(token *jwt.Token) (interface{}, error) {
 	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 		return nil, fmt.Errorf("unexpected JWT signing method: %v", token.Header["alg"])
@@ -71,3 +71,35 @@ func TestServer_JWTInterceptor_InvalidSigningType(t *testing.T) {
 	_, err := ss.validateJWT(token)
 	require.ErrorContains(t, "unexpected JWT signing method", err)
 }
+
+func TestServer_JWTInterceptor_KeyManagementToken(t *testing.T) {
+	s := Server{
+		jwtKey:    []byte("testKey"),
+		authToken: "secret-token",
+	}
+	interceptor := s.JWTInterceptor()
+
+	unaryInfo := &grpc.UnaryServerInfo{
+		FullMethod: "/ethereum.eth.v1.KeyManagement/ListKeys",
+	}
+	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), map[string][]string{
+		"authorization": {"Bearer secret-token"},
+	})
+	_, err := interceptor(ctx, "xyz", unaryInfo, unaryHandler)
+	require.NoError(t, err)
+
+	// The JWT of the web UI does not authorize key management requests.
+	token, _, err := s.createTokenString()
+	require.NoError(t, err)
+	ctx = metadata.NewIncomingContext(context.Background(), map[string][]string{
+		"authorization": {"Bearer " + token},
+	})
+	_, err = interceptor(ctx, "xyz", unaryInfo, unaryHandler)
+	require.ErrorContains(t, "Invalid auth token", err)
+
+	_, err = interceptor(context.Background(), "xyz", unaryInfo, unaryHandler)
+	require.ErrorContains(t, "Retrieving metadata failed", err)
+}
@@ -0,0 +1,238 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	slashing "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Statuses of the keystores in the responses of the key management API.
+const (
+	keystoreImported  = "imported"
+	keystoreDuplicate = "duplicate"
+	keystoreDeleted   = "deleted"
+	keystoreNotActive = "not_active"
+	keystoreNotFound  = "not_found"
+	keystoreError     = "error"
+)
+
+// keyManagementServicePath is the prefix of the full gRPC method names of the key management API.
+const keyManagementServicePath = "/ethereum.eth.v1.KeyManagement/"
+
+// keypairImporter is implemented by the keymanagers which can import keys at runtime.
+type keypairImporter interface {
+	ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]byte) error
+}
+
+// accountDeleter is implemented by the keymanagers which can delete keys at runtime.
+type accountDeleter interface {
+	DeleteAccounts(ctx context.Context, publicKeys [][]byte) error
+}
+
+// ListKeys returns the validating public keys of the keymanager. Keys of keymanagers
+// which cannot delete keys, such as remote signers, are marked as readonly.
+func (s *Server) ListKeys(ctx context.Context, _ *empty.Empty) (*ethpbv1.ListKeysResponse, error) {
+	if s.keymanager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No keymanager initialized")
+	}
+	pubKeys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validating public keys: %v", err)
+	}
+	_, canDelete := s.keymanager.(accountDeleter)
+	keystores := make([]*ethpbv1.ListKeysResponse_Keystore, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		keystores[i] = &ethpbv1.ListKeysResponse_Keystore{
+			ValidatingPubkey: hexutil.Encode(pubKey[:]),
+			Readonly:         !canDelete,
+		}
+	}
+	return &ethpbv1.ListKeysResponse{Data: keystores}, nil
+}
+
+// ImportKeys imports EIP-2335 keystores into the keymanager. The optional EIP-3076 slashing
+// protection data is imported first, so that the validator client never signs with an imported
+// key without knowing its history. The running validator client picks up the imported keys
+// right away.
+func (s *Server) ImportKeys(ctx context.Context, req *ethpbv1.ImportKeysRequest) (*ethpbv1.ImportKeysResponse, error) {
+	importer, ok := s.keymanager.(keypairImporter)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported or derived keymanagers can import keystores")
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Number of keystores and passwords does not match: %d != %d",
+			len(req.Keystores),
+			len(req.Passwords),
+		)
+	}
+	s.keystoresLock.Lock()
+	defer s.keystoresLock.Unlock()
+
+	if req.SlashingProtection != "" {
+		if s.valDB == nil {
+			return nil, status.Error(codes.FailedPrecondition, "No validator database found")
+		}
+		buf := bytes.NewBufferString(req.SlashingProtection)
+		if err := slashing.ImportStandardProtectionJSON(ctx, s.valDB, buf); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not import slashing protection data: %v", err)
+		}
+	}
+
+	existingPubKeys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validating public keys: %v", err)
+	}
+	knownPubKeys := make(map[[48]byte]bool, len(existingPubKeys))
+	for _, pubKey := range existingPubKeys {
+		knownPubKeys[pubKey] = true
+	}
+	statuses := make([]*ethpbv1.ImportedKeystoreStatus, len(req.Keystores))
+	privKeys := make([][]byte, 0, len(req.Keystores))
+	pubKeys := make([][]byte, 0, len(req.Keystores))
+	importedIndices := make([]int, 0, len(req.Keystores))
+	for i, encoded := range req.Keystores {
+		privKey, pubKey, err := decryptKeystore(encoded, req.Passwords[i])
+		if err != nil {
+			statuses[i] = &ethpbv1.ImportedKeystoreStatus{Status: keystoreError, Message: err.Error()}
+			continue
+		}
+		if knownPubKeys[bytesutil.ToBytes48(pubKey)] {
+			statuses[i] = &ethpbv1.ImportedKeystoreStatus{
+				Status:  keystoreDuplicate,
+				Message: fmt.Sprintf("Key %#x already exists", pubKey),
+			}
+			continue
+		}
+		knownPubKeys[bytesutil.ToBytes48(pubKey)] = true
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, pubKey)
+		importedIndices = append(importedIndices, i)
+		statuses[i] = &ethpbv1.ImportedKeystoreStatus{Status: keystoreImported}
+	}
+	if len(privKeys) > 0 {
+		if err := importer.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+			for _, i := range importedIndices {
+				statuses[i] = &ethpbv1.ImportedKeystoreStatus{
+					Status:  keystoreError,
+					Message: fmt.Sprintf("Could not import keystore: %v", err),
+				}
+			}
+		}
+	}
+	return &ethpbv1.ImportKeysResponse{Data: statuses}, nil
+}
+
+// DeleteKeys removes keys from the keymanager and returns their EIP-3076 slashing protection
+// data. The keys are removed before their history is exported, so that no message signed with
+// a deleted key is missing from the returned data. Keys which are not known to the keymanager
+// but have a signing history are reported as not active and their history is returned as well.
+func (s *Server) DeleteKeys(ctx context.Context, req *ethpbv1.DeleteKeysRequest) (*ethpbv1.DeleteKeysResponse, error) {
+	deleter, ok := s.keymanager.(accountDeleter)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported or derived keymanagers can delete keys")
+	}
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No validator database found")
+	}
+	s.keystoresLock.Lock()
+	defer s.keystoresLock.Unlock()
+
+	activePubKeys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve validating public keys: %v", err)
+	}
+	isActive := make(map[[48]byte]bool, len(activePubKeys))
+	for _, pubKey := range activePubKeys {
+		isActive[pubKey] = true
+	}
+	statuses := make([]*ethpbv1.DeletedKeystoreStatus, len(req.Pubkeys))
+	requestedPubKeys := make([][48]byte, len(req.Pubkeys))
+	validPubKeys := make([][48]byte, 0, len(req.Pubkeys))
+	pubKeysToDelete := make([][]byte, 0, len(req.Pubkeys))
+	for i, hexKey := range req.Pubkeys {
+		pubKey, err := hexutil.Decode(hexKey)
+		if err != nil || len(pubKey) != 48 {
+			statuses[i] = &ethpbv1.DeletedKeystoreStatus{
+				Status:  keystoreError,
+				Message: fmt.Sprintf("Invalid public key %s", hexKey),
+			}
+			continue
+		}
+		requestedPubKeys[i] = bytesutil.ToBytes48(pubKey)
+		validPubKeys = append(validPubKeys, requestedPubKeys[i])
+		if isActive[requestedPubKeys[i]] {
+			// Requesting the same key twice deletes it once.
+			isActive[requestedPubKeys[i]] = false
+			pubKeysToDelete = append(pubKeysToDelete, pubKey)
+			statuses[i] = &ethpbv1.DeletedKeystoreStatus{Status: keystoreDeleted}
+		} else {
+			statuses[i] = &ethpbv1.DeletedKeystoreStatus{Status: keystoreNotFound}
+		}
+	}
+	if len(pubKeysToDelete) > 0 {
+		if err := deleter.DeleteAccounts(ctx, pubKeysToDelete); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not delete keys: %v", err)
+		}
+	}
+
+	exported, err := slashing.ExportStandardProtectionJSONForPubKeys(ctx, s.valDB, validPubKeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection data: %v", err)
+	}
+	hasHistory := make(map[string]bool, len(exported.Data))
+	for _, data := range exported.Data {
+		hasHistory[strings.ToLower(data.Pubkey)] = true
+	}
+	for i, st := range statuses {
+		if st.Status == keystoreNotFound && hasHistory[hexutil.Encode(requestedPubKeys[i][:])] {
+			st.Status = keystoreNotActive
+		}
+	}
+	encoded, err := json.Marshal(exported)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not JSON marshal slashing protection data: %v", err)
+	}
+	return &ethpbv1.DeleteKeysResponse{
+		Data:               statuses,
+		SlashingProtection: string(encoded),
+	}, nil
+}
+
+// Decrypts a JSON encoded EIP-2335 keystore, returning its secret key and public key.
+func decryptKeystore(encoded, password string) ([]byte, []byte, error) {
+	keystore := &keymanager.Keystore{}
+	if err := json.Unmarshal([]byte(encoded), keystore); err != nil {
+		return nil, nil, errors.Wrap(err, "not a valid EIP-2335 keystore JSON file")
+	}
+	privKeyBytes, err := keystorev4.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	privKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "not a valid BLS secret key in keystore")
+	}
+	pubKey := privKey.PublicKey().Marshal()
+	keystorePubKey := strings.ToLower(strings.TrimPrefix(keystore.Pubkey, "0x"))
+	if keystorePubKey != "" && keystorePubKey != hex.EncodeToString(pubKey) {
+		return nil, nil, fmt.Errorf("public key %s of keystore does not match its secret key", keystore.Pubkey)
+	}
+	return privKeyBytes, pubKey, nil
+}
@@ -0,0 +1,261 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func setupKeyManagementServer(t *testing.T, kind keymanager.Kind) *Server {
+	imported.ResetCaches()
+	ctx := context.Background()
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      setupWalletDir(t),
+			KeymanagerKind: kind,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	return &Server{
+		keymanager: km,
+		wallet:     w,
+		valDB:      dbtest.SetupDB(t, [][48]byte{}),
+	}
+}
+
+func createKeystore(t *testing.T, password string) (string, []byte) {
+	encryptor := keystorev4.New()
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Pubkey:  fmt.Sprintf("%x", privKey.PublicKey().Marshal()),
+		Name:    encryptor.Name(),
+	})
+	require.NoError(t, err)
+	return string(encoded), privKey.PublicKey().Marshal()
+}
+
+func slashingProtectionJSON(t *testing.T, pubKey []byte, targetEpoch string) string {
+	encoded, err := json.Marshal(&format.EIPSlashingProtectionFormat{
+		Metadata: struct {
+			InterchangeFormatVersion string `json:"interchange_format_version"`
+			GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+		}{
+			InterchangeFormatVersion: format.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    fmt.Sprintf("%#x", [32]byte{1}),
+		},
+		Data: []*format.ProtectionData{
+			{
+				Pubkey:       fmt.Sprintf("%#x", pubKey),
+				SignedBlocks: []*format.SignedBlock{},
+				SignedAttestations: []*format.SignedAttestation{
+					{
+						SourceEpoch: "0",
+						TargetEpoch: targetEpoch,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return string(encoded)
+}
+
+func TestServer_ListKeys(t *testing.T) {
+	ctx := context.Background()
+	s := setupKeyManagementServer(t, keymanager.Imported)
+	keystore, pubKey := createKeystore(t, strongPass)
+	_, err := s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		Keystores: []string{keystore},
+		Passwords: []string{strongPass},
+	})
+	require.NoError(t, err)
+
+	resp, err := s.ListKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, hexutil.Encode(pubKey), resp.Data[0].ValidatingPubkey)
+	assert.Equal(t, false, resp.Data[0].Readonly)
+
+	_, err = (&Server{}).ListKeys(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "No keymanager initialized", err)
+}
+
+func TestServer_ImportKeys(t *testing.T) {
+	ctx := context.Background()
+	s := setupKeyManagementServer(t, keymanager.Imported)
+	keystore, pubKey := createKeystore(t, strongPass)
+	otherKeystore, _ := createKeystore(t, strongPass)
+
+	resp, err := s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		Keystores:          []string{keystore, keystore, otherKeystore, "{}"},
+		Passwords:          []string{strongPass, strongPass, "wrong password", strongPass},
+		SlashingProtection: slashingProtectionJSON(t, pubKey, "5"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Data))
+	assert.Equal(t, keystoreImported, resp.Data[0].Status)
+	assert.Equal(t, keystoreDuplicate, resp.Data[1].Status)
+	assert.Equal(t, keystoreError, resp.Data[2].Status)
+	assert.Equal(t, keystoreError, resp.Data[3].Status)
+
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, pubKey, keys[0][:])
+
+	// The slashing protection history is imported along with the key.
+	history, err := s.valDB.AttestationHistoryForPubKey(ctx, bytesutil.ToBytes48(pubKey))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, uint64(5), uint64(history[0].Target))
+
+	// Importing an existing key again reports a duplicate.
+	resp, err = s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		Keystores: []string{keystore},
+		Passwords: []string{strongPass},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, keystoreDuplicate, resp.Data[0].Status)
+}
+
+func TestServer_ImportKeys_Derived(t *testing.T) {
+	ctx := context.Background()
+	s := setupKeyManagementServer(t, keymanager.Derived)
+	keystore, pubKey := createKeystore(t, strongPass)
+
+	resp, err := s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		Keystores: []string{keystore},
+		Passwords: []string{strongPass},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, keystoreImported, resp.Data[0].Status)
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, pubKey, keys[0][:])
+}
+
+func TestServer_ImportKeys_Preconditions(t *testing.T) {
+	ctx := context.Background()
+	_, err := (&Server{}).ImportKeys(ctx, &ethpbv1.ImportKeysRequest{})
+	assert.ErrorContains(t, "Only imported or derived keymanagers can import keystores", err)
+
+	s := setupKeyManagementServer(t, keymanager.Imported)
+	keystore, _ := createKeystore(t, strongPass)
+	_, err = s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		Keystores: []string{keystore},
+	})
+	assert.ErrorContains(t, "Number of keystores and passwords does not match", err)
+
+	_, err = s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		Keystores:          []string{keystore},
+		Passwords:          []string{strongPass},
+		SlashingProtection: "not json",
+	})
+	assert.ErrorContains(t, "Could not import slashing protection data", err)
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys), "Keys imported despite invalid slashing protection data")
+}
+
+func TestServer_DeleteKeys(t *testing.T) {
+	ctx := context.Background()
+	s := setupKeyManagementServer(t, keymanager.Imported)
+	keystore, pubKey := createKeystore(t, strongPass)
+	otherKeystore, otherPubKey := createKeystore(t, strongPass)
+	_, inactivePubKey := createKeystore(t, strongPass)
+	_, unknownPubKey := createKeystore(t, strongPass)
+	_, err := s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		Keystores:          []string{keystore, otherKeystore},
+		Passwords:          []string{strongPass, strongPass},
+		SlashingProtection: slashingProtectionJSON(t, pubKey, "3"),
+	})
+	require.NoError(t, err)
+	_, err = s.ImportKeys(ctx, &ethpbv1.ImportKeysRequest{
+		SlashingProtection: slashingProtectionJSON(t, inactivePubKey, "4"),
+	})
+	require.NoError(t, err)
+
+	resp, err := s.DeleteKeys(ctx, &ethpbv1.DeleteKeysRequest{
+		Pubkeys: []string{
+			hexutil.Encode(pubKey),
+			hexutil.Encode(inactivePubKey),
+			hexutil.Encode(unknownPubKey),
+			"0x1234",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(resp.Data))
+	assert.Equal(t, keystoreDeleted, resp.Data[0].Status)
+	assert.Equal(t, keystoreNotActive, resp.Data[1].Status)
+	assert.Equal(t, keystoreNotFound, resp.Data[2].Status)
+	assert.Equal(t, keystoreError, resp.Data[3].Status)
+
+	// The deleted key no longer signs, while the other key still does.
+	_, err = s.keymanager.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKey, SigningRoot: make([]byte, 32)})
+	assert.ErrorContains(t, "no signing key found", err)
+	_, err = s.keymanager.Sign(ctx, &validatorpb.SignRequest{PublicKey: otherPubKey, SigningRoot: make([]byte, 32)})
+	require.NoError(t, err)
+
+	// The history of the requested keys is returned.
+	exported := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(resp.SlashingProtection), exported))
+	assert.Equal(t, fmt.Sprintf("%#x", [32]byte{1}), exported.Metadata.GenesisValidatorsRoot)
+	require.Equal(t, 2, len(exported.Data))
+	targetEpochs := make(map[string]string)
+	for _, data := range exported.Data {
+		require.Equal(t, 1, len(data.SignedAttestations))
+		targetEpochs[data.Pubkey] = data.SignedAttestations[0].TargetEpoch
+	}
+	assert.Equal(t, "3", targetEpochs[hexutil.Encode(pubKey)])
+	assert.Equal(t, "4", targetEpochs[hexutil.Encode(inactivePubKey)])
+
+	// Deleting the key again reports it as not active.
+	resp, err = s.DeleteKeys(ctx, &ethpbv1.DeleteKeysRequest{
+		Pubkeys: []string{hexutil.Encode(pubKey)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, keystoreNotActive, resp.Data[0].Status)
+}
+
+func TestServer_DeleteKeys_Preconditions(t *testing.T) {
+	ctx := context.Background()
+	_, err := (&Server{}).DeleteKeys(ctx, &ethpbv1.DeleteKeysRequest{})
+	assert.ErrorContains(t, "Only imported or derived keymanagers can delete keys", err)
+
+	s := setupKeyManagementServer(t, keymanager.Imported)
+	s.valDB = nil
+	_, err = s.DeleteKeys(ctx, &ethpbv1.DeleteKeysRequest{})
+	assert.ErrorContains(t, "No validator database found", err)
+}
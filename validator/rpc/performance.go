@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/performance"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPerformanceHistory returns the performance of the validator keys over a range of epochs, as recorded
+// by the validator client, along with a summary of the performance of each key over the range.
+func (s *Server) GetPerformanceHistory(
+	ctx context.Context, req *pb.PerformanceHistoryRequest,
+) (*pb.PerformanceHistoryResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not initialized")
+	}
+	resp, err := performance.History(ctx, s.valDB, req)
+	if errors.Is(err, performance.ErrInvalidRequest) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get performance history: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_GetPerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	s := &Server{valDB: dbtest.SetupDB(t, [][48]byte{pubKey})}
+	require.NoError(t, s.valDB.SavePerformanceHistory(ctx, map[[48]byte][]*pb.EpochPerformance{
+		pubKey: {
+			{Epoch: 1, AttestationAssigned: true, AttestationSubmitted: true, PerformanceReported: true, AttestationIncluded: true},
+			{Epoch: 2, AttestationAssigned: true, PerformanceReported: true},
+		},
+	}))
+
+	resp, err := s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{PublicKeys: [][]byte{pubKey[:]}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Histories))
+	assert.Equal(t, 2, len(resp.Histories[0].Epochs))
+	assert.Equal(t, uint64(1), resp.Histories[0].Summary.AttestationsIncluded)
+	assert.Equal(t, uint64(2), resp.Histories[0].Summary.AttestationsReported)
+
+	_, err = s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{StartEpoch: 2, EndEpoch: 1})
+	assert.ErrorContains(t, "Invalid request", err)
+}
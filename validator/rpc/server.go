@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path/filepath"
+	"sync"
 	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -12,6 +14,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	healthpb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -82,6 +85,8 @@ type Server struct {
 	credentialError           error
 	grpcServer                *grpc.Server
 	jwtKey                    []byte
+	authToken                 string
+	keystoresLock             sync.Mutex
 	validatorService          *client.ValidatorService
 	syncChecker               client.SyncChecker
 	genesisFetcher            client.GenesisFetcher
@@ -181,6 +186,14 @@ func (s *Server) Start() {
 	}
 	s.jwtKey = jwtKey
 
+	// The key management API token is kept across restarts in the wallet directory.
+	if err := s.initializeAuthToken(); err != nil {
+		log.WithError(err).Fatal("Could not initialize key management API auth token")
+	}
+	log.WithField(
+		"path", filepath.Join(s.walletDir, AuthTokenFileName),
+	).Info("Key management API requests require the bearer token stored at path")
+
 	// Register services available for the gRPC server.
 	reflection.Register(s.grpcServer)
 	pb.RegisterAuthServer(s.grpcServer, s)
@@ -189,6 +202,8 @@ func (s *Server) Start() {
 	pb.RegisterBeaconServer(s.grpcServer, s)
 	pb.RegisterAccountsServer(s.grpcServer, s)
 	pb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	pb.RegisterPerformanceServer(s.grpcServer, s)
+	ethpbv1.RegisterKeyManagementServer(s.grpcServer, s)
 
 	go func() {
 		if s.listener != nil {
@@ -108,6 +108,62 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	return interchangeJSON, nil
 }
 
+// ExportStandardProtectionJSONForPubKeys extracts the slashing protection data of the specified
+// public keys from a validator database into an EIP-3076 compliant format. Public keys without
+// any signing history in the database are not part of the returned data.
+func ExportStandardProtectionJSONForPubKeys(
+	ctx context.Context, validatorDB db.Database, pubKeys [][48]byte,
+) (*format.EIPSlashingProtectionFormat, error) {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesisRootHex, err := rootToHexString(genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRootHex
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+
+	dataList := make([]*format.ProtectionData, 0, len(pubKeys))
+	seen := make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if seen[pubKey] {
+			continue
+		}
+		seen[pubKey] = true
+		pubKeyHex, err := pubKeyToHexString(pubKey[:])
+		if err != nil {
+			return nil, err
+		}
+		signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(signedBlocks) == 0 && len(signedAttestations) == 0 {
+			continue
+		}
+		if signedAttestations == nil {
+			signedAttestations = make([]*format.SignedAttestation, 0)
+		}
+		dataList = append(dataList, &format.ProtectionData{
+			Pubkey:             pubKeyHex,
+			SignedBlocks:       signedBlocks,
+			SignedAttestations: signedAttestations,
+		})
+	}
+	sort.Slice(dataList, func(i, j int) bool {
+		return strings.Compare(dataList[i].Pubkey, dataList[j].Pubkey) < 0
+	})
+	interchangeJSON.Data = dataList
+	return interchangeJSON, nil
+}
+
 func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [48]byte) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
@@ -195,3 +195,50 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func TestExportStandardProtectionJSONForPubKeys(t *testing.T) {
+	pubKeys := [][48]byte{
+		{1},
+		{2},
+		{3},
+	}
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	genesisValidatorsRoot := [32]byte{4}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+
+	signingRoot := [32]byte{5}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 1, signingRoot[:]))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[1], signingRoot, createAttestation(0, 1)))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[2], 2, signingRoot[:]))
+
+	// The history of the third key is not requested, and the unknown key has no history.
+	exported, err := ExportStandardProtectionJSONForPubKeys(ctx, validatorDB, [][48]byte{pubKeys[1], pubKeys[0], {9}})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%#x", genesisValidatorsRoot), exported.Metadata.GenesisValidatorsRoot)
+	assert.Equal(t, format.InterchangeFormatVersion, exported.Metadata.InterchangeFormatVersion)
+	wanted := []*format.ProtectionData{
+		{
+			Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+			SignedBlocks: []*format.SignedBlock{
+				{
+					Slot:        "1",
+					SigningRoot: fmt.Sprintf("%#x", signingRoot),
+				},
+			},
+			SignedAttestations: []*format.SignedAttestation{},
+		},
+		{
+			Pubkey:       fmt.Sprintf("%#x", pubKeys[1]),
+			SignedBlocks: []*format.SignedBlock{},
+			SignedAttestations: []*format.SignedAttestation{
+				{
+					SourceEpoch: "0",
+					TargetEpoch: "1",
+					SigningRoot: fmt.Sprintf("%#x", signingRoot),
+				},
+			},
+		},
+	}
+	assert.DeepEqual(t, wanted, exported.Data)
+}